@@ -6,6 +6,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"github.com/EchoUtopia/pg2oracle/pkg/builder"
@@ -90,6 +91,7 @@ up.user_id in (select user_id from experience where id  in ($60,$61)  AND experi
  up.subject in (select subject from user_tags where tag  in ($70,$71)  AND taxonomy = $72 AND reference_id = $73)  ORDER BY ut.tag desc,up.full_name`
 	printSQL bool   = true
 	convert bool = false
+	oracle  string = `11g`
 )
 
 func init() {
@@ -98,6 +100,7 @@ func init() {
 	flag.StringVar(&sql, "sql", sql, "Required. The SQL to parse.")
 	// flag.BoolVar(&convert, `convert`, true, ``)
 	flag.BoolVar(&convert, `convert`, false, ``)
+	flag.StringVar(&oracle, `oracle`, oracle, `The oracle version to convert to: 11g, 12c, 19c or 23ai.`)
 }
 
 func main() {
@@ -118,22 +121,22 @@ func main() {
 	}
 		if convert {
 			start := time.Now()
-			cb := &builder.CustomBuilder{Builder: builder.Oracle()}
-			if err := cb.Convert(sql);err != nil {
-				log.Printf("%+v\n", err)
+			version, err := builder.ParseOracleVersion(oracle)
+			if err != nil {
+				log.Println(err)
 				return
 			}
-
-			now := time.Now()
-			convertedSql, err := cb.ToBoundSQL()
+			tr := builder.NewTranslator(builder.TranslatorOptions{Version: version})
+			res, err := tr.Translate(context.Background(), sql)
 			if err != nil {
-				log.Printf("%+v", err)
+				log.Printf("%+v\n", err)
 				return
 			}
-			fmt.Println(`generate: `, time.Since(now))
-
 			fmt.Println(`total: `, time.Since(start))
-			fmt.Println(convertedSql)
+			for _, w := range res.Warnings {
+				fmt.Println(`warning: `, w)
+			}
+			fmt.Println(res.SQL)
 			return
 		}
 		stmt, err := postgresParser.Parse(sql)
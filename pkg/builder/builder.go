@@ -31,6 +31,7 @@ const (
 	UNION     = "union"
 	INTERSECT = "intersect"
 	EXCEPT    = "except"
+	MINUS     = "minus"
 )
 
 type join struct {
@@ -45,9 +46,12 @@ type setOp struct {
 	builder      *Builder
 }
 
+// limit holds the row count and the offset of a limitation, each is an int,
+// a value written as bind variable or a Cond written as expression. A nil row
+// count limits only the offset.
 type limit struct {
-	limitN int
-	offset int
+	limitN interface{}
+	offset interface{}
 }
 
 // Builder describes a SQL statement
@@ -69,6 +73,11 @@ type Builder struct {
 	orderBy    string
 	groupBy    string
 	having     string
+	version    OracleVersion
+	// columns are the names of the selected columns, which the converter
+	// keeps for the queries that select from this one. An expression
+	// without alias has no name, and a star is kept as *.
+	columns []string
 }
 
 // Dialect sets the db dialect of Builder.
@@ -170,6 +179,7 @@ func (b *Builder) setOperation(opType, distinctType string, cond *Builder) *Buil
 		builder = &Builder{cond: NewCond()}
 		builder.optype = setOpType
 		builder.dialect = b.dialect
+		builder.version = b.version
 		builder.selects = b.selects
 
 		currentSetOps := b.setOps
@@ -196,9 +206,12 @@ func (b *Builder) setOperation(opType, distinctType string, cond *Builder) *Buil
 	return builder
 }
 
-// Limit sets limitN condition
+// Limit sets limitN condition. On oracle before 12c the rows are numbered by
+// ROWNUM, and with an offset the numbered query is selected again by the
+// names of its columns; when a column has no name, like that of SELECT *, the
+// row number RN is selected as an extra last column.
 func (b *Builder) Limit(limitN int, offset ...int) *Builder {
-	b.limitation = &limit{limitN: limitN}
+	b.limitation = &limit{limitN: limitN, offset: 0}
 
 	if len(offset) > 0 {
 		b.limitation.offset = offset[0]
@@ -207,6 +220,19 @@ func (b *Builder) Limit(limitN int, offset ...int) *Builder {
 	return b
 }
 
+// LimitExpr sets a limitation whose row count and offset are not constant,
+// each is a value that is written as bind variable or an Expr. A nil limitN
+// skips the offset rows without limiting the rows after them.
+func (b *Builder) LimitExpr(limitN interface{}, offset ...interface{}) *Builder {
+	b.limitation = &limit{limitN: limitN, offset: 0}
+
+	if len(offset) > 0 && offset[0] != nil {
+		b.limitation.offset = offset[0]
+	}
+
+	return b
+}
+
 // Select sets select SQL
 func (b *Builder) Select(cols ...string) *Builder {
 	b.selects = cols
@@ -16,7 +16,7 @@ func (b *Builder) limitWriteTo(w Writer) error {
 
 	if b.limitation != nil {
 		limit := b.limitation
-		if limit.offset < 0 || limit.limitN <= 0 {
+		if !limit.valid() {
 			return ErrInvalidLimitation
 		}
 		// erase limit condition
@@ -25,30 +25,55 @@ func (b *Builder) limitWriteTo(w Writer) error {
 
 		switch strings.ToLower(strings.TrimSpace(b.dialect)) {
 		case ORACLE:
-			if len(b.selects) == 0 {
-				b.selects = append(b.selects, "*")
+			if b.version.fetchFirst() {
+				if b.optype == setOpType {
+					if err := b.WriteTo(ow); err != nil {
+						return err
+					}
+				}
+				if !limit.noOffset() {
+					fmt.Fprint(ow, " OFFSET ")
+					if err := writeLimitValue(ow, limit.offset); err != nil {
+						return err
+					}
+					fmt.Fprint(ow, " ROWS")
+				}
+				if limit.noCount() {
+					return nil
+				}
+				fmt.Fprint(ow, " FETCH NEXT ")
+				if err := writeLimitValue(ow, limit.limitN); err != nil {
+					return err
+				}
+				fmt.Fprint(ow, " ROWS ONLY")
+				return nil
 			}
-
+			// ROWNUM is assigned before ORDER BY sorts the rows, so the
+			// query is ordered in an inner block and numbered outside of it.
 			var final *Builder
-			selects := b.selects
-			b.selects = append(selects, "ROWNUM RN")
-
-			var wb *Builder
-			if b.optype == setOpType {
-				wb = Dialect(b.dialect).Select("at.*", "ROWNUM RN").
-					From(b, "at")
-			} else {
-				wb = b
-			}
-
-			if limit.offset == 0 {
-				final = Dialect(b.dialect).Select(selects...).From(wb, "at").
-					Where(Lte{"at.RN": limit.limitN})
-			} else {
-				sub := Dialect(b.dialect).Select("*").
-					From(b, "at").Where(Lte{"at.RN": limit.offset + limit.limitN})
-
-				final = Dialect(b.dialect).Select(selects...).From(sub, "att").
+			switch {
+			case limit.noOffset() && limit.noCount():
+				return b.WriteTo(ow)
+			case limit.noOffset():
+				final = Dialect(b.dialect).Select("*").From(b, "at").
+					Where(Lte{"ROWNUM": limit.limitN})
+			default:
+				sub := Dialect(b.dialect).Select("at.*", "ROWNUM RN").From(b, "at")
+				if !limit.noCount() {
+					end, err := limit.end()
+					if err != nil {
+						return err
+					}
+					sub.Where(Lte{"ROWNUM": end})
+				}
+				// the outer query selects the columns by name, so that
+				// RN is not one of them. When they have no name, RN is
+				// selected as an extra last column.
+				columns, ok := b.outputColumns()
+				if !ok {
+					columns = []string{"*"}
+				}
+				final = Dialect(b.dialect).Select(columns...).From(sub, "att").
 					Where(Gt{"att.RN": limit.offset})
 			}
 
@@ -61,19 +86,35 @@ func (b *Builder) limitWriteTo(w Writer) error {
 				}
 			}
 
-			if limit.offset == 0 {
-				fmt.Fprint(ow, " LIMIT ", limit.limitN)
-			} else {
-				fmt.Fprintf(ow, " LIMIT %v OFFSET %v", limit.limitN, limit.offset)
+			// only postgres has an OFFSET without LIMIT.
+			if limit.noCount() && b.dialect != POSTGRES {
+				return ErrInvalidLimitation
+			}
+			if !limit.noCount() {
+				fmt.Fprint(ow, " LIMIT ")
+				if err := writeLimitValue(ow, limit.limitN); err != nil {
+					return err
+				}
+			}
+			if !limit.noOffset() {
+				fmt.Fprint(ow, " OFFSET ")
+				if err := writeLimitValue(ow, limit.offset); err != nil {
+					return err
+				}
 			}
 		case MSSQL:
+			// TOP is written into the select list, which has no bind values.
+			limitN, offset, ok := limit.ints()
+			if !ok {
+				return ErrInvalidLimitation
+			}
 			if len(b.selects) == 0 {
 				b.selects = append(b.selects, "*")
 			}
 
 			var final *Builder
 			selects := b.selects
-			b.selects = append(append([]string{fmt.Sprintf("TOP %d %v", limit.limitN+limit.offset, b.selects[0])},
+			b.selects = append(append([]string{fmt.Sprintf("TOP %d %v", limitN+offset, b.selects[0])},
 				b.selects[1:]...), "ROW_NUMBER() OVER (ORDER BY (SELECT 1)) AS RN")
 
 			var wb *Builder
@@ -84,10 +125,10 @@ func (b *Builder) limitWriteTo(w Writer) error {
 				wb = b
 			}
 
-			if limit.offset == 0 {
+			if offset == 0 {
 				final = Dialect(b.dialect).Select(selects...).From(wb, "at")
 			} else {
-				final = Dialect(b.dialect).Select(selects...).From(wb, "at").Where(Gt{"at.RN": limit.offset})
+				final = Dialect(b.dialect).Select(selects...).From(wb, "at").Where(Gt{"at.RN": offset})
 			}
 
 			return final.WriteTo(ow)
@@ -98,3 +139,206 @@ func (b *Builder) limitWriteTo(w Writer) error {
 
 	return nil
 }
+
+func (l *limit) ints() (limitN, offset int, ok bool) {
+	limitN, ok = l.limitN.(int)
+	if !ok {
+		return 0, 0, false
+	}
+	offset, ok = l.offset.(int)
+	return limitN, offset, ok
+}
+
+// valid checks the limitation when it is constant.
+func (l *limit) valid() bool {
+	if l.offset == nil {
+		return false
+	}
+	if n, ok := l.limitN.(int); ok && n < 0 {
+		return false
+	}
+	if offset, ok := l.offset.(int); ok && offset < 0 {
+		return false
+	}
+	return true
+}
+
+func (l *limit) noCount() bool {
+	return l.limitN == nil
+}
+
+func (l *limit) noOffset() bool {
+	offset, ok := l.offset.(int)
+	return ok && offset == 0
+}
+
+// end returns the number of the last row of the limitation.
+func (l *limit) end() (interface{}, error) {
+	if limitN, offset, ok := l.ints(); ok {
+		return limitN + offset, nil
+	}
+	w := NewWriter()
+	if err := writeLimitValue(w, l.offset); err != nil {
+		return nil, err
+	}
+	fmt.Fprint(w, " + ")
+	if err := writeLimitValue(w, l.limitN); err != nil {
+		return nil, err
+	}
+	return Expr(w.String(), w.args...), nil
+}
+
+// writeLimitValue writes v of a limitation, a Cond is written as expression
+// and any other value that is not an int as bind variable.
+func writeLimitValue(w Writer, v interface{}) error {
+	switch t := v.(type) {
+	case int:
+		_, err := fmt.Fprint(w, t)
+		return err
+	case Cond:
+		if _, err := fmt.Fprint(w, "("); err != nil {
+			return err
+		}
+		if err := t.WriteTo(w); err != nil {
+			return err
+		}
+		_, err := fmt.Fprint(w, ")")
+		return err
+	default:
+		if _, err := fmt.Fprint(w, "?"); err != nil {
+			return err
+		}
+		w.Append(v)
+		return nil
+	}
+}
+
+// outputColumns returns the names of the columns b selects, so that they can
+// be selected again from b as a subquery. The names are those the converter
+// kept, or those of the select items of a query built otherwise. It reports
+// false when a column has no name that can be referred to, like an unnamed
+// expression or a star.
+func (b *Builder) outputColumns() ([]string, bool) {
+	src := b.columnSource()
+	if src == nil {
+		return nil, false
+	}
+	if src.columns == nil {
+		return selectedColumns(src.selects)
+	}
+	for _, v := range src.columns {
+		if v == "" || v == "*" {
+			return nil, false
+		}
+	}
+	return src.columns, len(src.columns) > 0
+}
+
+// columnSource returns the builder whose select list names the columns of b,
+// the first query of a set operation or the query a SELECT * selects from.
+func (b *Builder) columnSource() *Builder {
+	switch {
+	case b.optype == setOpType:
+		if len(b.setOps) == 0 || b.setOps[0].builder == nil {
+			return nil
+		}
+		return b.setOps[0].builder.columnSource()
+	case len(b.selects) == 1 && b.selects[0] == "*" && b.subQuery != nil:
+		return b.subQuery.columnSource()
+	}
+	return b
+}
+
+// selectedColumns returns the names of the columns the select items yield.
+func selectedColumns(selects []string) ([]string, bool) {
+	if len(selects) == 0 {
+		return nil, false
+	}
+	items := splitTopLevel(strings.Join(selects, ","))
+	columns := make([]string, 0, len(items))
+	for _, item := range items {
+		name, ok := outputColumn(strings.TrimSpace(item))
+		if !ok {
+			return nil, false
+		}
+		columns = append(columns, name)
+	}
+	return columns, true
+}
+
+// splitTopLevel splits s at the commas that are neither quoted nor enclosed
+// in parentheses.
+func splitTopLevel(s string) []string {
+	var (
+		items []string
+		depth int
+		quote byte
+		start int
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(items, s[start:])
+}
+
+// outputColumn returns the name of the column a select item yields: its
+// alias, or the column name of a possibly qualified column.
+func outputColumn(item string) (string, bool) {
+	end := len(item)
+	start := end
+	if end > 0 && item[end-1] == '"' {
+		start = strings.LastIndexByte(item[:end-1], '"')
+		if start == -1 {
+			return "", false
+		}
+	} else {
+		for start > 0 && isIdentifierByte(item[start-1]) {
+			start--
+		}
+		if start == end || (item[start] >= '0' && item[start] <= '9') {
+			return "", false
+		}
+		switch strings.ToUpper(item[start:]) {
+		case "END", "NULL", "TRUE", "FALSE":
+			return "", false
+		}
+	}
+	name := item[start:]
+	if start == 0 {
+		return name, true
+	}
+	if item[start-1] == '.' {
+		if strings.ContainsAny(item[:start-1], " ()") {
+			return "", false
+		}
+		return name, true
+	}
+	if item[start-1] != ' ' {
+		return "", false
+	}
+	// an alias follows a complete expression, not an operator.
+	rest := strings.TrimRight(item[:start], " ")
+	if rest == "" || strings.ContainsRune("+-*/|=<>(,", rune(rest[len(rest)-1])) {
+		return "", false
+	}
+	return name, true
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '$' || c == '#' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
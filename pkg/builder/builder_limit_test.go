@@ -87,28 +87,28 @@ func TestBuilder_Limit4Oracle(t *testing.T) {
 	sql, err := Dialect(ORACLE).Select("a", "b", "c").From("table1").OrderBy("a ASC").
 		Limit(5, 10).ToBoundSQL()
 	assert.NoError(t, err)
-	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT * FROM (SELECT a,b,c,ROWNUM RN FROM table1 ORDER BY a ASC) at WHERE at.RN<=15) att WHERE att.RN>10", sql)
+	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT at.*,ROWNUM RN FROM (SELECT a,b,c FROM table1 ORDER BY a ASC) at WHERE ROWNUM<=15) att WHERE att.RN>10", sql)
 	assert.NoError(t, f.executableCheck(sql))
 
 	// simple with join -- OracleSQL style
 	sql, err = Dialect(ORACLE).Select("a", "b", "c", "d").From("table1 t1").
 		InnerJoin("table2 t2", "t1.id = t2.ref_id").OrderBy("a ASC").Limit(5, 10).ToBoundSQL()
 	assert.NoError(t, err)
-	assert.EqualValues(t, "SELECT a,b,c,d FROM (SELECT * FROM (SELECT a,b,c,d,ROWNUM RN FROM table1 t1 INNER JOIN table2 t2 ON t1.id = t2.ref_id ORDER BY a ASC) at WHERE at.RN<=15) att WHERE att.RN>10", sql)
+	assert.EqualValues(t, "SELECT a,b,c,d FROM (SELECT at.*,ROWNUM RN FROM (SELECT a,b,c,d FROM table1 t1 INNER JOIN table2 t2 ON t1.id = t2.ref_id ORDER BY a ASC) at WHERE ROWNUM<=15) att WHERE att.RN>10", sql)
 	assert.NoError(t, f.executableCheck(sql))
 
 	// simple -- OracleSQL style
 	sql, err = Dialect(ORACLE).Select("a", "b", "c").From("table1").
 		OrderBy("a ASC").Limit(5).ToBoundSQL()
 	assert.NoError(t, err)
-	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT a,b,c,ROWNUM RN FROM table1 ORDER BY a ASC) at WHERE at.RN<=5", sql)
+	assert.EqualValues(t, "SELECT * FROM (SELECT a,b,c FROM table1 ORDER BY a ASC) at WHERE ROWNUM<=5", sql)
 	assert.NoError(t, f.executableCheck(sql))
 
 	// simple with where -- OracleSQL style
 	sql, err = Dialect(ORACLE).Select("a", "b", "c").From("table1").Where(Neq{"a": "10", "b": "20"}).
 		OrderBy("a ASC").Limit(5, 1).ToBoundSQL()
 	assert.NoError(t, err)
-	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT * FROM (SELECT a,b,c,ROWNUM RN FROM table1 WHERE a<>'10' AND b<>'20' ORDER BY a ASC) at WHERE at.RN<=6) att WHERE att.RN>1", sql)
+	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT at.*,ROWNUM RN FROM (SELECT a,b,c FROM table1 WHERE a<>'10' AND b<>'20' ORDER BY a ASC) at WHERE ROWNUM<=6) att WHERE att.RN>1", sql)
 	assert.NoError(t, f.executableCheck(sql))
 
 	// union with limit -- OracleSQL style
@@ -119,6 +119,6 @@ func TestBuilder_Limit4Oracle(t *testing.T) {
 				OrderBy("a DESC").Limit(10)), "at").
 		Limit(3).ToBoundSQL()
 	assert.NoError(t, err)
-	assert.EqualValues(t, "SELECT a,b,c FROM (SELECT a,b,c,ROWNUM RN FROM ((SELECT a,b,c FROM (SELECT * FROM (SELECT a,b,c,ROWNUM RN FROM table1 WHERE a<>'0' ORDER BY a ASC) at WHERE at.RN<=15) att WHERE att.RN>10) UNION ALL (SELECT a,b,c FROM (SELECT a,b,c,ROWNUM RN FROM table1 WHERE b<>'48' ORDER BY a DESC) at WHERE at.RN<=10)) at) at WHERE at.RN<=3", sql)
+	assert.EqualValues(t, "SELECT * FROM (SELECT a,b,c FROM ((SELECT a,b,c FROM (SELECT at.*,ROWNUM RN FROM (SELECT a,b,c FROM table1 WHERE a<>'0' ORDER BY a ASC) at WHERE ROWNUM<=15) att WHERE att.RN>10) UNION ALL (SELECT * FROM (SELECT a,b,c FROM table1 WHERE b<>'48' ORDER BY a DESC) at WHERE ROWNUM<=10)) at) at WHERE ROWNUM<=3", sql)
 	assert.NoError(t, f.executableCheck(sql))
 }*/
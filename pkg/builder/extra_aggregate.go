@@ -0,0 +1,89 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// DefaultArrayType is the collection type array_agg collects into, register
+// CollectInto for array_agg to collect into another type.
+const DefaultArrayType = `SYS.ODCIVARCHAR2LIST`
+
+func registerAggregateFuncs(r *FuncRegistry) {
+	for _, name := range []string{`count`, `sum`, `avg`, `min`, `max`} {
+		r.Register(name, 1, aggregate(strings.ToUpper(name)))
+	}
+	r.Register(`string_agg`, 2, translateStringAgg)
+	r.Register(`array_agg`, 1, func(call *FuncCall) (string, error) {
+		call.Warnf(`array_agg collects into %s, register CollectInto for another type`, DefaultArrayType)
+		return CollectInto(DefaultArrayType)(call)
+	})
+	r.Register(`bool_and`, 1, boolAggregate(`MIN`))
+	r.Register(`every`, 1, boolAggregate(`MIN`))
+	r.Register(`bool_or`, 1, boolAggregate(`MAX`))
+}
+
+// aggregate translates an aggregate function that is written the same way in
+// oracle.
+func aggregate(name string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		args := append([]string{call.Filtered(call.Args[0])}, call.Args[1:]...)
+		if call.Distinct {
+			return call.Windowed(fmt.Sprintf(`%s(DISTINCT %s)`, name, strings.Join(args, `, `))), nil
+		}
+		return call.Windowed(fmt.Sprintf(`%s(%s)`, name, strings.Join(args, `, `))), nil
+	}
+}
+
+func translateStringAgg(call *FuncCall) (string, error) {
+	distinct := ``
+	if call.Distinct {
+		if !call.Version.listaggDistinct() {
+			return ``, errors.Wrapf(NotImplemented, `string_agg(DISTINCT ...) needs LISTAGG(DISTINCT ...) of oracle 19c, the target is %s`, call.Version)
+		}
+		distinct = `DISTINCT `
+	}
+	// LISTAGG needs an order.
+	order := `NULL`
+	if len(call.OrderBy) > 0 {
+		order = strings.Join(call.OrderBy, `, `)
+	}
+	call.Warnf(`LISTAGG fails when the result is longer than the maximum size of VARCHAR2`)
+	return fmt.Sprintf(`LISTAGG(%s%s, %s) WITHIN GROUP (ORDER BY %s)`, distinct, call.Filtered(call.Args[0]), call.Args[1], order), nil
+}
+
+// CollectInto returns the translation of array_agg to a collection of the
+// oracle type typeName, a nested table or varray type.
+func CollectInto(typeName string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		distinct := ``
+		if call.Distinct {
+			distinct = `DISTINCT `
+		}
+		order := ``
+		if len(call.OrderBy) > 0 {
+			order = ` ORDER BY ` + strings.Join(call.OrderBy, `, `)
+		}
+		return fmt.Sprintf(`CAST(COLLECT(%s%s%s) AS %s)`, distinct, call.Args[0], order, typeName), nil
+	}
+}
+
+// boolAggregate translates bool_and and bool_or to the minimum or maximum of
+// 1 for true and 0 for false, NULL is skipped like in postgres.
+func boolAggregate(name string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		value := call.Args[0]
+		if call.Version.boolean() {
+			if p, ok := call.values[0].(predicate); ok {
+				value = string(p)
+			}
+			value = boolToNumber(value)
+		}
+		sql := call.Windowed(fmt.Sprintf(`%s(%s)`, name, call.Filtered(value)))
+		if call.Version.boolean() {
+			return `(` + sql + ` = 1)`, nil
+		}
+		return sql, nil
+	}
+}
@@ -0,0 +1,60 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAggregates(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select bool_and(a), bool_or(b > 1), every(c is null) from t`: `SELECT MIN("a"), MAX(CASE WHEN "b">1 THEN 1 WHEN NOT ("b">1) THEN 0 END), MIN(CASE WHEN "c" IS NULL THEN 1 WHEN NOT ("c" IS NULL) THEN 0 END) FROM "t"`,
+
+		`select count(*) filter (where a > $1), sum(x) filter (where y = 'k'), count(distinct x) filter (where y = 'k') from t`: `SELECT COUNT(CASE WHEN "a">:arg1 THEN 1 END), SUM(CASE WHEN "y"='k' THEN "x" END), COUNT(DISTINCT CASE WHEN "y"='k' THEN "x" END) FROM "t"`,
+
+		`select bool_or(a) filter (where b = 1) from t`: `SELECT MAX(CASE WHEN "b"=1 THEN "a" END) FROM "t"`,
+
+		`select a, count(*) from t group by a having count(*) > 1`: `SELECT "a", COUNT(*) FROM "t" GROUP BY "a" HAVING COUNT(*)>1`,
+
+		`select a from t group by a having sum(b) > $1 and bool_and(c) order by a`: `SELECT "a" FROM "t" GROUP BY "a" HAVING SUM("b")>:arg1 AND MIN("c")=1 ORDER BY "a"`,
+	})
+
+	for _, sql := range []string{
+		`select string_agg(distinct a, ',') from t`,
+		`select lower(a) filter (where a > 1) from t`,
+		`select array_agg(a) filter (where a > 1) from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+
+	testTranslateVersion(t, Oracle19c, map[string]string{
+		`select string_agg(distinct a, ',') from t`: `SELECT LISTAGG(DISTINCT "a", ',') WITHIN GROUP (ORDER BY NULL) FROM "t"`,
+	})
+	testTranslateVersion(t, Oracle23ai, map[string]string{
+		`select bool_and(a) from t`: `SELECT (MIN(CASE WHEN "a" THEN 1 WHEN NOT ("a") THEN 0 END) = 1) FROM "t"`,
+	})
+}
+
+func TestConvertCollectingAggregates(t *testing.T) {
+	for sql, expected := range map[string]string{
+		`select string_agg(a, ',' order by b desc, c) from t`:       `SELECT LISTAGG("a", ',') WITHIN GROUP (ORDER BY "b" DESC, "c") FROM "t"`,
+		`select string_agg(a, ', ') filter (where b > 1) from t`:    `SELECT LISTAGG(CASE WHEN "b">1 THEN "a" END, ', ') WITHIN GROUP (ORDER BY NULL) FROM "t"`,
+		`select array_agg(distinct a order by a) from t group by d`: `SELECT CAST(COLLECT(DISTINCT "a" ORDER BY "a") AS SYS.ODCIVARCHAR2LIST) FROM "t" GROUP BY "d"`,
+	} {
+		cb := &CustomBuilder{Builder: Oracle()}
+		require.NoError(t, cb.Convert(sql), sql)
+		converted, err := cb.ToBoundSQL()
+		require.NoError(t, err)
+		require.Equal(t, expected, converted, sql)
+		require.Len(t, cb.Warnings(), 1, sql)
+	}
+
+	r := NewFuncRegistry()
+	r.Register(`array_agg`, 1, CollectInto(`NUMBER_LIST`))
+	res, err := NewTranslator(TranslatorOptions{Funcs: r}).Translate(context.Background(), `select array_agg(id order by id) from t`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST(COLLECT("id" ORDER BY "id") AS NUMBER_LIST) FROM "t"`, res.SQL)
+	require.Empty(t, res.Warnings)
+}
@@ -0,0 +1,119 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+)
+
+// predicate is a converted condition, which is not a value before oracle
+// 23ai.
+type predicate string
+
+func (p predicate) String() string {
+	return string(p)
+}
+
+func isPredicate(e parser.Expr) bool {
+	switch e.(type) {
+	case *parser.ComparisonExpr, *parser.AndExpr, *parser.OrExpr, *parser.NotExpr, *parser.RangeCond:
+		return true
+	}
+	return false
+}
+
+func (cb *CustomBuilder) convertPredicate(e parser.Expr) (predicate, error) {
+	cond, err := cb.convertExprToCond(e)
+	if err != nil {
+		return ``, err
+	}
+	sql, err := ToBoundSQL(cond)
+	if err != nil {
+		return ``, err
+	}
+	return predicate(sql), nil
+}
+
+// boolValue converts a predicate used as a value. Before oracle 23ai a
+// boolean is stored as 1 for true and 0 for false.
+func (cb *CustomBuilder) boolValue(p predicate) string {
+	if cb.version.boolean() {
+		return `(` + string(p) + `)`
+	}
+	return boolToNumber(string(p))
+}
+
+// boolToNumber returns 1 for true and 0 for false of the condition, and NULL
+// when it is unknown like a postgres boolean.
+func boolToNumber(cond string) string {
+	return fmt.Sprintf(`CASE WHEN %[1]s THEN 1 WHEN NOT (%[1]s) THEN 0 END`, cond)
+}
+
+// boolCond converts a boolean value used as a condition, like the column of
+// WHERE is_active.
+func (cb *CustomBuilder) boolCond(e parser.Expr) (Cond, error) {
+	if b, ok := e.(*parser.DBool); ok && !cb.version.boolean() {
+		if *b {
+			return Expr(`1=1`), nil
+		}
+		return Expr(`1=0`), nil
+	}
+	value, err := cb.getExprDisplayValue(e)
+	if err != nil {
+		return nil, err
+	}
+	if cb.version.boolean() {
+		return Expr(value), nil
+	}
+	return Eq{value: 1}, nil
+}
+
+// convertBoolTest converts IS [NOT] TRUE and IS [NOT] FALSE, which are
+// false rather than unknown for NULL.
+func (cb *CustomBuilder) convertBoolTest(left parser.Expr, not bool, truth bool) (Cond, error) {
+	left = parser.StripParens(left)
+	var (
+		value string
+		err   error
+	)
+	if isPredicate(left) {
+		var p predicate
+		if p, err = cb.convertPredicate(left); err != nil {
+			return nil, err
+		}
+		value = string(p)
+	} else if value, err = cb.getExprDisplayValue(left); err != nil {
+		return nil, err
+	}
+	if cb.version.boolean() {
+		if isPredicate(left) {
+			value = `(` + value + `)`
+		}
+		operator := ` IS `
+		if not {
+			operator = ` IS NOT `
+		}
+		if truth {
+			return Expr(value + operator + `TRUE`), nil
+		}
+		return Expr(value + operator + `FALSE`), nil
+	}
+	expected := 1
+	if not {
+		expected = 0
+	}
+	if isPredicate(left) {
+		if !truth {
+			value = `NOT (` + value + `)`
+		}
+		return Eq{fmt.Sprintf(`CASE WHEN %s THEN 1 ELSE 0 END`, value): expected}, nil
+	}
+	// NULL is taken for the opposite of the tested value.
+	tested, opposite := 1, 0
+	if !truth {
+		tested, opposite = 0, 1
+	}
+	if not {
+		tested = opposite
+	}
+	return Eq{fmt.Sprintf(`NVL(%s, %d)`, value, opposite): tested}, nil
+}
@@ -0,0 +1,37 @@
+package builder
+
+import (
+	"testing"
+)
+
+func TestConvertBooleans(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select a from t where is_active and not deleted`: `SELECT "a" FROM "t" WHERE "is_active"=1 AND NOT "deleted"=1`,
+
+		`select a from t where flag is true or flag is not false or flag is false or flag is not true`: `SELECT "a" FROM "t" WHERE NVL("flag", 0)=1 OR NVL("flag", 1)=1 OR NVL("flag", 1)=0 OR NVL("flag", 0)=0`,
+
+		`select a from t where (a > b) is not true and a > b is false`: `SELECT "a" FROM "t" WHERE CASE WHEN "a">("b") THEN 1 ELSE 0 END=0 AND CASE WHEN NOT ("a">("b")) THEN 1 ELSE 0 END=1`,
+
+		`select a > b as flag, a is null n from t where true`: `SELECT CASE WHEN "a">("b") THEN 1 WHEN NOT ("a">("b")) THEN 0 END "flag", CASE WHEN "a" IS NULL THEN 1 WHEN NOT ("a" IS NULL) THEN 0 END "n" FROM "t" WHERE 1=1`,
+
+		`select coalesce(a > b, false) from t`: `SELECT NVL(CASE WHEN "a">("b") THEN 1 WHEN NOT ("a">("b")) THEN 0 END, 0) FROM "t"`,
+
+		`select case when a then 1 when b > $1 then 2 else 0 end x from t`: `SELECT CASE WHEN "a"=1 THEN 1 WHEN "b">:arg1 THEN 2 ELSE 0 END "x" FROM "t"`,
+
+		`select case a when 'x' then b < 1 end from t`: `SELECT CASE "a" WHEN 'x' THEN CASE WHEN "b"<1 THEN 1 WHEN NOT ("b"<1) THEN 0 END END FROM "t"`,
+
+		`update t set done = a > b`: `UPDATE "t" SET "done"=(CASE WHEN "a">("b") THEN 1 WHEN NOT ("a">("b")) THEN 0 END)`,
+	})
+
+	testTranslateVersion(t, Oracle23ai, map[string]string{
+		`select a from t where is_active and not deleted`: `SELECT "a" FROM "t" WHERE ("is_active") AND NOT "deleted"`,
+
+		`select a from t where flag is not false and (a > b) is true`: `SELECT "a" FROM "t" WHERE ("flag" IS NOT FALSE) AND (("a">("b")) IS TRUE)`,
+
+		`select a > b as flag, bool_or(a > 1) from t where true`: `SELECT ("a">("b")) "flag", (MAX(CASE WHEN "a">1 THEN 1 WHEN NOT ("a">1) THEN 0 END) = 1) FROM "t" WHERE TRUE`,
+
+		`select case when a then 1 else 0 end from t`: `SELECT CASE WHEN "a" THEN 1 ELSE 0 END FROM "t"`,
+
+		`update t set done = a > b`: `UPDATE "t" SET "done"=(("a">("b")))`,
+	})
+}
@@ -20,64 +20,180 @@ type CustomBuilder struct {
 	OnConflict   *parser.OnConflict
 	CustomSqlStr string
 	InTx         bool
+	// MultiRowInsert selects how an insert of several rows is written.
+	MultiRowInsert MultiRowInsertMode
+	// InsertBatchSize is the maximum number of rows written by one insert
+	// statement, DefaultInsertBatchSize if zero.
+	InsertBatchSize int
+	// NullSafeConflict matches the conflict columns of an upsert NULL-safe,
+	// a row whose conflict columns are NULL then updates the row with NULL
+	// columns instead of inserting a new one.
+	NullSafeConflict bool
+	// Catalog resolves what a statement refers to by name only, like the
+	// constraint of ON CONFLICT ON CONSTRAINT.
+	Catalog *Catalog
+	// ReturningMode selects how a RETURNING clause is converted.
+	ReturningMode ReturningMode
+	// Funcs translates function calls, DefaultFuncRegistry if nil.
+	Funcs *FuncRegistry
+	// EmptyString selects what is done with the comparisons, concatenations
+	// and COALESCE calls with the empty string, which is NULL in oracle.
+	EmptyString EmptyStringMode
+	// Quoting selects how identifiers are quoted and folded.
+	Quoting QuotingMode
+	// ReservedNames maps the identifiers that are reserved words in oracle to
+	// the names they have in oracle, the others are quoted.
+	ReservedNames map[string]string
 	*onConflictOracleParams
+	state *convertState
+	// with holds the converted WITH clause that prefixes the statement.
+	with string
+	// insertRows holds the rows of an insert ... values statement.
+	insertRows [][]interface{}
+	// insertSelect holds the converted query of an insert ... select statement.
+	insertSelect string
+	// selectAliases, when set, name the columns of the converted query.
+	selectAliases []string
+	// windows are the named windows of the converted query.
+	windows parser.Window
+	// outBinds describes the out binds of RETURNING ... INTO.
+	outBinds []OutBind
+	// kind overrides the kind derived from optype, e.g. for DDL.
+	kind StatementKind
+}
+
+// convertState is shared by a CustomBuilder and the builders it creates for
+// nested statements, so that whatever they report ends up in one place.
+type convertState struct {
+	warnings []Warning
+	// reserved holds the identifiers reserved in oracle that were reported.
+	reserved map[string]bool
+}
+
+// Warning describes a construct that was converted, but whose Oracle
+// translation does not behave exactly like the Postgres original.
+type Warning struct {
+	Message string
+	// Location is the postgres expression the warning is about, it is
+	// empty when the warning is not about one expression.
+	Location string
+}
+
+func (w Warning) String() string {
+	if w.Location == `` {
+		return w.Message
+	}
+	return w.Message + `: ` + w.Location
+}
+
+func (cb *CustomBuilder) getState() *convertState {
+	if cb.state == nil {
+		cb.state = &convertState{}
+	}
+	return cb.state
+}
+
+func (cb *CustomBuilder) warnf(format string, args ...interface{}) {
+	st := cb.getState()
+	st.warnings = append(st.warnings, Warning{Message: fmt.Sprintf(format, args...)})
+}
+
+// Warnings returns the warnings collected by Convert.
+func (cb *CustomBuilder) Warnings() []Warning {
+	return cb.getState().warnings
+}
+
+// subBuilder creates a builder for a nested statement of cb.
+func (cb *CustomBuilder) subBuilder(t optype) *CustomBuilder {
+	return &CustomBuilder{
+		Builder: &Builder{
+			cond:    NewCond(),
+			dialect: cb.dialect,
+			optype:  t,
+			version: cb.version,
+		},
+		InTx:             cb.InTx,
+		MultiRowInsert:   cb.MultiRowInsert,
+		InsertBatchSize:  cb.InsertBatchSize,
+		NullSafeConflict: cb.NullSafeConflict,
+		Catalog:          cb.Catalog,
+		ReturningMode:    cb.ReturningMode,
+		Funcs:            cb.Funcs,
+		EmptyString:      cb.EmptyString,
+		Quoting:          cb.Quoting,
+		ReservedNames:    cb.ReservedNames,
+		state:            cb.getState(),
+	}
 }
 
 func (cb *CustomBuilder) ToBoundSQL() (string, error) {
+	sql, err := cb.rawBoundSQL()
+	if err != nil {
+		return ``, err
+	}
+	return convertPlaceHolder(sql)
+}
+
+// rawBoundSQL returns the converted sql with the placeholders still marked
+// by CustomPlaceHolder.
+func (cb *CustomBuilder) rawBoundSQL() (string, error) {
 	if cb.CustomSqlStr != `` {
-		return convertPlaceHolder(cb.CustomSqlStr)
+		return cb.CustomSqlStr, nil
 	}
-	innerSql, err := cb.Builder.ToBoundSQL()
+	sql, err := cb.Builder.ToBoundSQL()
 	if err != nil {
 		return ``, err
 	}
-	return convertPlaceHolder(innerSql)
+	return cb.with + sql, nil
 }
 
 var startTransactionDialect = map[string]string{
 	ORACLE: `Savepoint a;`,
 }
 
-func (cb *CustomBuilder) convertReturning(returning parser.ReturningClause) error {
+func (cb *CustomBuilder) convertReturning(table parser.TableExpr, returning parser.ReturningClause) error {
 	if _, ok := returning.(*parser.NoReturningClause); ok {
 		return nil
 	}
 	if _, ok := returning.(*parser.ReturningNothing); ok {
 		return nil
 	}
+	exprs := cb.expandReturning(table, *returning.(*parser.ReturningExprs))
+	if cb.ReturningMode == ReturningInto {
+		return cb.convertReturningInto(table, exprs)
+	}
 	if cb.optype == insertType {
 
-		if len(cb.OnConflict.Columns) == 0 {
-			return errors.Wrap(NotImplemented, `on conflict must specify columns`)
+		if cb.insertSelect != `` || len(cb.insertRows) > 1 {
+			return errors.Wrap(NotImplemented, `insert ** select *** returning *** not supported`)
 		}
-		if cb.onConflictOracleParams == nil {
+		if cb.OnConflict == nil || cb.onConflictOracleParams == nil {
 			return errors.Wrap(NotImplemented, `only support returning when on conflict`)
 		}
-		if len(cb.selects) > 0 {
-			return errors.Wrap(NotImplemented, `insert ** select *** returning *** not supported`)
+		if len(cb.OnConflict.Columns) == 0 {
+			return errors.Wrap(NotImplemented, `on conflict must specify columns`)
 		}
 	}
-	rcb := &CustomBuilder{
-		Builder: &Builder{
-			cond:    NewCond(),
-			dialect: cb.dialect,
-		},
-	}
-	columns, err := cb.convertSelectExpr(parser.SelectExprs(*returning.(*parser.ReturningExprs)))
+	rcb := cb.subBuilder(condType)
+	columns, err := cb.convertSelectExpr(parser.SelectExprs(exprs))
 	if err != nil {
 		return err
 	}
 	rcb.Select(columns)
-	if strings.Contains(columns, `excluded.`) {
+	if strings.Contains(strings.ToLower(strings.Replace(columns, `"`, ``, -1)), `excluded.`) {
 		return errors.New(`returning not support excluded table`)
 	}
-	if cb.optype == updateType {
+	if cb.optype == updateType || cb.optype == deleteType {
 		rcb.cond = cb.cond
 		rcb.from = cb.from
 	} else {
 		columns := []string{}
 		for _, v := range cb.OnConflict.Columns {
-			columns = append(columns, string(v))
+			column, err := cb.convertUnresolvedName(parser.UnresolvedName{v})
+			if err != nil {
+				return err
+			}
+			columns = append(columns, column)
 		}
 		ivs, err := cb.getInsertValuesByCols(columns)
 		if err != nil {
@@ -94,24 +210,28 @@ func (cb *CustomBuilder) convertReturning(returning parser.ReturningClause) erro
 		rcb.from = cb.into
 		rcb.Where(eq)
 	}
-	sql, err := rcb.ToBoundSQL()
+	sql, err := rcb.rawBoundSQL()
 	if err != nil {
 		return err
 	}
-	os := cb.CustomSqlStr
-	if os == `` {
-		os, err = cb.ToBoundSQL()
-		if err != nil {
-			return err
-		}
+	os, err := cb.rawBoundSQL()
+	if err != nil {
+		return err
 	}
 	ns := ``
+	cb.warnf(`returning is emulated by a separate select, the statement is no longer atomic`)
 	if !cb.InTx {
 		ns += startTransactionDialect[cb.dialect] + "\n"
 	}
-	ns += os + `;
-`
-	ns += sql
+	if cb.optype == deleteType {
+		// the deleted rows are selected first, locked so that they are
+		// still the ones deleted.
+		ns += sql + ` FOR UPDATE;
+` + os
+	} else {
+		ns += os + `;
+` + sql
+	}
 	if !cb.InTx {
 		ns += `;
 commit;`
@@ -121,10 +241,15 @@ commit;`
 }
 
 type onConflictOracleParams struct {
-	TableName     string
-	UsingValues   string
+	TableName string
+	// Target and Source are the aliases of the table and of the proposed
+	// rows.
+	Target        string
+	Source        string
+	Using         string
 	OnCondition   string
 	UpdateValues  string
+	UpdateWhere   string
 	DoNothing     bool
 	InsertColumns string
 	InsertValues  string
@@ -140,12 +265,11 @@ func init() {
 	}
 }
 
-const onConflictTemplateStr = `MERGE INTO {{.TableName}} t
-USING (select {{.UsingValues}} FROM DUAL) s
+const onConflictTemplateStr = `MERGE INTO {{.TableName}} {{.Target}}
+USING ({{.Using}}) {{.Source}}
 ON ({{.OnCondition}})
-{{if .DoNothing}}
-{{else}}WHEN MATCHED THEN
-UPDATE SET {{.UpdateValues}}
+{{if not .DoNothing}}WHEN MATCHED THEN
+UPDATE SET {{.UpdateValues}}{{if .UpdateWhere}} WHERE {{.UpdateWhere}}{{end}}
 {{end}}WHEN NOT MATCHED THEN
 INSERT ({{.InsertColumns}}) VALUES({{.InsertValues}})`
 
@@ -155,67 +279,96 @@ func (cb *CustomBuilder) convertOnConflict(insert *parser.Insert) (string, error
 	}
 	params := &onConflictOracleParams{
 		TableName:     cb.into,
-		UsingValues:   "",
+		Using:         "",
 		OnCondition:   ``,
 		UpdateValues:  "",
 		DoNothing:     insert.OnConflict.DoNothing,
 		InsertColumns: "",
 		InsertValues:  "",
 	}
-	cb.onConflictOracleParams = params
-	cb.OnConflict = insert.OnConflict
-	if len(insert.OnConflict.Columns) == 0 {
-		return ``, errors.New(`must specify on conflict columns`)
-	}
-	onConditionBuilder := &strings.Builder{}
-	usingValuesBuilder := &strings.Builder{}
-	updateValuesBuilder := NewWriter()
-	insertValuesBuilder := &strings.Builder{}
-	for k, v := range insert.OnConflict.Columns {
-		if _, err := fmt.Fprintf(onConditionBuilder, `(SELECT t.%s FROM DUAL) = s.%s`, v, v); err != nil {
+	oc := insert.OnConflict
+	if oc.Constraint != `` {
+		columns, err := cb.constraintColumns(insert.Table, oc.Constraint)
+		if err != nil {
 			return ``, err
 		}
-		if k != len(insert.OnConflict.Columns)-1 {
-			if _, err := onConditionBuilder.WriteString(`, `); err != nil {
-				return ``, err
-			}
-		}
+		resolved := *oc
+		resolved.Columns = columns
+		oc = &resolved
+	}
+	cb.onConflictOracleParams = params
+	cb.OnConflict = oc
+	if len(oc.Columns) == 0 {
+		return ``, errors.New(`must specify on conflict columns`)
 	}
-	eq, err := generateUpdateExpr(cb.OnConflict.Exprs)
+	using, insertCols, err := cb.mergeSource()
 	if err != nil {
 		return ``, err
 	}
-	eqCount := 0
-	for k, v := range eq {
-		if _, err := fmt.Fprintf(updateValuesBuilder, `%s = %s`, k, getDisplayValue(v)); err != nil {
+	onConditions := make([]string, 0, len(oc.Columns))
+	conflictCols := make(map[string]bool, len(oc.Columns))
+	for _, v := range oc.Columns {
+		target, err := cb.convertUnresolvedName(parser.UnresolvedName{parser.Name(`t`), v})
+		if err != nil {
 			return ``, err
 		}
-		if eqCount != len(eq)-1 {
-			if _, err := updateValuesBuilder.WriteString(`, `); err != nil {
-				return ``, err
+		source, err := cb.convertUnresolvedName(parser.UnresolvedName{parser.Name(`s`), v})
+		if err != nil {
+			return ``, err
+		}
+		cond := fmt.Sprintf(`%s = %s`, target, source)
+		if cb.NullSafeConflict {
+			cond = fmt.Sprintf(`%s OR (%s IS NULL AND %s IS NULL)`, cond, target, source)
+			if len(oc.Columns) > 1 {
+				cond = `(` + cond + `)`
 			}
 		}
-		eqCount++
+		onConditions = append(onConditions, cond)
+		conflictCols[strings.ToLower(string(v))] = true
 	}
-	for k, v := range cb.insertCols {
-		if _, err := fmt.Fprintf(usingValuesBuilder, `%s %s`, getDisplayValue(cb.insertVals[k]), v); err != nil {
-			return ``, err
+	// the update refers to the proposed row as excluded and to the existing
+	// row by the name of the table, merge names them s and t.
+	qualifiers := mergeQualifiers(insert.Table)
+	if !params.DoNothing {
+		updates := make([]string, 0, len(oc.Exprs))
+		for _, v := range oc.Exprs {
+			for _, name := range v.Names {
+				if len(name) == 1 && conflictCols[strings.ToLower(name.String())] {
+					// ORA-38104
+					return ``, errors.Errorf(`conflict column %s can not be updated by merge`, name)
+				}
+			}
 		}
-		if _, err := fmt.Fprintf(insertValuesBuilder, `s.%s`, v); err != nil {
+		eq, err := cb.generateUpdateExpr(renameQualifiers(oc.Exprs, qualifiers))
+		if err != nil {
 			return ``, err
 		}
-		// params.
-		if k != len(cb.insertVals)-1 {
-			usingValuesBuilder.WriteString(`, `)
-			insertValuesBuilder.WriteString(`, `)
+		for _, k := range eq.sortedKeys() {
+			updates = append(updates, fmt.Sprintf(`%s = %s`, k, getDisplayValue(eq[k])))
 		}
+		params.UpdateValues = strings.Join(updates, `, `)
+		if oc.Where != nil {
+			where, _ := parser.WalkExpr(qualifiers, oc.Where.Expr)
+			cond, err := cb.convertExprToCond(where)
+			if err != nil {
+				return ``, err
+			}
+			if params.UpdateWhere, err = ToBoundSQL(cond); err != nil {
+				return ``, err
+			}
+		}
+	}
+	params.Target = cb.quoteIdentifier(`t`)
+	params.Source = cb.quoteIdentifier(`s`)
+	insertValues := make([]string, 0, len(insertCols))
+	for _, v := range insertCols {
+		insertValues = append(insertValues, params.Source+`.`+v)
 	}
-	params.OnCondition = onConditionBuilder.String()
-	params.UsingValues = usingValuesBuilder.String()
-	params.UpdateValues = updateValuesBuilder.String()
-	params.InsertValues = insertValuesBuilder.String()
+	params.OnCondition = strings.Join(onConditions, ` AND `)
+	params.Using = using
+	params.InsertValues = strings.Join(insertValues, `, `)
 
-	params.InsertColumns = strings.Join(cb.insertCols, `,`)
+	params.InsertColumns = strings.Join(insertCols, `,`)
 	buf := bytes.NewBuffer(make([]byte, 0, 512))
 	if err := OnConflictTemplate.Execute(buf, params); err != nil {
 		return ``, err
@@ -223,6 +376,158 @@ func (cb *CustomBuilder) convertOnConflict(insert *parser.Insert) (string, error
 	return buf.String(), nil
 }
 
+// constraintColumns resolves the conflict target of ON CONFLICT ON CONSTRAINT
+// to the columns of the constraint.
+func (cb *CustomBuilder) constraintColumns(table parser.TableExpr, name parser.Name) (parser.NameList, error) {
+	t, err := cb.lookupTable(table)
+	if err != nil {
+		return nil, errors.Wrapf(err, `on conflict on constraint %s`, name)
+	}
+	constraint, ok := t.Constraint(string(name))
+	if !ok {
+		return nil, errors.Errorf(`constraint %s of table %s is not in the catalog`, name, t.Name)
+	}
+	columns := make(parser.NameList, 0, len(constraint.Columns))
+	for _, v := range constraint.Columns {
+		columns = append(columns, parser.Name(v))
+	}
+	return columns, nil
+}
+
+// qualifierRenamer renames the qualifier of column names.
+type qualifierRenamer struct {
+	// qualifiers maps the lower case qualifiers to their new name.
+	qualifiers map[string]parser.Name
+	// unqualified, when set, qualifies the names without qualifier.
+	unqualified parser.Name
+}
+
+func (r qualifierRenamer) VisitPre(expr parser.Expr) (bool, parser.Expr) {
+	if sub, ok := expr.(*parser.Subquery); ok && r.unqualified != `` {
+		// the names without qualifier of a subquery are its own columns,
+		// only the qualified names are renamed in it.
+		inner := qualifierRenamer{qualifiers: r.qualifiers}
+		walked, _ := parser.WalkExpr(inner, sub)
+		return false, walked
+	}
+	name, ok := expr.(parser.UnresolvedName)
+	if !ok {
+		return true, expr
+	}
+	if len(name) == 1 && r.unqualified != `` {
+		if _, ok := name[0].(parser.Name); ok {
+			return false, parser.UnresolvedName{r.unqualified, name[0]}
+		}
+	}
+	if len(name) != 2 {
+		return false, expr
+	}
+	qualifier, ok := name[0].(parser.Name)
+	if !ok {
+		return false, expr
+	}
+	to, ok := r.qualifiers[strings.ToLower(string(qualifier))]
+	if !ok {
+		return false, expr
+	}
+	return false, parser.UnresolvedName{to, name[1]}
+}
+
+func (r qualifierRenamer) VisitPost(expr parser.Expr) parser.Expr {
+	return expr
+}
+
+// mergeQualifiers maps the names an upsert uses for the proposed and the
+// existing row to the aliases of the merge. The columns without qualifier are
+// those of the existing row, merge qualifies them as the source has columns of
+// the same names.
+func mergeQualifiers(table parser.TableExpr) qualifierRenamer {
+	r := qualifierRenamer{qualifiers: map[string]parser.Name{`excluded`: `s`}, unqualified: `t`}
+	if t, ok := table.(*parser.AliasedTableExpr); ok {
+		r.qualifiers[strings.ToLower(string(t.As.Alias))] = `t`
+		table = t.Expr
+	}
+	if t, ok := table.(*parser.NormalizableTableName); ok {
+		if name, ok := t.TableNameReference.(parser.UnresolvedName); ok {
+			if last, ok := name[len(name)-1].(parser.Name); ok {
+				r.qualifiers[strings.ToLower(string(last))] = `t`
+			}
+		}
+	}
+	return r
+}
+
+func renameQualifiers(exprs parser.UpdateExprs, r qualifierRenamer) parser.UpdateExprs {
+	out := make(parser.UpdateExprs, 0, len(exprs))
+	for _, v := range exprs {
+		expr, _ := parser.WalkExpr(r, v.Expr)
+		out = append(out, &parser.UpdateExpr{Tuple: v.Tuple, Names: v.Names, Expr: expr})
+	}
+	return out
+}
+
+// mergeSource returns the query of the USING clause of an upsert, with its
+// columns named like the insert columns, and the columns it inserts.
+func (cb *CustomBuilder) mergeSource() (string, []string, error) {
+	if len(cb.insertCols) == 0 {
+		return ``, nil, errors.New(`on conflict needs insert columns`)
+	}
+	if cb.insertSelect != `` {
+		return cb.insertSelect, cb.insertCols, nil
+	}
+	if len(cb.insertRows) <= 1 {
+		return mergeSourceRow(cb.insertCols, cb.insertVals), cb.insertCols, nil
+	}
+	// a DEFAULT can not be selected, columns that are DEFAULT in every row
+	// are left to oracle.
+	cols := make([]string, 0, len(cb.insertCols))
+	keep := make([]bool, len(cb.insertCols))
+	for k, v := range cb.insertCols {
+		defaults := 0
+		for _, row := range cb.insertRows {
+			if _, ok := row[k].(defaultValue); ok {
+				defaults++
+			}
+		}
+		if defaults != 0 && defaults != len(cb.insertRows) {
+			return ``, nil, errors.Errorf(`DEFAULT of column %s is not used by all rows`, v)
+		}
+		keep[k] = defaults == 0
+		if keep[k] {
+			cols = append(cols, v)
+		}
+	}
+	if len(cols) == 0 {
+		return ``, nil, errors.New(`values with only DEFAULT are not supported`)
+	}
+	selects := make([]string, 0, len(cb.insertRows))
+	for _, row := range cb.insertRows {
+		vals := make([]interface{}, 0, len(cols))
+		for k, v := range row {
+			if keep[k] {
+				vals = append(vals, v)
+			}
+		}
+		selects = append(selects, mergeSourceRow(cols, vals))
+	}
+	return strings.Join(selects, "\nUNION ALL "), cols, nil
+}
+
+func mergeSourceRow(cols []string, vals []interface{}) string {
+	var builder strings.Builder
+	builder.WriteString(`select `)
+	for k, v := range cols {
+		if k > 0 {
+			builder.WriteString(`, `)
+		}
+		builder.WriteString(getDisplayValue(vals[k]))
+		builder.WriteByte(' ')
+		builder.WriteString(v)
+	}
+	builder.WriteString(` FROM DUAL`)
+	return builder.String()
+}
+
 func Q(s string) string {
 	s = strings.Replace(s, "'", "''", -1)
 	s = strings.Replace(s, "\000", "", -1)
@@ -0,0 +1,103 @@
+package builder
+
+import (
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// Column describes a column of a table.
+type Column struct {
+	Name string
+	// Type is the postgres type of the column, e.g. varchar(20).
+	Type string
+}
+
+// Constraint describes a unique or primary key constraint.
+type Constraint struct {
+	Name    string
+	Columns []string
+	Primary bool
+}
+
+// Table describes a table the converted statements refer to.
+type Table struct {
+	// Name is the name of the table, optionally qualified by its schema.
+	Name        string
+	Columns     []Column
+	Constraints []Constraint
+}
+
+// Constraint returns the constraint of t named name.
+func (t *Table) Constraint(name string) (*Constraint, bool) {
+	for k := range t.Constraints {
+		if strings.EqualFold(t.Constraints[k].Name, name) {
+			return &t.Constraints[k], true
+		}
+	}
+	return nil, false
+}
+
+// Catalog holds the metadata of tables, it is used to convert statements
+// that can not be converted from their text alone.
+// A Catalog must not be changed while it is used for conversion.
+type Catalog struct {
+	tables map[string]*Table
+}
+
+// NewCatalog creates a Catalog holding tables.
+func NewCatalog(tables ...Table) *Catalog {
+	c := &Catalog{tables: make(map[string]*Table)}
+	for _, t := range tables {
+		c.AddTable(t)
+	}
+	return c
+}
+
+// AddTable registers t, replacing a table of the same name.
+func (c *Catalog) AddTable(t Table) {
+	if c.tables == nil {
+		c.tables = make(map[string]*Table)
+	}
+	c.tables[strings.ToLower(t.Name)] = &t
+}
+
+// Table returns the table named name, an unqualified table is also found by
+// its qualified name.
+func (c *Catalog) Table(name string) (*Table, bool) {
+	if c == nil {
+		return nil, false
+	}
+	name = strings.ToLower(name)
+	if t, ok := c.tables[name]; ok {
+		return t, true
+	}
+	if idx := strings.LastIndexByte(name, '.'); idx != -1 {
+		t, ok := c.tables[name[idx+1:]]
+		return t, ok
+	}
+	return nil, false
+}
+
+// lookupTable returns the catalog table of a converted statement.
+func (cb *CustomBuilder) lookupTable(table parser.TableExpr) (*Table, error) {
+	if t, ok := table.(*parser.AliasedTableExpr); ok {
+		table = t.Expr
+	}
+	t, ok := table.(*parser.NormalizableTableName)
+	if !ok {
+		return nil, errors.Wrap(NotImplemented, `lookupTable`)
+	}
+	name, ok := t.TableNameReference.(parser.UnresolvedName)
+	if !ok {
+		return nil, errors.Wrap(NotImplemented, `lookupTable`)
+	}
+	if cb.Catalog == nil {
+		return nil, errors.Errorf(`table %s: no catalog`, name)
+	}
+	found, ok := cb.Catalog.Table(name.String())
+	if !ok {
+		return nil, errors.Errorf(`table %s is not in the catalog`, name)
+	}
+	return found, nil
+}
@@ -0,0 +1,47 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog(t *testing.T) {
+	c := NewCatalog(Table{Name: `Users`}, Table{Name: `audit.log`})
+	_, ok := c.Table(`users`)
+	require.True(t, ok)
+	_, ok = c.Table(`public.users`)
+	require.True(t, ok)
+	_, ok = c.Table(`audit.log`)
+	require.True(t, ok)
+	_, ok = c.Table(`log`)
+	require.False(t, ok)
+	_, ok = (*Catalog)(nil).Table(`users`)
+	require.False(t, ok)
+}
+
+func TestConvertOnConflictOnConstraint(t *testing.T) {
+	catalog := NewCatalog(Table{
+		Name: `users`,
+		Constraints: []Constraint{
+			{Name: `users_pkey`, Columns: []string{`id`}, Primary: true},
+			{Name: `users_email_key`, Columns: []string{`tenant`, `email`}},
+		},
+	})
+	cb := &CustomBuilder{Builder: Oracle(), Catalog: catalog}
+	sql, err := convertBy(cb, `insert into users (tenant, email, nick) values ($1, $2, $3) on conflict on constraint users_email_key do update set nick = excluded.nick`)
+	require.NoError(t, err)
+	require.Equal(t, `MERGE INTO "users" "t"
+USING (select :arg1 "tenant", :arg2 "email", :arg3 "nick" FROM DUAL) "s"
+ON ("t"."tenant" = "s"."tenant" AND "t"."email" = "s"."email")
+WHEN MATCHED THEN
+UPDATE SET "nick" = "s"."nick"
+WHEN NOT MATCHED THEN
+INSERT ("tenant","email","nick") VALUES("s"."tenant", "s"."email", "s"."nick")`, sql)
+
+	cb = &CustomBuilder{Builder: Oracle(), Catalog: catalog}
+	_, err = convertBy(cb, `insert into users (id) values (1) on conflict on constraint users_name_key do nothing`)
+	require.Error(t, err)
+	_, err = convert(`insert into users (id) values (1) on conflict on constraint users_pkey do nothing`)
+	require.Error(t, err)
+}
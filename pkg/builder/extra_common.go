@@ -6,7 +6,7 @@ import (
 	"fmt"
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
 	"github.com/pkg/errors"
-	"regexp"
+	"reflect"
 	"strings"
 )
 
@@ -42,100 +42,29 @@ func (cb *CustomBuilder) convertAliasedTable(table *parser.AliasedTableExpr) (st
 			return ``, err
 		}
 	case *parser.Subquery:
-		ncb := &CustomBuilder{
-			Builder: &Builder{
-				cond:    NewCond(),
-				dialect: cb.dialect,
-				optype:  selectType,
-			},
-		}
-		if _, err := ncb.convertSelectStatement(t.Select); err != nil {
-			return ``, err
-		}
-		ts, err = ncb.ToBoundSQL()
+		ts, err = cb.convertSubquery(t)
 		if err != nil {
 			return ``, err
 		}
-		ts = `(` + ts + `)`
 	default:
 		return ``, errors.Wrapf(NotImplemented, `convertAliasedTable: %#v`, t)
 	}
-	if cb.optype == selectType && table.As.Alias != `` {
-		ts += ` ` + formatNode(table.As)
-	}
-	return ts, nil
-}
-
-var nowRe = regexp.MustCompile(`now\(\)`)
-var funcSupported = map[string]bool{
-	`now`:     true,
-	`count`:   true,
-	`extract`: true,
-	`sum`:     true,
-	`avg`:     true,
-}
-
-func convertFunc(expr *parser.FuncExpr) (Cond, error) {
-	f := strings.ToLower(expr.Func.String())
-	if !funcSupported[f] {
-		return nil, errors.Wrap(NotImplemented, `func`)
-	}
-	if expr.Filter != nil || expr.WindowDef != nil {
-		return nil, errors.Wrap(NotImplemented, `func filter or window`)
+	if err := cb.checkIdentifier(string(table.As.Alias)); err != nil {
+		return ``, err
 	}
-	var builder strings.Builder
-	if f == `extract` {
-		builder.WriteString(`EXTRACT(`)
-		builder.WriteString(strings.Trim(expr.Exprs[0].String(), `'`))
-		builder.WriteString(` FROM `)
-		p1 := expr.Exprs[1]
-		switch v := p1.(type) {
-		case parser.UnresolvedName:
-			builder.WriteByte('"')
-			str, err := convertUnresolvedName(v)
-			if err != nil {
-				return nil, err
-			}
-			builder.WriteString(str)
-			builder.WriteString(`")`)
-		case *parser.FuncExpr:
-			f := v.Func.FunctionReference
-			if _, ok := f.(parser.UnresolvedName); !ok {
-				return nil, errors.Wrap(NotImplemented, `convertfunc`)
-			}
-			fs := f.String()
-			if fs == `now`{
-				fs = `SYSTIMESTAMP`
-			}
-			builder.WriteString(fs)
-			builder.WriteByte(')')
-		default:
-			return nil, errors.Wrap(NotImplemented, `extract from `)
-		}
-	}else {
-		builder.WriteString(expr.Func.FunctionReference.String())
-		builder.WriteByte('(')
-		if len(expr.Exprs) > 1 {
-			return nil, errors.Wrap(NotImplemented, `convert func`)
-		}
-		fv, ok := expr.Exprs[0].(parser.UnresolvedName);
-		if ! ok {
-			return nil, errors.Wrap(NotImplemented, `convert func`)
-		}
-		str, err := convertUnresolvedName(fv)
-		if err != nil {
-			return nil, err
+	if cb.optype != insertType && table.As.Alias != `` {
+		if len(table.As.Cols) > 0 {
+			return ``, errors.Wrapf(NotImplemented, `alias %s with columns`, formatNode(table.As))
 		}
-		builder.WriteString(str)
-		builder.WriteByte(')')
+		ts += ` ` + cb.quoteIdentifier(string(table.As.Alias))
 	}
-	return Expr(builder.String()), nil
+	return ts, nil
 }
 
 func (cb *CustomBuilder) convertNormalizableTableName(table *parser.NormalizableTableName) (string, error) {
 	switch t := table.TableNameReference.(type) {
 	case parser.UnresolvedName:
-		return convertUnresolvedName(t)
+		return cb.convertUnresolvedName(t)
 	default:
 		return ``, errors.Wrap(NotImplemented, `convertNormalizableTableName`)
 	}
@@ -149,13 +78,70 @@ func (cb *CustomBuilder) convertFrom(from *parser.From) error {
 		return errors.Wrap(NotImplemented, `as of`)
 	}
 	if len(from.Tables) > 1 {
-		return errors.Wrap(NotImplemented, `select multiple tables`)
+		tables := make([]string, 0, len(from.Tables))
+		for _, v := range from.Tables {
+			ts, err := cb.convertListedTable(v)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, ts)
+		}
+		cb.From(strings.Join(tables, `, `))
+		return nil
 	} else if len(from.Tables) == 1 {
 		return cb.convertTable(from.Tables[0])
 	}
 	return nil
 }
 
+// targetWithAlias returns the target table of a statement with its alias, and
+// the alias. A target without alias is aliased by its own name.
+func (cb *CustomBuilder) targetWithAlias(table parser.TableExpr) (string, string, error) {
+	var name *parser.NormalizableTableName
+	var alias parser.Name
+	switch t := table.(type) {
+	case *parser.NormalizableTableName:
+		name = t
+	case *parser.AliasedTableExpr:
+		n, ok := t.Expr.(*parser.NormalizableTableName)
+		if !ok {
+			return ``, ``, errors.Wrap(NotImplemented, `target table`)
+		}
+		name, alias = n, t.As.Alias
+	default:
+		return ``, ``, errors.Wrap(NotImplemented, `target table`)
+	}
+	ts, err := cb.convertNormalizableTableName(name)
+	if err != nil {
+		return ``, ``, err
+	}
+	if alias == `` {
+		un, ok := name.TableNameReference.(parser.UnresolvedName)
+		if !ok {
+			return ``, ``, errors.Wrap(NotImplemented, `target table`)
+		}
+		last, ok := un[len(un)-1].(parser.Name)
+		if !ok {
+			return ``, ``, errors.Wrap(NotImplemented, `target table`)
+		}
+		alias = last
+	}
+	quoted := cb.quoteIdentifier(string(alias))
+	return ts + ` ` + quoted, quoted, nil
+}
+
+// convertListedTable converts a table of a comma separated list of tables.
+func (cb *CustomBuilder) convertListedTable(table parser.TableExpr) (string, error) {
+	switch t := table.(type) {
+	case *parser.AliasedTableExpr:
+		return cb.convertAliasedTable(t)
+	case *parser.NormalizableTableName:
+		return cb.convertNormalizableTableName(t)
+	default:
+		return ``, errors.Wrap(NotImplemented, `join in a list of tables`)
+	}
+}
+
 func (cb *CustomBuilder) convertTable(table parser.TableExpr) error {
 	if table == nil {
 		return nil
@@ -204,7 +190,7 @@ func getDisplayValue(vi interface{}) string {
 	}
 }
 
-func getValueFromExpr(vi parser.Expr) (interface{}, error) {
+func (cb *CustomBuilder) getValueFromExpr(vi parser.Expr) (interface{}, error) {
 	switch v := vi.(type) {
 	case *parser.DTimestamp, *parser.DTimestampTZ:
 		return formatNode(v), nil
@@ -215,12 +201,15 @@ func getValueFromExpr(vi parser.Expr) (interface{}, error) {
 		}
 		return value, nil
 	case *parser.CastExpr:
+		if _, ok := v.Type.(*parser.IntervalColType); ok {
+			return settle(cb.operand(v))
+		}
 		pattern := `CAST(%s AS %s)`
-		ct, err := ConvertPGTypeToOracle(v.Type.String())
+		ct, err := cb.convertColumnType(v.Type)
 		if err != nil {
 			return nil, err
 		}
-		value, err := getValueFromExpr(v.Expr)
+		value, err := cb.getValueFromExpr(v.Expr)
 		if err != nil {
 			return nil, err
 		}
@@ -231,43 +220,149 @@ func getValueFromExpr(vi parser.Expr) (interface{}, error) {
 	case *parser.StrVal:
 		return v.OriginalString(), nil
 	case *parser.FuncExpr:
-		return convertFunc(v)
+		return cb.convertFunc(v)
+	case *parser.CoalesceExpr:
+		return cb.translateCall(&FuncCall{Name: v.Name}, cb.emptyStringCoalesce(v))
 	case *parser.BinaryExpr:
-		return convertBinary(v)
+		return cb.convertBinary(v)
+	case *parser.DInterval:
+		return settle(cb.operand(v))
+	case *parser.UnaryExpr:
+		switch v.Operator {
+		case parser.UnaryMinus:
+			return settle(cb.operand(v))
+		case parser.UnaryPlus:
+			return cb.getValueFromExpr(v.Expr)
+		}
+		return nil, errors.Wrapf(NotImplemented, `operator %s`, v.Operator)
 	case *parser.ParenExpr:
-		value, err := getValueFromExpr(v.Expr)
+		value, err := cb.getValueFromExpr(v.Expr)
 		if err != nil {
 			return ``, err
 		}
-		return Expr(getDisplayValue(value)), nil
+		return Expr(`(` + getDisplayValue(value) + `)`), nil
 	case *parser.DBool:
+		if cb.version.boolean() {
+			return Expr(strings.ToUpper(v.String())), nil
+		}
 		value := 1
 		if ! *v {
 			value = 0
 		}
 		return value, nil
 	case parser.UnresolvedName:
-		vs, err := convertUnresolvedName(v)
+		vs, err := cb.convertUnresolvedName(v)
 		if err != nil {
 			return ``, err
 		}
 		return Expr(vs), nil
-	default:
-		// fmt.Println(`uncaught value of expr: `, reflect.TypeOf(vi), `value: `, v.String())
+	case *parser.ComparisonExpr, *parser.AndExpr, *parser.OrExpr, *parser.NotExpr, *parser.RangeCond:
+		p, err := cb.convertPredicate(v)
+		if err != nil {
+			return nil, err
+		}
+		return Expr(cb.boolValue(p)), nil
+	case *parser.CaseExpr:
+		return cb.convertCase(cb.emptyStringCase(v))
+	case *parser.NullIfExpr:
+		first, err := cb.getExprDisplayValue(v.Expr1)
+		if err != nil {
+			return nil, err
+		}
+		second, err := cb.getExprDisplayValue(v.Expr2)
+		if err != nil {
+			return nil, err
+		}
+		return Expr(fmt.Sprintf(`NULLIF(%s, %s)`, first, second)), nil
+	case *parser.Tuple:
+		values := make([]string, 0, len(v.Exprs))
+		for _, e := range v.Exprs {
+			value, err := cb.getExprDisplayValue(e)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return Expr(`(` + strings.Join(values, `, `) + `)`), nil
+	case *parser.Subquery:
+		sql, err := cb.convertSubquery(v)
+		if err != nil {
+			return nil, err
+		}
+		return Expr(sql), nil
+	case *parser.DInt, *parser.DFloat, *parser.DDecimal:
 		return Expr(v.String()), nil
+	default:
+		if vi == parser.DNull {
+			return Expr(`NULL`), nil
+		}
+		return nil, errors.Wrapf(NotImplemented, `sql: %s, type: %s`, v.String(), reflect.TypeOf(v))
 	}
 }
 
-func convertBinary(v *parser.BinaryExpr) (Cond, error) {
-	left, err := getValueFromExpr(v.Left)
+// convertSubquery converts a subquery, which is written in parentheses.
+func (cb *CustomBuilder) convertSubquery(v *parser.Subquery) (string, error) {
+	ncb := cb.subBuilder(selectType)
+	if _, err := ncb.convertSelectStatement(v.Select); err != nil {
+		return ``, err
+	}
+	sql, err := ncb.rawBoundSQL()
 	if err != nil {
-		return nil, err
+		return ``, err
 	}
-	right, err := getValueFromExpr(v.Right)
+	return `(` + sql + `)`, nil
+}
+
+func (cb *CustomBuilder) convertBinary(v *parser.BinaryExpr) (Cond, error) {
+	value, err := settle(cb.arithmetic(v))
 	if err != nil {
 		return nil, err
 	}
-	return Expr(fmt.Sprintf(`%s %s %s`, getDisplayValue(left), binaryOpName[v.Operator], getDisplayValue(right))), nil
+	return value.(Cond), nil
+}
+
+// convertCase converts a CASE expression, the conditions of a searched CASE
+// are converted as those of WHERE.
+func (cb *CustomBuilder) convertCase(v *parser.CaseExpr) (Cond, error) {
+	var builder strings.Builder
+	builder.WriteString(`CASE`)
+	if v.Expr != nil {
+		value, err := cb.getExprDisplayValue(v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(` ` + value)
+	}
+	for _, when := range v.Whens {
+		var cond string
+		if v.Expr != nil {
+			value, err := cb.getExprDisplayValue(when.Cond)
+			if err != nil {
+				return nil, err
+			}
+			cond = value
+		} else {
+			p, err := cb.convertPredicate(when.Cond)
+			if err != nil {
+				return nil, err
+			}
+			cond = string(p)
+		}
+		value, err := cb.getExprDisplayValue(when.Val)
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(` WHEN ` + cond + ` THEN ` + value)
+	}
+	if v.Else != nil {
+		value, err := cb.getExprDisplayValue(v.Else)
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(` ELSE ` + value)
+	}
+	builder.WriteString(` END`)
+	return Expr(builder.String()), nil
 }
 
 var PGOracleTypeMap = map[string]string{
@@ -275,6 +370,7 @@ var PGOracleTypeMap = map[string]string{
 	`VARCHAR`:                  `VARCHAR2(4000)`,
 	`TEXT`:                     `CLOB`,
 	`JSON`:                     `CLOB`,
+	`JSONB`:                    `CLOB`,
 	`UUID`:                     `RAW`,
 	`BYTEA`:                    `BLOB`,
 	`NUMERIC`:                  `NUMBER`,
@@ -291,6 +387,23 @@ var PGOracleTypeMap = map[string]string{
 	`DATE`:                     `DATE`,
 }
 
+// convertColumnType converts a postgres type to the oracle type of the target
+// version.
+func (cb *CustomBuilder) convertColumnType(t parser.CastTargetType) (string, error) {
+	switch v := t.(type) {
+	case *parser.BoolColType:
+		if cb.version.boolean() {
+			return `BOOLEAN`, nil
+		}
+		return `NUMBER(1)`, nil
+	case *parser.StringColType:
+		if v.N > 0 && (v.Name == `VARCHAR` || v.Name == `CHAR`) {
+			return fmt.Sprintf(`%s(%d)`, strings.Replace(v.Name, `VARCHAR`, `VARCHAR2`, 1), v.N), nil
+		}
+	}
+	return ConvertPGTypeToOracle(t.String())
+}
+
 func ConvertPGTypeToOracle(in string) (string, error) {
 	idx := strings.Index(in, `NUMERIC`)
 	if idx == 0 {
@@ -331,7 +444,7 @@ func (cb *CustomBuilder) convertJoin(expr *parser.JoinTableExpr) error {
 		return err
 	}
 	bj.joinTable = ts
-	jCond, err := convertJoinCond(expr.Cond)
+	jCond, err := cb.convertJoinCond(expr.Cond)
 	if err != nil {
 		return err
 	}
@@ -340,14 +453,14 @@ func (cb *CustomBuilder) convertJoin(expr *parser.JoinTableExpr) error {
 	return nil
 }
 
-func convertJoinCond(cond parser.JoinCond) (Cond, error) {
+func (cb *CustomBuilder) convertJoinCond(cond parser.JoinCond) (Cond, error) {
 	switch c := cond.(type) {
 	case *parser.OnJoinCond:
 		v, ok := c.Expr.(*parser.ComparisonExpr)
 		if ! ok {
 			return nil, errors.Wrap(NotImplemented, `join cond`)
 		}
-		return convertExprToCond(v)
+		return cb.convertExprToCond(v)
 	default:
 		return nil, errors.Wrap(NotImplemented, `join cond `)
 	}
@@ -857,8 +970,8 @@ var (
 	}
 )
 
-func getExprDisplayValue(expr parser.Expr) (string, error){
-	value, err := getValueFromExpr(expr)
+func (cb *CustomBuilder) getExprDisplayValue(expr parser.Expr) (string, error){
+	value, err := cb.getValueFromExpr(expr)
 	if err != nil {
 		return ``, err
 	}
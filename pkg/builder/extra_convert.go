@@ -1,11 +1,10 @@
 package builder
 
 import (
-	"errors"
 	"fmt"
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
 	"strings"
-	"time"
 )
 
 var (
@@ -67,27 +66,37 @@ func convertPlaceHolder(input string) (string, error) {
 // }
 
 func (cb *CustomBuilder) Convert(input string) error {
-	start := time.Now()
+	stmts, err := cb.parse(input)
+	if err != nil {
+		return err
+	}
+	if len(stmts) > 1 {
+		return MoreThanOneStatement
+	}
+	return cb.convertStatement(stmts[0])
+}
+
+// parse cleans the postgres input and parses it into statements.
+func (cb *CustomBuilder) parse(input string) (parser.StatementList, error) {
 	if cb.dialect != ORACLE {
-		return fmt.Errorf(`dialect %s not supported`, cb.dialect)
+		return nil, fmt.Errorf(`dialect %s not supported`, cb.dialect)
 	}
 	// clean
-	var err error
-	input, err = convertDollar(input)
+	input, err := convertDollar(input)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	// fmt.Println(input)
 	stmts, err := parser.Parse(input)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if len(stmts) > 1 {
-		return MoreThanOneStatement
+	if len(stmts) == 0 {
+		return nil, errors.New(`no statement`)
 	}
-	fmt.Printf("clean and parser cost: %s\n", time.Since(start))
-	start = time.Now()
-	stmt := stmts[0]
+	return stmts, nil
+}
+
+func (cb *CustomBuilder) convertStatement(stmt parser.Statement) error {
 	switch st := stmt.(type) {
 	case parser.SelectStatement:
 		_, ok := st.(*parser.SelectClause)
@@ -108,7 +117,7 @@ func (cb *CustomBuilder) Convert(input string) error {
 			}
 			cb.CustomSqlStr = sqlStr
 		}
-		if err := cb.convertReturning(st.Returning); err != nil {
+		if err := cb.convertReturning(st.Table, st.Returning); err != nil {
 			return err
 		}
 	case *parser.Update:
@@ -126,7 +135,16 @@ func (cb *CustomBuilder) Convert(input string) error {
 		if _, err := cb.convertSelect(st); err != nil {
 			return err
 		}
+	case *parser.CreateTable:
+		if err := cb.convertCreateTable(st); err != nil {
+			return err
+		}
+	case *parser.DropTable:
+		if err := cb.convertDropTable(st); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(NotImplemented, `statement %s`, stmt.StatementTag())
 	}
-	fmt.Printf("convert cost: %s\n", time.Since(start))
 	return nil
 }
@@ -5,24 +5,26 @@ import (
 	"testing"
 )
 
+// expected holds the conversions with QuoteMinimal, and expectedQuoted those
+// of the same statements with the default quoting.
 var expected = map[string]string{
-	`select title from a union select title from b`: `(SELECT title FROM b) UNION (SELECT title FROM a)`,
+	`select title from a union select title from b`: `(SELECT title FROM a) UNION (SELECT title FROM b)`,
 
 	`select '1'::int from b`: `SELECT CAST('1' AS NUMBER) FROM b`,
 
-	`select aa.name from aa a join (select id, name from bb) b on a.id = b.id`: `SELECT aa."name" FROM aa a INNER JOIN (SELECT id, "name" FROM bb) b ON a.id = b.id`,
+	`select aa.name from aa a join (select id, name from bb) b on a.id = b.id`: `SELECT aa.name FROM aa a INNER JOIN (SELECT id, name FROM bb) b ON a.id=(b.id)`,
 
 	`select * from tasks where title ilike 'sdf%'`: `SELECT * FROM tasks WHERE UPPER(title) LIKE UPPER('sdf%')`,
 
-	`select (extract(year from now()) - extract(year from date_of_birth))::int from dual`: `SELECT CAST(extract(year FROM SYSTIMESTAMP) - extract(year FROM date_of_birth) AS NUMBER) FROM dual`,
+	`select (extract(year from now()) - extract(year from date_of_birth))::int from dual`: `SELECT CAST((EXTRACT(YEAR FROM SYSTIMESTAMP) - EXTRACT(YEAR FROM date_of_birth)) AS NUMBER) FROM dual`,
 
-	`select count(distinct $1) from b`: `SELECT count(DISTINCT :1) FROM b`,
+	`select count(distinct $1) from b`: `SELECT COUNT(DISTINCT :arg1) FROM b`,
 
 	`update a set b = b+'1'::int`: `UPDATE a SET b=(b + CAST('1' AS NUMBER))`,
 
 	`insert into a(field1) values('value1') on conflict (field1) do update set b = 'value2'`: `MERGE INTO a t
 USING (select 'value1' field1 FROM DUAL) s
-ON ((SELECT t.field1 FROM DUAL) = s.field1)
+ON (t.field1 = s.field1)
 WHEN MATCHED THEN
 UPDATE SET b = 'value2'
 WHEN NOT MATCHED THEN
@@ -30,22 +32,73 @@ INSERT (field1) VALUES(s.field1)`,
 
 	`insert into a(field1) values('value1') on conflict (field1) do update set b = $1`: `MERGE INTO a t
 USING (select 'value1' field1 FROM DUAL) s
-ON ((SELECT t.field1 FROM DUAL) = s.field1)
+ON (t.field1 = s.field1)
 WHEN MATCHED THEN
-UPDATE SET b = :1
+UPDATE SET b = :arg1
 WHEN NOT MATCHED THEN
 INSERT (field1) VALUES(s.field1)`,
 
 	`insert into a(field1) values('value1') on conflict (field1) do update set b = 'value2' returning id`: `Savepoint a;
 MERGE INTO a t
 USING (select 'value1' field1 FROM DUAL) s
-ON ((SELECT t.field1 FROM DUAL) = s.field1)
+ON (t.field1 = s.field1)
 WHEN MATCHED THEN
 UPDATE SET b = 'value2'
 WHEN NOT MATCHED THEN
 INSERT (field1) VALUES(s.field1);
 SELECT id FROM a WHERE field1='value1';
 commit;`,
+
+	`select a from t where b like 'x%' and c not in (select d from s where e = $1)`: `SELECT a FROM t WHERE (b LIKE 'x%') AND (c NOT IN (SELECT d FROM s WHERE e=:arg1))`,
+
+	`select nullif(a, ''), case when b in (1, 2) then c end from t where (a, b) = (1, 2) or a = any (select d from s)`: `SELECT NULLIF(a, ''), CASE WHEN b IN (1, 2) THEN c END FROM t WHERE (a, b)=((1, 2)) OR (a = ANY (SELECT d FROM s))`,
+}
+
+var expectedQuoted = map[string]string{
+	`select title from a union select title from b`: `(SELECT "title" FROM "a") UNION (SELECT "title" FROM "b")`,
+
+	`select '1'::int from b`: `SELECT CAST('1' AS NUMBER) FROM "b"`,
+
+	`select aa.name from aa a join (select id, name from bb) b on a.id = b.id`: `SELECT "aa"."name" FROM "aa" "a" INNER JOIN (SELECT "id", "name" FROM "bb") "b" ON "a"."id"=("b"."id")`,
+
+	`select * from tasks where title ilike 'sdf%'`: `SELECT * FROM "tasks" WHERE UPPER("title") LIKE UPPER('sdf%')`,
+
+	`select (extract(year from now()) - extract(year from date_of_birth))::int from dual`: `SELECT CAST((EXTRACT(YEAR FROM SYSTIMESTAMP) - EXTRACT(YEAR FROM "date_of_birth")) AS NUMBER) FROM "dual"`,
+
+	`select count(distinct $1) from b`: `SELECT COUNT(DISTINCT :arg1) FROM "b"`,
+
+	`update a set b = b+'1'::int`: `UPDATE "a" SET "b"=("b" + CAST('1' AS NUMBER))`,
+
+	`insert into a(field1) values('value1') on conflict (field1) do update set b = 'value2'`: `MERGE INTO "a" "t"
+USING (select 'value1' "field1" FROM DUAL) "s"
+ON ("t"."field1" = "s"."field1")
+WHEN MATCHED THEN
+UPDATE SET "b" = 'value2'
+WHEN NOT MATCHED THEN
+INSERT ("field1") VALUES("s"."field1")`,
+
+	`insert into a(field1) values('value1') on conflict (field1) do update set b = $1`: `MERGE INTO "a" "t"
+USING (select 'value1' "field1" FROM DUAL) "s"
+ON ("t"."field1" = "s"."field1")
+WHEN MATCHED THEN
+UPDATE SET "b" = :arg1
+WHEN NOT MATCHED THEN
+INSERT ("field1") VALUES("s"."field1")`,
+
+	`insert into a(field1) values('value1') on conflict (field1) do update set b = 'value2' returning id`: `Savepoint a;
+MERGE INTO "a" "t"
+USING (select 'value1' "field1" FROM DUAL) "s"
+ON ("t"."field1" = "s"."field1")
+WHEN MATCHED THEN
+UPDATE SET "b" = 'value2'
+WHEN NOT MATCHED THEN
+INSERT ("field1") VALUES("s"."field1");
+SELECT "id" FROM "a" WHERE "field1"='value1';
+commit;`,
+
+	`select a from t where b like 'x%' and c not in (select d from s where e = $1)`: `SELECT "a" FROM "t" WHERE ("b" LIKE 'x%') AND ("c" NOT IN (SELECT "d" FROM "s" WHERE "e"=:arg1))`,
+
+	`select nullif(a, ''), case when b in (1, 2) then c end from t where (a, b) = (1, 2) or a = any (select d from s)`: `SELECT NULLIF("a", ''), CASE WHEN "b" IN (1, 2) THEN "c" END FROM "t" WHERE ("a", "b")=((1, 2)) OR ("a" = ANY (SELECT "d" FROM "s"))`,
 }
 
 func convert(sql string) (string, error) {
@@ -63,10 +116,102 @@ func convert(sql string) (string, error) {
 
 func TestConvert(t *testing.T) {
 	for in, expected := range expected {
-		converted, err := convert(in)
+		converted, err := convertBy(&CustomBuilder{Builder: Oracle(), Quoting: QuoteMinimal}, in)
 		if err != nil {
 			t.Fatal(err)
 		}
 		require.Equal(t, expected, converted)
 	}
+	testConvertCases(t, expectedQuoted)
+
+	for _, sql := range []string{
+		`select a from t where b = any ($1)`,
+		`select a from t where (a, b) < (1, 2)`,
+		`select a from t where b similar to 'x%'`,
+		`select ~a from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func testConvertCases(t *testing.T, cases map[string]string) {
+	for in, expected := range cases {
+		converted, err := convert(in)
+		require.NoError(t, err, in)
+		require.Equal(t, expected, converted, in)
+	}
+}
+
+func TestConvertWith(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`with a as (select id from b) select id from a`: `WITH "a" AS (SELECT "id" FROM "b") SELECT "id" FROM "a"`,
+
+		`with a (x) as (select 1), b as (select x from a) select x from b`: `WITH "a" ("x") AS (SELECT 1 FROM DUAL), "b" AS (SELECT "x" FROM "a") SELECT "x" FROM "b"`,
+
+		`with recursive t(n) as (select 1 union all select n + 1 from t where n < 5) select n from t`: `WITH "t" ("n") AS (SELECT 1 FROM DUAL UNION ALL SELECT "n" + 1 FROM "t" WHERE "n"<5) SELECT "n" FROM "t"`,
+
+		`with recursive org as (select id, manager_id from emp where manager_id is null union all select e.id, e.manager_id from emp e join org o on e.manager_id = o.id) select id from org`: `WITH "org" ("id", "manager_id") AS (SELECT "id", "manager_id" FROM "emp" WHERE "manager_id" IS NULL UNION ALL SELECT "e"."id", "e"."manager_id" FROM "emp" "e" INNER JOIN "org" "o" ON "e"."manager_id"=("o"."id")) SELECT "id" FROM "org"`,
+	})
+
+	testConvertCases(t, map[string]string{
+		`with recursive u as (select a from t union select b from s), v as (select a + 1 from u) select * from v`: `WITH "u" AS ((SELECT "a" FROM "t") UNION (SELECT "b" FROM "s")), "v" AS (SELECT "a" + 1 FROM "u") SELECT * FROM "v"`,
+	})
+
+	_, err := convert(`with recursive t as (select count(*) from a union all select 1 from t) select * from t`)
+	require.Error(t, err)
+	_, err = convert(`with recursive t (n) as (select 1 union select n from t) select * from t`)
+	require.Error(t, err)
+}
+
+func TestConvertUnionOrder(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select title from a except select title from b`: `(SELECT "title" FROM "a") MINUS (SELECT "title" FROM "b")`,
+
+		`select a from b union all select c.a from c join d on c.id = d.id`: `(SELECT "a" FROM "b") UNION ALL (SELECT "c"."a" FROM "c" INNER JOIN "d" ON "c"."id"=("d"."id"))`,
+	})
+}
+
+func TestConvertSelectExprs(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select a, b + 1 as c, count(d) from x`: `SELECT "a", "b" + 1 "c", COUNT("d") FROM "x"`,
+
+		`select 1`: `SELECT 1 FROM DUAL`,
+	})
+}
+
+func TestConvertDistinctOn(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select distinct on (user_id) user_id, amount from orders order by user_id, created desc`: `SELECT "user_id", "amount" FROM (SELECT "user_id", "amount",ROW_NUMBER() OVER (PARTITION BY "user_id" ORDER BY "user_id", "created" DESC) RN,"user_id" SK1 FROM "orders") d WHERE RN=1 ORDER BY SK1`,
+
+		`select distinct on (a, b) a, b c from t where x = $1 order by b desc, a, c limit 5`: `SELECT * FROM (SELECT "a", "c" FROM (SELECT "a", "b" "c",ROW_NUMBER() OVER (PARTITION BY "a", "b" ORDER BY "b" DESC, "a", "b") RN,"b" SK1,"a" SK2 FROM "t" WHERE "x"=:arg1) d WHERE RN=1 ORDER BY SK1 DESC, SK2) at WHERE ROWNUM<=5`,
+
+		`select distinct on (a) a from t`: `SELECT "a" FROM (SELECT "a",ROW_NUMBER() OVER (PARTITION BY "a" ORDER BY NULL) RN FROM "t") d WHERE RN=1`,
+
+		`select distinct on (1) a, b x from t order by 1, x desc`: `SELECT "a", "x" FROM (SELECT "a", "b" "x",ROW_NUMBER() OVER (PARTITION BY "a" ORDER BY "a", "b" DESC) RN,"a" SK1 FROM "t") d WHERE RN=1 ORDER BY SK1`,
+
+		`select distinct on (x) a, b x from t order by b, a`: `SELECT "a", "x" FROM (SELECT "a", "b" "x",ROW_NUMBER() OVER (PARTITION BY "b" ORDER BY "b", "a") RN,"b" SK1 FROM "t") d WHERE RN=1 ORDER BY SK1`,
+	})
+
+	for _, sql := range []string{
+		`select distinct on (a) a, b from t order by 3`,
+		`select distinct on (a) * from t order by a`,
+		`select distinct on (a) a, count(*) from t group by a`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+
+	for _, sql := range []string{
+		`select distinct on (a) a, b from t order by b, a`,
+		`select distinct on (a, b) a, b from t order by a, c, b`,
+	} {
+		_, err := convert(sql)
+		require.EqualError(t, err, `select distinct on expressions must match initial order by expressions`, sql)
+	}
+
+	cb := &CustomBuilder{Builder: Oracle(), Catalog: NewCatalog(Table{Name: `t`, Columns: []Column{{Name: `a`}, {Name: `b`}}})}
+	sql, err := convertBy(cb, `select distinct on (a) * from t order by a, b`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "a", "b" FROM (SELECT "a", "b",ROW_NUMBER() OVER (PARTITION BY "a" ORDER BY "a", "b") RN,"a" SK1 FROM "t") d WHERE RN=1 ORDER BY SK1`, sql)
 }
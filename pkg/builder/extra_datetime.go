@@ -0,0 +1,189 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+func registerDateTimeFuncs(r *FuncRegistry) {
+	r.Register(`now`, 0, Template(`SYSTIMESTAMP`))
+	r.Register(`clock_timestamp`, 0, Template(`SYSTIMESTAMP`))
+	r.Register(`current_timestamp`, 0, Template(`CURRENT_TIMESTAMP`))
+	r.Register(`localtimestamp`, 0, Template(`LOCALTIMESTAMP`))
+	// postgres dates have no time of day.
+	r.Register(`current_date`, 0, Template(`TRUNC(CURRENT_DATE)`))
+	r.Register(`make_date`, 3, Template(`TO_DATE(TO_CHAR($1, 'FM0000') || '-' || TO_CHAR($2, 'FM00') || '-' || TO_CHAR($3, 'FM00'), 'YYYY-MM-DD')`))
+	r.Register(`to_timestamp`, 1, Template(`(TIMESTAMP '1970-01-01 00:00:00 UTC' + NUMTODSINTERVAL($1, 'SECOND'))`))
+	r.Register(`to_char`, 2, translateToChar)
+	r.Register(`date_trunc`, 2, translateDateTrunc)
+	r.Register(`age`, 1, translateAge)
+	r.Register(`age`, 2, translateAge)
+	r.Register(`extract`, 2, translateExtract)
+	r.Register(`date_part`, 2, translateExtract)
+}
+
+// dateField returns the field argument of extract, date_part or date_trunc,
+// which must be a literal.
+func dateField(call *FuncCall) (string, error) {
+	field, ok := stringLiteral(call.Args[0])
+	if !ok {
+		return ``, errors.Errorf(`the field of %s must be a literal`, call.Name)
+	}
+	return strings.ToLower(field), nil
+}
+
+// extractFormats are the fields that oracle EXTRACT lacks, as TO_CHAR
+// formats.
+var extractFormats = map[string]string{
+	`doy`:     `DDD`,
+	`week`:    `IW`,
+	`quarter`: `Q`,
+	`isoyear`: `IYYY`,
+	`century`: `CC`,
+	`julian`:  `J`,
+}
+
+func translateExtract(call *FuncCall) (string, error) {
+	field, err := dateField(call)
+	if err != nil {
+		return ``, err
+	}
+	source := call.Args[1]
+	switch field {
+	case `year`, `month`, `day`, `hour`, `minute`, `second`, `timezone_hour`, `timezone_minute`:
+		return fmt.Sprintf(`EXTRACT(%s FROM %s)`, strings.ToUpper(field), source), nil
+	case `milliseconds`:
+		return fmt.Sprintf(`(EXTRACT(SECOND FROM %s) * 1000)`, source), nil
+	case `microseconds`:
+		return fmt.Sprintf(`(EXTRACT(SECOND FROM %s) * 1000000)`, source), nil
+	case `decade`:
+		return fmt.Sprintf(`TRUNC(EXTRACT(YEAR FROM %s) / 10)`, source), nil
+	case `millennium`:
+		return fmt.Sprintf(`CEIL(EXTRACT(YEAR FROM %s) / 1000)`, source), nil
+	case `dow`:
+		// TRUNC(x, 'IW') is the monday of the week, whatever the territory.
+		return fmt.Sprintf(`MOD(TRUNC(%[1]s) - TRUNC(%[1]s, 'IW') + 1, 7)`, source), nil
+	case `isodow`:
+		return fmt.Sprintf(`(TRUNC(%[1]s) - TRUNC(%[1]s, 'IW') + 1)`, source), nil
+	case `epoch`:
+		switch v := call.values[1].(type) {
+		case interval:
+			return v.epoch(), nil
+		case difference:
+			i := fmt.Sprintf(`(CAST(%s AS TIMESTAMP) - CAST(%s AS TIMESTAMP))`, v.left, v.right)
+			return fmt.Sprintf(`(EXTRACT(DAY FROM %[1]s) * 86400 + EXTRACT(HOUR FROM %[1]s) * 3600 + EXTRACT(MINUTE FROM %[1]s) * 60 + EXTRACT(SECOND FROM %[1]s))`, i), nil
+		}
+		if isCurrentTimestamp(source) {
+			return fmt.Sprintf(`((CAST(SYS_EXTRACT_UTC(%s) AS DATE) - DATE '1970-01-01') * 86400)`, source), nil
+		}
+		call.Warnf(`extract(epoch from %s) takes the time as UTC and drops the fractional seconds`, source)
+		return fmt.Sprintf(`((CAST(%s AS DATE) - DATE '1970-01-01') * 86400)`, source), nil
+	}
+	if format, ok := extractFormats[field]; ok {
+		return fmt.Sprintf(`TO_NUMBER(TO_CHAR(%s, '%s'))`, source, format), nil
+	}
+	return ``, errors.Wrapf(NotImplemented, `%s %s`, call.Name, field)
+}
+
+func isCurrentTimestamp(source string) bool {
+	return source == `SYSTIMESTAMP` || source == `CURRENT_TIMESTAMP`
+}
+
+// truncFormats are the fields of date_trunc as formats of oracle TRUNC.
+var truncFormats = map[string]string{
+	`minute`:  `MI`,
+	`hour`:    `HH`,
+	`day`:     `DD`,
+	`week`:    `IW`,
+	`month`:   `MM`,
+	`quarter`: `Q`,
+	`year`:    `YYYY`,
+	`century`: `CC`,
+}
+
+func translateDateTrunc(call *FuncCall) (string, error) {
+	field, err := dateField(call)
+	if err != nil {
+		return ``, err
+	}
+	if field == `second` {
+		// a date has no fractional seconds.
+		return fmt.Sprintf(`CAST(%s AS DATE)`, call.Args[1]), nil
+	}
+	format, ok := truncFormats[field]
+	if !ok {
+		return ``, errors.Wrapf(NotImplemented, `date_trunc %s`, field)
+	}
+	return fmt.Sprintf(`TRUNC(%s, '%s')`, call.Args[1], format), nil
+}
+
+// translateAge translates age to the whole months between the timestamps,
+// oracle can not add the remaining days to an interval of months.
+func translateAge(call *FuncCall) (string, error) {
+	from := `TRUNC(CURRENT_DATE)`
+	to := call.Args[0]
+	if len(call.Args) == 2 {
+		from, to = call.Args[0], call.Args[1]
+	}
+	call.Warnf(`age is converted to whole months, the days are dropped`)
+	return fmt.Sprintf(`NUMTOYMINTERVAL(TRUNC(MONTHS_BETWEEN(%s, %s)), 'MONTH')`, from, to), nil
+}
+
+// charFormats are the patterns of postgres to_char that oracle writes
+// differently.
+var charFormats = []struct {
+	pg, oracle string
+}{
+	{`MS`, `FF3`},
+	{`US`, `FF6`},
+	{`TZ`, `TZD`},
+	{`tz`, `tzd`},
+	{`OF`, `TZH:TZM`},
+}
+
+func translateToChar(call *FuncCall) (string, error) {
+	format, ok := stringLiteral(call.Args[1])
+	if !ok {
+		call.Warnf(`the format of to_char is not a literal, it is passed to oracle unchanged`)
+		return fmt.Sprintf(`TO_CHAR(%s, %s)`, call.Args[0], call.Args[1]), nil
+	}
+	converted, err := convertCharFormat(format)
+	if err != nil {
+		return ``, err
+	}
+	return fmt.Sprintf(`TO_CHAR(%s, '%s')`, call.Args[0], converted), nil
+}
+
+// convertCharFormat converts a postgres to_char format, text in double quotes
+// is copied.
+func convertCharFormat(format string) (string, error) {
+	var builder strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] == '"' {
+			end := strings.IndexByte(format[i+1:], '"')
+			if end == -1 {
+				return ``, errors.Errorf(`unterminated quote in format %s`, format)
+			}
+			builder.WriteString(format[i : i+end+2])
+			i += end + 1
+			continue
+		}
+		if strings.HasPrefix(strings.ToUpper(format[i:]), `ID`) {
+			return ``, errors.Wrapf(NotImplemented, `to_char format ID`)
+		}
+		replaced := false
+		for _, v := range charFormats {
+			if strings.HasPrefix(format[i:], v.pg) {
+				builder.WriteString(v.oracle)
+				i += len(v.pg) - 1
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			builder.WriteByte(format[i])
+		}
+	}
+	return builder.String(), nil
+}
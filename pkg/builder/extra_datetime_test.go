@@ -0,0 +1,54 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDateTimeFuncs(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select current_date, current_timestamp, localtimestamp, clock_timestamp(), now() from t`: `SELECT TRUNC(CURRENT_DATE), CURRENT_TIMESTAMP, LOCALTIMESTAMP, SYSTIMESTAMP, SYSTIMESTAMP FROM "t"`,
+
+		`select date_trunc('month', a), date_trunc('week', a), date_trunc('second', a) from t`: `SELECT TRUNC("a", 'MM'), TRUNC("a", 'IW'), CAST("a" AS DATE) FROM "t"`,
+
+		`select make_date(2020, m, 1) from t`: `SELECT TO_DATE(TO_CHAR(2020, 'FM0000') || '-' || TO_CHAR("m", 'FM00') || '-' || TO_CHAR(1, 'FM00'), 'YYYY-MM-DD') FROM "t"`,
+
+		`select to_timestamp($1) from t`: `SELECT (TIMESTAMP '1970-01-01 00:00:00 UTC' + NUMTODSINTERVAL(:arg1, 'SECOND')) FROM "t"`,
+
+		`select to_char(a, 'YYYY-MM-DD HH24:MI:SS.MS "MS" OF') from t`: `SELECT TO_CHAR("a", 'YYYY-MM-DD HH24:MI:SS.FF3 "MS" TZH:TZM') FROM "t"`,
+
+		`select extract(month from a), date_part('hour', a), extract(milliseconds from a) from t`: `SELECT EXTRACT(MONTH FROM "a"), EXTRACT(HOUR FROM "a"), (EXTRACT(SECOND FROM "a") * 1000) FROM "t"`,
+
+		`select extract(dow from a), extract(isodow from a) from t`: `SELECT MOD(TRUNC("a") - TRUNC("a", 'IW') + 1, 7), (TRUNC("a") - TRUNC("a", 'IW') + 1) FROM "t"`,
+
+		`select extract(doy from a), extract(week from a), extract(quarter from a) from t`: `SELECT TO_NUMBER(TO_CHAR("a", 'DDD')), TO_NUMBER(TO_CHAR("a", 'IW')), TO_NUMBER(TO_CHAR("a", 'Q')) FROM "t"`,
+
+		`select extract(epoch from now()) from t`: `SELECT ((CAST(SYS_EXTRACT_UTC(SYSTIMESTAMP) AS DATE) - DATE '1970-01-01') * 86400) FROM "t"`,
+	})
+
+	for _, sql := range []string{
+		`select date_trunc('decade', a) from t`,
+		`select date_trunc($1, a) from t`,
+		`select extract(foo from a) from t`,
+		`select to_char(a, 'ID') from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestConvertDateTimeWarnings(t *testing.T) {
+	for sql, expected := range map[string]string{
+		`select extract(year from age(born)) from t`: `SELECT EXTRACT(YEAR FROM NUMTOYMINTERVAL(TRUNC(MONTHS_BETWEEN(TRUNC(CURRENT_DATE), "born")), 'MONTH')) FROM "t"`,
+		`select age(a, b) from t`:                    `SELECT NUMTOYMINTERVAL(TRUNC(MONTHS_BETWEEN("a", "b")), 'MONTH') FROM "t"`,
+		`select extract(epoch from a) from t`:        `SELECT ((CAST("a" AS DATE) - DATE '1970-01-01') * 86400) FROM "t"`,
+	} {
+		cb := &CustomBuilder{Builder: Oracle()}
+		require.NoError(t, cb.Convert(sql), sql)
+		converted, err := cb.ToBoundSQL()
+		require.NoError(t, err)
+		require.Equal(t, expected, converted, sql)
+		require.Len(t, cb.Warnings(), 1, sql)
+	}
+}
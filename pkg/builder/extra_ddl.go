@@ -0,0 +1,208 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+const (
+	// oracle error codes of an existing and of a missing table.
+	oraNameAlreadyUsed = -955
+	oraTableNotExists  = -942
+)
+
+func (cb *CustomBuilder) convertCreateTable(create *parser.CreateTable) error {
+	if create.As() {
+		return errors.Wrap(NotImplemented, `create table as`)
+	}
+	if create.Interleave != nil {
+		return errors.Wrap(NotImplemented, `interleave`)
+	}
+	name, err := cb.convertNormalizableTableName(&create.Table)
+	if err != nil {
+		return err
+	}
+	defs := make([]string, 0, len(create.Defs))
+	for _, def := range create.Defs {
+		var ds string
+		switch d := def.(type) {
+		case *parser.ColumnTableDef:
+			ds, err = cb.convertColumnDef(d)
+		case *parser.UniqueConstraintTableDef:
+			ds, err = cb.convertUniqueConstraint(d)
+		default:
+			err = errors.Wrapf(NotImplemented, `table definition %s`, formatNode(def))
+		}
+		if err != nil {
+			return err
+		}
+		defs = append(defs, ds)
+	}
+	ifNotExists := ``
+	if create.IfNotExists && cb.version.ifExists() {
+		ifNotExists = `IF NOT EXISTS `
+	}
+	sql := fmt.Sprintf("CREATE TABLE %s%s (\n%s\n)", ifNotExists, name, strings.Join(defs, ",\n"))
+	if create.IfNotExists && !cb.version.ifExists() {
+		sql = ignoreErrorBlock(sql, oraNameAlreadyUsed)
+	}
+	cb.kind = DDLKind
+	cb.CustomSqlStr = sql
+	return nil
+}
+
+func (cb *CustomBuilder) convertColumnDef(def *parser.ColumnTableDef) (string, error) {
+	if len(def.CheckExprs) > 0 || def.References.Table.TableNameReference != nil || def.Family.Name != `` || def.Family.Create {
+		return ``, errors.Wrapf(NotImplemented, `column definition %s`, formatNode(def))
+	}
+	name, err := cb.convertUnresolvedName(parser.UnresolvedName{def.Name})
+	if err != nil {
+		return ``, err
+	}
+	var typ string
+	if t, ok := def.Type.(*parser.IntColType); ok && t.IsSerial() {
+		if cb.version.identity() {
+			typ = `NUMBER GENERATED BY DEFAULT AS IDENTITY`
+		} else {
+			typ = `NUMBER`
+			cb.warnf(`column %s is not an identity column on oracle %s, it needs a sequence`, def.Name, cb.version)
+		}
+	} else if typ, err = cb.convertColumnType(def.Type); err != nil {
+		return ``, err
+	}
+	var builder strings.Builder
+	builder.WriteString(name)
+	builder.WriteByte(' ')
+	builder.WriteString(typ)
+	if def.DefaultExpr.Expr != nil {
+		value, err := cb.getExprDisplayValue(def.DefaultExpr.Expr)
+		if err != nil {
+			return ``, err
+		}
+		builder.WriteString(` DEFAULT `)
+		builder.WriteString(value)
+	}
+	switch def.Nullable.Nullability {
+	case parser.NotNull:
+		constraint, err := cb.constraintName(def.Nullable.ConstraintName)
+		if err != nil {
+			return ``, err
+		}
+		builder.WriteString(constraint)
+		builder.WriteString(` NOT NULL`)
+	case parser.Null:
+		builder.WriteString(` NULL`)
+	}
+	if def.PrimaryKey || def.Unique {
+		constraint, err := cb.constraintName(def.UniqueConstraintName)
+		if err != nil {
+			return ``, err
+		}
+		builder.WriteString(constraint)
+		if def.PrimaryKey {
+			builder.WriteString(` PRIMARY KEY`)
+		} else {
+			builder.WriteString(` UNIQUE`)
+		}
+	}
+	return builder.String(), nil
+}
+
+func (cb *CustomBuilder) convertUniqueConstraint(def *parser.UniqueConstraintTableDef) (string, error) {
+	if def.Storing != nil || def.Interleave != nil {
+		return ``, errors.Wrapf(NotImplemented, `constraint %s`, formatNode(def))
+	}
+	columns := make([]string, 0, len(def.Columns))
+	for _, v := range def.Columns {
+		if v.Direction != parser.DefaultDirection {
+			return ``, errors.Wrapf(NotImplemented, `constraint %s`, formatNode(def))
+		}
+		column, err := cb.convertUnresolvedName(parser.UnresolvedName{v.Column})
+		if err != nil {
+			return ``, err
+		}
+		columns = append(columns, column)
+	}
+	constraint, err := cb.constraintName(def.Name)
+	if err != nil {
+		return ``, err
+	}
+	kind := `UNIQUE`
+	if def.PrimaryKey {
+		kind = `PRIMARY KEY`
+	}
+	return fmt.Sprintf(`%s%s (%s)`, strings.TrimPrefix(constraint+` `, ` `), kind, strings.Join(columns, `, `)), nil
+}
+
+func (cb *CustomBuilder) convertDropTable(drop *parser.DropTable) error {
+	stmts := make([]string, 0, len(drop.Names))
+	for _, v := range drop.Names {
+		un, ok := v.(parser.UnresolvedName)
+		if !ok {
+			return errors.Wrapf(NotImplemented, `drop table %s`, v)
+		}
+		name, err := cb.convertUnresolvedName(un)
+		if err != nil {
+			return err
+		}
+		ifExists := ``
+		if drop.IfExists && cb.version.ifExists() {
+			ifExists = `IF EXISTS `
+		}
+		sql := `DROP TABLE ` + ifExists + name
+		if drop.DropBehavior == parser.DropCascade {
+			sql += ` CASCADE CONSTRAINTS`
+		}
+		if drop.IfExists && !cb.version.ifExists() {
+			sql = ignoreErrorBlock(sql, oraTableNotExists)
+		}
+		stmts = append(stmts, sql)
+	}
+	cb.kind = DDLKind
+	if len(stmts) == 1 {
+		cb.CustomSqlStr = stmts[0]
+		return nil
+	}
+	// oracle drops one table per statement.
+	var builder strings.Builder
+	builder.WriteString("BEGIN\n")
+	for _, v := range stmts {
+		if strings.HasPrefix(v, `BEGIN`) {
+			builder.WriteString(v)
+		} else {
+			fmt.Fprintf(&builder, `EXECUTE IMMEDIATE %s;`, Q(v))
+		}
+		builder.WriteByte('\n')
+	}
+	builder.WriteString(`END;`)
+	cb.CustomSqlStr = builder.String()
+	return nil
+}
+
+// constraintName returns the CONSTRAINT clause naming a constraint, or an
+// empty string for an unnamed one.
+func (cb *CustomBuilder) constraintName(name parser.Name) (string, error) {
+	if name == `` {
+		return ``, nil
+	}
+	quoted, err := cb.convertUnresolvedName(parser.UnresolvedName{name})
+	if err != nil {
+		return ``, err
+	}
+	return ` CONSTRAINT ` + quoted, nil
+}
+
+// ignoreErrorBlock returns a pl/sql block that runs sql and ignores the oracle
+// error code, it emulates IF [NOT] EXISTS.
+func ignoreErrorBlock(sql string, code int) string {
+	return fmt.Sprintf(`BEGIN
+EXECUTE IMMEDIATE %s;
+EXCEPTION
+WHEN OTHERS THEN
+IF SQLCODE != %d THEN
+RAISE;
+END IF;
+END;`, Q(sql), code)
+}
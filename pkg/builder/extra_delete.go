@@ -2,18 +2,48 @@ package builder
 
 import (
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
-	"github.com/pkg/errors"
 )
 
 func (cb *CustomBuilder) convertDelete(delete *parser.Delete) error {
-	if err := cb.convertTable(delete.Table); err != nil {
+	if len(delete.Using) > 0 {
+		if err := cb.convertDeleteUsing(delete); err != nil {
+			return err
+		}
+	} else {
+		if err := cb.convertTable(delete.Table); err != nil {
+			return err
+		}
+		if err := cb.convertWhere(delete.Where); err != nil {
+			return err
+		}
+	}
+	if err := cb.convertReturning(delete.Table, delete.Returning); err != nil {
 		return err
 	}
-	if err := cb.convertWhere(delete.Where); err != nil {
+	return nil
+}
+
+// convertDeleteUsing converts DELETE ... USING to a DELETE whose join with the
+// USING list moves to an EXISTS subquery. The target is aliased by its own
+// name, so that the columns it qualifies are correlated in the subquery.
+func (cb *CustomBuilder) convertDeleteUsing(delete *parser.Delete) error {
+	target, _, err := cb.targetWithAlias(delete.Table)
+	if err != nil {
 		return err
 	}
-	if delete.Returning != nil {
-		return errors.Wrap(NotImplemented, `delete returning`)
+	exists := cb.subBuilder(selectType)
+	exists.Select(`1`)
+	if err := exists.convertFrom(&parser.From{Tables: delete.Using}); err != nil {
+		return err
+	}
+	if err := exists.convertWhere(delete.Where); err != nil {
+		return err
+	}
+	existsSQL, err := exists.rawBoundSQL()
+	if err != nil {
+		return err
 	}
+	cb.From(target)
+	cb.Where(Expr(`EXISTS (` + existsSQL + `)`))
 	return nil
 }
@@ -0,0 +1,20 @@
+package builder
+
+import (
+	"testing"
+)
+
+func TestConvertDeleteUsing(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`delete from a using b where a.id = b.aid and b.k = $1`: `DELETE FROM "a" "a" WHERE EXISTS (SELECT 1 FROM "b" WHERE "a"."id"=("b"."aid") AND "b"."k"=:arg1)`,
+
+		`delete from a as x using b y, c where x.id = y.aid and y.c = c.id`: `DELETE FROM "a" "x" WHERE EXISTS (SELECT 1 FROM "b" "y", "c" WHERE "x"."id"=("y"."aid") AND "y"."c"=("c"."id"))`,
+
+		`delete from a as x using b y where x.id = y.aid returning x.id`: `Savepoint a;
+SELECT "x"."id" FROM "a" "x" WHERE EXISTS (SELECT 1 FROM "b" "y" WHERE "x"."id"=("y"."aid")) FOR UPDATE;
+DELETE FROM "a" "x" WHERE EXISTS (SELECT 1 FROM "b" "y" WHERE "x"."id"=("y"."aid"));
+commit;`,
+
+		`delete from a x where x.id = 1`: `DELETE FROM "a" "x" WHERE "x"."id"=1`,
+	})
+}
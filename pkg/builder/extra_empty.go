@@ -0,0 +1,198 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"strings"
+)
+
+// EmptyStringMode selects what is done with the constructs whose meaning
+// changes because oracle stores the empty string as NULL.
+type EmptyStringMode int
+
+const (
+	// EmptyStringIgnore converts the empty string like any other string.
+	EmptyStringIgnore EmptyStringMode = iota
+	// EmptyStringWarn reports the comparisons, concatenations and COALESCE
+	// calls with the empty string, or with a parameter that may be bound to
+	// the empty string.
+	EmptyStringWarn
+	// EmptyStringRewrite converts a test for equality with the empty string
+	// to IS NULL and a test for inequality to IS NOT NULL, and drops the
+	// empty string from concatenations and COALESCE calls. Parameters are
+	// still reported, their value is only known when the statement runs.
+	EmptyStringRewrite
+)
+
+func isEmptyString(e parser.Expr) bool {
+	switch v := e.(type) {
+	case *parser.StrVal:
+		return v.OriginalString() == ``
+	case *parser.DString:
+		return *v == ``
+	}
+	return false
+}
+
+func isParameter(e parser.Expr) bool {
+	switch v := e.(type) {
+	case *parser.StrVal:
+		return strings.HasPrefix(v.OriginalString(), CustomPlaceHolder)
+	case *parser.DString:
+		return strings.HasPrefix(string(*v), CustomPlaceHolder)
+	}
+	return false
+}
+
+// location returns the postgres text of expr for a warning, with its
+// placeholders written as $n again.
+func location(expr parser.Expr) string {
+	s := formatNode(expr)
+	var b strings.Builder
+	for idx := strings.Index(s, `'`+CustomPlaceHolder); idx != -1; idx = strings.Index(s, `'`+CustomPlaceHolder) {
+		b.WriteString(s[:idx])
+		b.WriteByte('$')
+		s = s[idx+1+len(CustomPlaceHolder):]
+		end := 0
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+		b.WriteString(s[:end])
+		s = strings.TrimPrefix(s[end:], `'`)
+	}
+	return b.String() + s
+}
+
+// emptyStringComparison converts a comparison with the empty string, ok is
+// false when the comparison is converted as usual.
+func (cb *CustomBuilder) emptyStringComparison(expr *parser.ComparisonExpr) (cond Cond, ok bool, err error) {
+	if cb.EmptyString == EmptyStringIgnore {
+		return nil, false, nil
+	}
+	if list, ok := parser.StripParens(expr.Right).(*parser.Tuple); ok {
+		cb.emptyStringList(expr, list)
+		return nil, false, nil
+	}
+	other := expr.Left
+	switch {
+	case isEmptyString(expr.Right):
+	case isEmptyString(expr.Left):
+		other = expr.Right
+	default:
+		if isParameter(expr.Left) || isParameter(expr.Right) {
+			cb.warnAt(location(expr), `a parameter bound to '' is NULL in oracle, the comparison is never true for it`)
+		}
+		return nil, false, nil
+	}
+	if cb.EmptyString == EmptyStringRewrite && (expr.Operator == parser.EQ || expr.Operator == parser.NE) {
+		value, err := cb.getExprDisplayValue(other)
+		if err != nil {
+			return nil, false, err
+		}
+		if expr.Operator == parser.EQ {
+			return IsNull{value}, true, nil
+		}
+		return NotNull{value}, true, nil
+	}
+	cb.warnAt(location(expr), `'' is NULL in oracle, the comparison is never true`)
+	return nil, false, nil
+}
+
+// emptyStringList reports the empty strings and the parameters of the list
+// of values that expr compares with, like that of IN. They are not rewritten,
+// oracle never matches NULL in the list.
+func (cb *CustomBuilder) emptyStringList(expr parser.Expr, list *parser.Tuple) {
+	if cb.EmptyString == EmptyStringIgnore {
+		return
+	}
+	var parameter, empty bool
+	for _, e := range list.Exprs {
+		parameter = parameter || isParameter(e)
+		empty = empty || isEmptyString(e)
+	}
+	if empty {
+		cb.warnAt(location(expr), `'' is NULL in oracle, it is never matched in the list`)
+	}
+	if parameter {
+		cb.warnAt(location(expr), `a parameter bound to '' is NULL in oracle, it is never matched in the list`)
+	}
+}
+
+// emptyStringCase returns the CASE to convert, a CASE that compares its
+// operand with the empty string or a parameter is converted as a searched
+// CASE, whose comparisons are reported or rewritten.
+func (cb *CustomBuilder) emptyStringCase(v *parser.CaseExpr) *parser.CaseExpr {
+	if cb.EmptyString == EmptyStringIgnore || v.Expr == nil {
+		return v
+	}
+	for _, when := range v.Whens {
+		if isEmptyString(when.Cond) || isParameter(when.Cond) {
+			searched := &parser.CaseExpr{Else: v.Else}
+			for _, when := range v.Whens {
+				searched.Whens = append(searched.Whens, &parser.When{
+					Cond: &parser.ComparisonExpr{Operator: parser.EQ, Left: v.Expr, Right: when.Cond},
+					Val:  when.Val,
+				})
+			}
+			return searched
+		}
+	}
+	return v
+}
+
+// emptyStringConcat returns the concatenation to convert, without its empty
+// string operand when it is rewritten.
+func (cb *CustomBuilder) emptyStringConcat(v *parser.BinaryExpr) parser.Expr {
+	if cb.EmptyString == EmptyStringIgnore {
+		return v
+	}
+	if isParameter(v.Left) || isParameter(v.Right) {
+		cb.warnAt(location(v), `a parameter bound to '' is NULL in oracle, the concatenation is NULL where postgres returns ''`)
+	}
+	if !isEmptyString(v.Left) && !isEmptyString(v.Right) {
+		return v
+	}
+	if cb.EmptyString == EmptyStringWarn {
+		cb.warnAt(location(v), `'' is NULL in oracle, the concatenation is NULL where postgres returns ''`)
+		return v
+	}
+	if isEmptyString(v.Left) {
+		return v.Right
+	}
+	return v.Left
+}
+
+// emptyStringCoalesce returns the arguments of COALESCE to convert, without
+// the empty string arguments when they are rewritten.
+func (cb *CustomBuilder) emptyStringCoalesce(v *parser.CoalesceExpr) parser.Exprs {
+	if cb.EmptyString == EmptyStringIgnore {
+		return v.Exprs
+	}
+	var parameter, empty bool
+	exprs := make(parser.Exprs, 0, len(v.Exprs))
+	for _, e := range v.Exprs {
+		parameter = parameter || isParameter(e)
+		if isEmptyString(e) {
+			empty = true
+			if cb.EmptyString == EmptyStringRewrite {
+				continue
+			}
+		}
+		exprs = append(exprs, e)
+	}
+	if parameter {
+		cb.warnAt(location(v), `a parameter bound to '' is NULL in oracle, %s returns NULL for it`, v.Name)
+	}
+	if empty && cb.EmptyString == EmptyStringWarn {
+		cb.warnAt(location(v), `'' is NULL in oracle, %s returns NULL instead of ''`, v.Name)
+	}
+	if len(exprs) == 0 {
+		exprs = append(exprs, parser.DNull)
+	}
+	return exprs
+}
+
+func (cb *CustomBuilder) warnAt(location string, format string, args ...interface{}) {
+	st := cb.getState()
+	st.warnings = append(st.warnings, Warning{Message: fmt.Sprintf(format, args...), Location: location})
+}
@@ -0,0 +1,57 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateEmptyStringWarn(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{EmptyString: EmptyStringWarn})
+	res, err := tr.Translate(context.Background(), `select coalesce(a, '') || b from t where nick = '' or c = $1`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT NVL("a", '') || "b" FROM "t" WHERE "nick"='' OR "c"=:arg1`, res.SQL)
+	require.Equal(t, []Warning{
+		{Message: `'' is NULL in oracle, COALESCE returns NULL instead of ''`, Location: `COALESCE(a, '')`},
+		{Message: `'' is NULL in oracle, the comparison is never true`, Location: `nick = ''`},
+		{Message: `a parameter bound to '' is NULL in oracle, the comparison is never true for it`, Location: `c = $1`},
+	}, res.Warnings)
+
+	res, err = NewTranslator(TranslatorOptions{}).Translate(context.Background(), `select a from t where nick = ''`)
+	require.NoError(t, err)
+	require.Empty(t, res.Warnings)
+}
+
+func TestTranslateEmptyStringRewrite(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{EmptyString: EmptyStringRewrite})
+	for sql, expected := range map[string]string{
+		`select a from t where nick = '' or '' <> b`:                                  `SELECT "a" FROM "t" WHERE "nick" IS NULL OR "b" IS NOT NULL`,
+		`select coalesce(a, '') || '-' || coalesce(b, ''), coalesce('') from t`:       `SELECT "a" || '-' || "b", NULL FROM "t"`,
+		`select a || '' from t where coalesce(b, '') = ''`:                            `SELECT "a" FROM "t" WHERE "b" IS NULL`,
+		`select case when a = '' then 1 end, case b when '' then 1 else 2 end from t`: `SELECT CASE WHEN "a" IS NULL THEN 1 END, CASE WHEN "b" IS NULL THEN 1 ELSE 2 END FROM "t"`,
+	} {
+		res, err := tr.Translate(context.Background(), sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, expected, res.SQL, sql)
+		require.Empty(t, res.Warnings, sql)
+	}
+
+	res, err := tr.Translate(context.Background(), `select a || $1 from t where b > ''`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "a" || :arg1 FROM "t" WHERE "b">''`, res.SQL)
+	require.Len(t, res.Warnings, 2)
+}
+
+func TestTranslateEmptyStringLists(t *testing.T) {
+	for _, mode := range []EmptyStringMode{EmptyStringWarn, EmptyStringRewrite} {
+		tr := NewTranslator(TranslatorOptions{EmptyString: mode})
+		res, err := tr.Translate(context.Background(), `select a from t where b in ('', 'x') and c not in ($1) and d = any (select e from s)`)
+		require.NoError(t, err)
+		require.Equal(t, `SELECT "a" FROM "t" WHERE ("b" IN ('', 'x')) AND ("c" NOT IN (:arg1)) AND ("d" = ANY (SELECT "e" FROM "s"))`, res.SQL)
+		require.Equal(t, []Warning{
+			{Message: `'' is NULL in oracle, it is never matched in the list`, Location: `b IN ('', 'x')`},
+			{Message: `a parameter bound to '' is NULL in oracle, it is never matched in the list`, Location: `c NOT IN ($1)`},
+		}, res.Warnings)
+	}
+}
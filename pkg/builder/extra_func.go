@@ -0,0 +1,328 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
+	"sync"
+)
+
+// AnyArity registers the translation of a function for any number of
+// arguments, it is used when no translation is registered for the exact
+// number of arguments of a call.
+const AnyArity = -1
+
+// FuncCall is a call of a postgres function whose arguments are already
+// converted to oracle.
+type FuncCall struct {
+	// Name is the lower case name of the postgres function.
+	Name string
+	Args []string
+	// Distinct is set for an aggregate call like count(DISTINCT x).
+	Distinct bool
+	// OrderBy are the converted items of the ORDER BY inside of an aggregate
+	// call, like string_agg(a, ',' ORDER BY b DESC).
+	OrderBy []string
+	// Filter is the converted condition of FILTER (WHERE ...) of an aggregate
+	// call, translations apply it with Filtered.
+	Filter string
+	// Over is the converted OVER clause of a window function or of an
+	// aggregate over a window, translations apply it with Windowed.
+	Over string
+	// Version is the oracle version the call is converted for.
+	Version OracleVersion
+
+	// values are the arguments before they are written, an interval or a
+	// difference of timestamps is kept as is.
+	values   []interface{}
+	filtered bool
+	windowed bool
+	warnings []string
+}
+
+// Filtered returns arg for the rows that match the FILTER of the call and
+// NULL for the other rows, which aggregates skip. A star is counted as 1.
+func (c *FuncCall) Filtered(arg string) string {
+	if c.Filter == `` {
+		return arg
+	}
+	c.filtered = true
+	if arg == `*` {
+		arg = `1`
+	}
+	return fmt.Sprintf(`CASE WHEN %s THEN %s END`, c.Filter, arg)
+}
+
+// Windowed returns the call sql over the window of the call.
+func (c *FuncCall) Windowed(sql string) string {
+	if c.Over == `` {
+		return sql
+	}
+	c.windowed = true
+	return sql + ` ` + c.Over
+}
+
+// Warnf reports that the translation does not behave exactly like the
+// postgres function.
+func (c *FuncCall) Warnf(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
+}
+
+// FuncTranslator returns the oracle translation of a function call.
+type FuncTranslator func(call *FuncCall) (string, error)
+
+// Template returns a FuncTranslator that writes tmpl, replacing $1 ... $n by
+// the arguments of the call and $* by all of them separated by commas, $$
+// writes a dollar sign.
+func Template(tmpl string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		if call.Distinct {
+			return ``, errors.Wrapf(NotImplemented, `%s(DISTINCT ...)`, call.Name)
+		}
+		var builder strings.Builder
+		for i := 0; i < len(tmpl); i++ {
+			if tmpl[i] != '$' || i == len(tmpl)-1 {
+				builder.WriteByte(tmpl[i])
+				continue
+			}
+			switch c := tmpl[i+1]; {
+			case c == '$':
+				builder.WriteByte('$')
+				i++
+			case c == '*':
+				builder.WriteString(strings.Join(call.Args, `, `))
+				i++
+			case c >= '0' && c <= '9':
+				n := 0
+				for i+1 < len(tmpl) && tmpl[i+1] >= '0' && tmpl[i+1] <= '9' {
+					n = n*10 + int(tmpl[i+1]-'0')
+					i++
+				}
+				if n == 0 || n > len(call.Args) {
+					return ``, errors.Errorf(`template %s of %s: no argument $%d`, tmpl, call.Name, n)
+				}
+				builder.WriteString(call.Args[n-1])
+			default:
+				builder.WriteByte('$')
+			}
+		}
+		return builder.String(), nil
+	}
+}
+
+// FuncRegistry maps postgres functions, by name and number of arguments, to
+// their oracle translation.
+// It is safe for concurrent use.
+type FuncRegistry struct {
+	mu    sync.RWMutex
+	funcs map[string]map[int]FuncTranslator
+}
+
+// NewFuncRegistry creates a FuncRegistry holding the default translations.
+func NewFuncRegistry() *FuncRegistry {
+	r := &FuncRegistry{funcs: make(map[string]map[int]FuncTranslator)}
+	registerDefaultFuncs(r)
+	return r
+}
+
+// Register sets the translation of the function name called with arity
+// arguments, replacing a registered one.
+func (r *FuncRegistry) Register(name string, arity int, t FuncTranslator) {
+	name = strings.ToLower(name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.funcs == nil {
+		r.funcs = make(map[string]map[int]FuncTranslator)
+	}
+	if r.funcs[name] == nil {
+		r.funcs[name] = make(map[int]FuncTranslator)
+	}
+	r.funcs[name][arity] = t
+}
+
+// Lookup returns the translation of the function name called with arity
+// arguments.
+func (r *FuncRegistry) Lookup(name string, arity int) (FuncTranslator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	byArity := r.funcs[strings.ToLower(name)]
+	if t, ok := byArity[arity]; ok {
+		return t, true
+	}
+	t, ok := byArity[AnyArity]
+	return t, ok
+}
+
+// Clone returns a copy of r, registering in the copy does not change r.
+func (r *FuncRegistry) Clone() *FuncRegistry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c := &FuncRegistry{funcs: make(map[string]map[int]FuncTranslator, len(r.funcs))}
+	for name, byArity := range r.funcs {
+		c.funcs[name] = make(map[int]FuncTranslator, len(byArity))
+		for arity, t := range byArity {
+			c.funcs[name][arity] = t
+		}
+	}
+	return c
+}
+
+// DefaultFuncRegistry translates the functions of builders without a
+// FuncRegistry.
+var DefaultFuncRegistry = NewFuncRegistry()
+
+// RegisterFunc registers a translation in DefaultFuncRegistry, applications
+// call it at startup, before statements are converted.
+func RegisterFunc(name string, arity int, t FuncTranslator) {
+	DefaultFuncRegistry.Register(name, arity, t)
+}
+
+func registerDefaultFuncs(r *FuncRegistry) {
+	for name, tmpl := range map[string]string{
+		`abs`:      `ABS($1)`,
+		`ceil`:     `CEIL($1)`,
+		`ceiling`:  `CEIL($1)`,
+		`floor`:    `FLOOR($1)`,
+		`round`:    `ROUND($1)`,
+		`trunc`:    `TRUNC($1)`,
+		`sqrt`:     `SQRT($1)`,
+		`exp`:      `EXP($1)`,
+		`ln`:       `LN($1)`,
+		`log`:      `LOG(10, $1)`,
+		`sign`:     `SIGN($1)`,
+		`length`:   `LENGTH($1)`,
+		`lower`:    `LOWER($1)`,
+		`upper`:    `UPPER($1)`,
+		`coalesce`: `$1`,
+	} {
+		r.Register(name, 1, Template(tmpl))
+	}
+	for name, tmpl := range map[string]string{
+		`round`:    `ROUND($1, $2)`,
+		`trunc`:    `TRUNC($1, $2)`,
+		`mod`:      `MOD($1, $2)`,
+		`power`:    `POWER($1, $2)`,
+		`pow`:      `POWER($1, $2)`,
+		`log`:      `LOG($1, $2)`,
+		`coalesce`: `NVL($1, $2)`,
+		`ifnull`:   `NVL($1, $2)`,
+		`strpos`:   `INSTR($1, $2)`,
+	} {
+		r.Register(name, 2, Template(tmpl))
+	}
+	r.Register(`coalesce`, AnyArity, Template(`COALESCE($*)`))
+	r.Register(`greatest`, AnyArity, nullPropagating(`GREATEST`))
+	r.Register(`least`, AnyArity, nullPropagating(`LEAST`))
+	r.Register(`random`, 0, Template(`DBMS_RANDOM.VALUE`))
+	registerAggregateFuncs(r)
+	registerWindowFuncs(r)
+	registerStringFuncs(r)
+	registerDateTimeFuncs(r)
+}
+
+// nullPropagating translates GREATEST and LEAST, which ignore NULL arguments
+// in postgres and return NULL for them in oracle.
+func nullPropagating(name string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		if len(call.Args) == 0 {
+			return ``, errors.Errorf(`%s needs arguments`, call.Name)
+		}
+		if len(call.Args) > 1 {
+			call.Warnf(`%s returns NULL when an argument is NULL in oracle`, name)
+		}
+		return fmt.Sprintf(`%s(%s)`, name, strings.Join(call.Args, `, `)), nil
+	}
+}
+
+// funcRegistry returns the registry that translates the functions of cb.
+func (cb *CustomBuilder) funcRegistry() *FuncRegistry {
+	if cb.Funcs != nil {
+		return cb.Funcs
+	}
+	return DefaultFuncRegistry
+}
+
+func (cb *CustomBuilder) convertFunc(expr *parser.FuncExpr) (Cond, error) {
+	call := &FuncCall{
+		// keywords like left are quoted in the name of the function.
+		Name:     parser.AsStringWithFlags(expr.Func, parser.FmtBareIdentifiers),
+		Distinct: expr.Type == parser.DistinctFuncType,
+	}
+	var err error
+	if call.OrderBy, err = cb.orderByItems(expr.OrderBy); err != nil {
+		return nil, err
+	}
+	if expr.Filter != nil {
+		cond, err := cb.convertExprToCond(expr.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if call.Filter, err = ToBoundSQL(cond); err != nil {
+			return nil, err
+		}
+	}
+	if expr.WindowDef != nil {
+		if call.Over, err = cb.convertWindow(expr.WindowDef); err != nil {
+			return nil, err
+		}
+	}
+	return cb.translateCall(call, expr.Exprs)
+}
+
+// translateCall converts the arguments of a function call and translates the
+// call through the function registry.
+func (cb *CustomBuilder) translateCall(call *FuncCall, exprs parser.Exprs) (Cond, error) {
+	call.Name = strings.ToLower(call.Name)
+	call.Version = cb.version
+	call.Args = make([]string, 0, len(exprs))
+	call.values = make([]interface{}, 0, len(exprs))
+	translate, ok := cb.funcRegistry().Lookup(call.Name, len(exprs))
+	if !ok {
+		return nil, errors.Wrapf(NotImplemented, `function %s with %d arguments`, call.Name, len(exprs))
+	}
+	for _, v := range exprs {
+		if isStar(v) {
+			call.Args = append(call.Args, `*`)
+			call.values = append(call.values, `*`)
+			continue
+		}
+		// the parentheses of an argument are redundant.
+		for p, ok := v.(*parser.ParenExpr); ok; p, ok = v.(*parser.ParenExpr) {
+			v = p.Expr
+		}
+		var value interface{}
+		var err error
+		if isPredicate(v) {
+			value, err = cb.convertPredicate(v)
+		} else {
+			value, err = cb.operand(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		call.values = append(call.values, value)
+		if value, err = settle(value, nil); err != nil {
+			return nil, err
+		}
+		if p, ok := value.(predicate); ok {
+			call.Args = append(call.Args, cb.boolValue(p))
+			continue
+		}
+		call.Args = append(call.Args, getDisplayValue(value))
+	}
+	sql, err := translate(call)
+	if err != nil {
+		return nil, err
+	}
+	if call.Filter != `` && !call.filtered {
+		return nil, errors.Wrapf(NotImplemented, `%s with FILTER`, call.Name)
+	}
+	if call.Over != `` && !call.windowed {
+		return nil, errors.Wrapf(NotImplemented, `%s over a window`, call.Name)
+	}
+	for _, w := range call.warnings {
+		cb.warnf(`%s`, w)
+	}
+	return Expr(sql), nil
+}
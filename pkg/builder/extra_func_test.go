@@ -0,0 +1,65 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertFuncs(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select count(*), count(distinct a), max(d) from t`:                        `SELECT COUNT(*), COUNT(DISTINCT "a"), MAX("d") FROM "t"`,
+		`select coalesce(a, b), coalesce(a, b, $1), ifnull(a, 1) from t`:           `SELECT NVL("a", "b"), COALESCE("a", "b", :arg1), NVL("a", 1) FROM "t"`,
+		`select lower(upper(a)) from t where length(a) > 3`:                        `SELECT LOWER(UPPER("a")) FROM "t" WHERE LENGTH("a")>3`,
+		`select position($1 in b), substring(a from 2 for 3), substr(a, 2) from t`: `SELECT INSTR("b", :arg1), SUBSTR("a", 2, 3), SUBSTR("a", 2) FROM "t"`,
+		`select random(), round(a, 2), log(a), mod(a, 3) from t`:                   `SELECT DBMS_RANDOM.VALUE, ROUND("a", 2), LOG(10, "a"), MOD("a", 3) FROM "t"`,
+		`select extract(year from now()) - extract(year from born) from t`:         `SELECT EXTRACT(YEAR FROM SYSTIMESTAMP) - EXTRACT(YEAR FROM "born") FROM "t"`,
+	})
+
+	for _, sql := range []string{`select foo(a) from t`, `select lower(a, b) from t`, `select lower(distinct a) from t`} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestConvertFuncWarning(t *testing.T) {
+	cb := &CustomBuilder{Builder: Oracle()}
+	require.NoError(t, cb.Convert(`select greatest(a, b) from t`))
+	sql, err := cb.ToBoundSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT GREATEST("a", "b") FROM "t"`, sql)
+	require.Len(t, cb.Warnings(), 1)
+}
+
+func TestTemplate(t *testing.T) {
+	call := &FuncCall{Name: `f`, Args: []string{`a`, `b`}}
+	sql, err := Template(`F($2, $1, $*) $$`)(call)
+	require.NoError(t, err)
+	require.Equal(t, `F(b, a, a, b) $`, sql)
+	_, err = Template(`F($3)`)(call)
+	require.Error(t, err)
+}
+
+func TestFuncRegistry(t *testing.T) {
+	r := NewFuncRegistry().Clone()
+	r.Register(`soundex`, 1, Template(`SOUNDEX($1)`))
+	r.Register(`to_json`, AnyArity, func(call *FuncCall) (string, error) {
+		if call.Version < Oracle19c {
+			return ``, fmt.Errorf(`to_json needs oracle 19c`)
+		}
+		return `JSON_OBJECT(` + strings.Join(call.Args, `, `) + `)`, nil
+	})
+	_, ok := DefaultFuncRegistry.Lookup(`soundex`, 1)
+	require.False(t, ok)
+
+	tr := NewTranslator(TranslatorOptions{Funcs: r, Version: Oracle19c})
+	res, err := tr.Translate(context.Background(), `select soundex(a), to_json(a, b) from t`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT SOUNDEX("a"), JSON_OBJECT("a", "b") FROM "t"`, res.SQL)
+
+	_, err = NewTranslator(TranslatorOptions{Funcs: r}).Translate(context.Background(), `select to_json(a) from t`)
+	require.Error(t, err)
+}
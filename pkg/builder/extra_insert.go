@@ -3,25 +3,286 @@ package builder
 import (
 	"fmt"
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
 )
 
+// MultiRowInsertMode selects how an insert of several rows is written.
+type MultiRowInsertMode int
+
+const (
+	// InsertAll writes INSERT ALL INTO ... VALUES ... SELECT 1 FROM DUAL.
+	InsertAll MultiRowInsertMode = iota
+	// InsertSelectUnionAll writes INSERT INTO ... SELECT ... FROM DUAL UNION ALL ...
+	InsertSelectUnionAll
+)
+
+// DefaultInsertBatchSize is the default maximum number of rows written by one
+// insert statement.
+const DefaultInsertBatchSize = 500
+
+// defaultValue marks a DEFAULT in the values of an insert.
+type defaultValue struct{}
+
 func (cb *CustomBuilder) convertInsert(insert *parser.Insert) error {
 	if err := cb.convertTable(insert.Table); err != nil {
 		return err
 	}
 	for _, v := range insert.Columns {
-		cl, err := convertUnresolvedName(v)
+		cl, err := cb.convertUnresolvedName(v)
 		if err != nil {
 			return err
 		}
 		cb.insertCols = append(cb.insertCols, cl)
 	}
+	if insert.Rows != nil && insert.Rows.Select != nil {
+		if _, ok := insert.Rows.Select.(*parser.ValuesClause); !ok {
+			return cb.convertInsertSelect(insert)
+		}
+	}
 	if _, err := cb.convertSelect(insert.Rows); err != nil {
 		return err
 	}
+	if len(cb.insertRows) > 1 && insert.OnConflict == nil {
+		sql, err := cb.convertMultiRowInsert()
+		if err != nil {
+			return err
+		}
+		cb.CustomSqlStr = sql
+	}
+	return nil
+}
+
+// convertInsertSelect converts the query of an insert ... select. The query
+// is the source of the merge of an upsert, so its columns are renamed to the
+// insert columns.
+func (cb *CustomBuilder) convertInsertSelect(insert *parser.Insert) error {
+	ncb := cb.subBuilder(selectType)
+	if insert.OnConflict != nil {
+		if len(cb.insertCols) == 0 {
+			return errors.New(`on conflict with a query needs insert columns`)
+		}
+		if selectsStar(insert.Rows) {
+			cb.warnf(`the columns of the query inserted into %s are not renamed, they must be named like the insert columns`, cb.into)
+		} else {
+			ncb.selectAliases = cb.insertCols
+		}
+	}
+	if _, err := ncb.convertSelect(insert.Rows); err != nil {
+		return err
+	}
+	sql, err := ncb.rawBoundSQL()
+	if err != nil {
+		return err
+	}
+	cb.insertSelect = sql
+	cb.CustomSqlStr = fmt.Sprintf(`INSERT INTO %s `, cb.into)
+	if len(cb.insertCols) > 0 {
+		cb.CustomSqlStr += fmt.Sprintf(`(%s) `, strings.Join(cb.insertCols, `, `))
+	}
+	cb.CustomSqlStr += sql
+	return nil
+}
+
+// selectsStar reports whether the columns of the query are selected by a star.
+func selectsStar(slt *parser.Select) bool {
+	clause := firstSelectClause(slt.Select)
+	if clause == nil {
+		return false
+	}
+	for _, v := range clause.Exprs {
+		if isStar(v.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStar reports whether expr is a star, qualified or not.
+func isStar(expr parser.Expr) bool {
+	switch e := expr.(type) {
+	case parser.UnqualifiedStar, *parser.AllColumnsSelector:
+		return true
+	case parser.UnresolvedName:
+		_, ok := e[len(e)-1].(parser.UnqualifiedStar)
+		return ok
+	}
+	return false
+}
+
+func (cb *CustomBuilder) convertValues(values *parser.ValuesClause) error {
+	if len(values.Tuples) == 0 {
+		return errors.New(`no values`)
+	}
+	for _, tuple := range values.Tuples {
+		if len(cb.insertCols) > 0 && len(tuple.Exprs) != len(cb.insertCols) {
+			return errors.Errorf(`%d values for %d insert columns`, len(tuple.Exprs), len(cb.insertCols))
+		}
+		row := make([]interface{}, 0, len(tuple.Exprs))
+		for _, v := range tuple.Exprs {
+			if _, ok := v.(parser.DefaultVal); ok {
+				row = append(row, defaultValue{})
+				continue
+			}
+			value, err := cb.getValueFromExpr(v)
+			if err != nil {
+				return err
+			}
+			row = append(row, value)
+		}
+		cb.insertRows = append(cb.insertRows, row)
+	}
+	if len(cb.insertRows) == 1 {
+		cols, vals, err := withoutDefaults(cb.insertCols, cb.insertRows[0])
+		if err != nil {
+			return err
+		}
+		cb.insertCols, cb.insertVals = cols, vals
+	}
 	return nil
 }
 
+// withoutDefaults drops the columns whose value is DEFAULT, oracle then fills
+// them with their default.
+func withoutDefaults(cols []string, row []interface{}) ([]string, []interface{}, error) {
+	hasDefault := false
+	for _, v := range row {
+		if _, ok := v.(defaultValue); ok {
+			hasDefault = true
+			break
+		}
+	}
+	if !hasDefault {
+		return cols, row, nil
+	}
+	if len(cols) == 0 {
+		return nil, nil, errors.New(`DEFAULT values need insert columns`)
+	}
+	outCols := make([]string, 0, len(cols))
+	outVals := make([]interface{}, 0, len(row))
+	for k, v := range row {
+		if _, ok := v.(defaultValue); ok {
+			continue
+		}
+		outCols = append(outCols, cols[k])
+		outVals = append(outVals, v)
+	}
+	if len(outCols) == 0 {
+		return nil, nil, errors.New(`values with only DEFAULT are not supported`)
+	}
+	return outCols, outVals, nil
+}
+
+func (cb *CustomBuilder) insertBatchSize() int {
+	if cb.InsertBatchSize > 0 {
+		return cb.InsertBatchSize
+	}
+	return DefaultInsertBatchSize
+}
+
+// convertMultiRowInsert writes the insert of several rows. When the rows do not
+// fit in one batch every batch becomes a statement of a pl/sql block.
+func (cb *CustomBuilder) convertMultiRowInsert() (string, error) {
+	size := cb.insertBatchSize()
+	stmts := make([]string, 0, len(cb.insertRows)/size+1)
+	for start := 0; start < len(cb.insertRows); start += size {
+		end := start + size
+		if end > len(cb.insertRows) {
+			end = len(cb.insertRows)
+		}
+		var sql string
+		var err error
+		if cb.MultiRowInsert == InsertSelectUnionAll {
+			sql, err = cb.insertSelectUnionAll(cb.insertRows[start:end])
+		} else {
+			sql, err = cb.insertAll(cb.insertRows[start:end])
+		}
+		if err != nil {
+			return ``, err
+		}
+		stmts = append(stmts, sql)
+	}
+	if len(stmts) == 1 {
+		return stmts[0], nil
+	}
+	return "BEGIN\n" + strings.Join(stmts, ";\n") + ";\nEND;", nil
+}
+
+func (cb *CustomBuilder) insertAll(rows [][]interface{}) (string, error) {
+	var builder strings.Builder
+	builder.WriteString("INSERT ALL\n")
+	for _, row := range rows {
+		cols, vals, err := withoutDefaults(cb.insertCols, row)
+		if err != nil {
+			return ``, err
+		}
+		fmt.Fprintf(&builder, `INTO %s `, cb.into)
+		if len(cols) > 0 {
+			fmt.Fprintf(&builder, `(%s) `, strings.Join(cols, `, `))
+		}
+		fmt.Fprintf(&builder, "VALUES (%s)\n", joinDisplayValues(vals))
+	}
+	builder.WriteString(`SELECT 1 FROM DUAL`)
+	return builder.String(), nil
+}
+
+// insertSelectUnionAll writes the rows as a union of selects from dual, which
+// needs the same columns in every row. Columns that are DEFAULT in all rows
+// are left out, rows that differ fall back to insertAll.
+func (cb *CustomBuilder) insertSelectUnionAll(rows [][]interface{}) (string, error) {
+	keep := make([]bool, len(rows[0]))
+	for k := range keep {
+		defaults := 0
+		for _, row := range rows {
+			if _, ok := row[k].(defaultValue); ok {
+				defaults++
+			}
+		}
+		if defaults != 0 && defaults != len(rows) {
+			cb.warnf(`DEFAULT is not used by all rows of the insert into %s, INSERT ALL is used instead of UNION ALL`, cb.into)
+			return cb.insertAll(rows)
+		}
+		keep[k] = defaults == 0
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, `INSERT INTO %s `, cb.into)
+	if len(cb.insertCols) > 0 {
+		cols := make([]string, 0, len(cb.insertCols))
+		for k, v := range cb.insertCols {
+			if keep[k] {
+				cols = append(cols, v)
+			}
+		}
+		if len(cols) == 0 {
+			return ``, errors.New(`values with only DEFAULT are not supported`)
+		}
+		fmt.Fprintf(&builder, `(%s)`, strings.Join(cols, `, `))
+	} else if _, _, err := withoutDefaults(nil, rows[0]); err != nil {
+		return ``, err
+	}
+	for k, row := range rows {
+		vals := make([]interface{}, 0, len(row))
+		for i, v := range row {
+			if keep[i] {
+				vals = append(vals, v)
+			}
+		}
+		if k > 0 {
+			builder.WriteString(` UNION ALL`)
+		}
+		fmt.Fprintf(&builder, "\nSELECT %s FROM DUAL", joinDisplayValues(vals))
+	}
+	return builder.String(), nil
+}
+
+func joinDisplayValues(vals []interface{}) string {
+	strs := make([]string, len(vals))
+	for k, v := range vals {
+		strs[k] = getDisplayValue(v)
+	}
+	return strings.Join(strs, `, `)
+}
+
 func (cb *CustomBuilder) getInsertValuesByCols(cols []string) ([]interface{}, error) {
 	out := make([]interface{}, 0, len(cols))
 	m := map[string]interface{}{}
@@ -0,0 +1,146 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func convertBy(cb *CustomBuilder, sql string) (string, error) {
+	if err := cb.Convert(sql); err != nil {
+		return ``, err
+	}
+	return cb.ToBoundSQL()
+}
+
+func TestConvertMultiRowInsert(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`insert into t (a, b) values (1, $1), (2, default), ($2, 'x')`: `INSERT ALL
+INTO "t" ("a", "b") VALUES (1, :arg1)
+INTO "t" ("a") VALUES (2)
+INTO "t" ("a", "b") VALUES (:arg2, 'x')
+SELECT 1 FROM DUAL`,
+
+		`insert into t values (1, null), (3, 4)`: `INSERT ALL
+INTO "t" VALUES (1, NULL)
+INTO "t" VALUES (3, 4)
+SELECT 1 FROM DUAL`,
+
+		`insert into t (a, b) values (default, 2)`: `INSERT INTO "t" ("b") Values (2)`,
+	})
+
+	_, err := convert(`insert into t (a, b) values (1, 2), (3)`)
+	require.Error(t, err)
+	_, err = convert(`insert into t values (1, default), (3, 4)`)
+	require.Error(t, err)
+}
+
+func TestConvertMultiRowInsertUnionAll(t *testing.T) {
+	cb := &CustomBuilder{Builder: Oracle(), MultiRowInsert: InsertSelectUnionAll}
+	sql, err := convertBy(cb, `insert into t (a, b, c) values (1, $1, default), (2, 'x', default)`)
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "t" ("a", "b")
+SELECT 1, :arg1 FROM DUAL UNION ALL
+SELECT 2, 'x' FROM DUAL`, sql)
+	require.Empty(t, cb.Warnings())
+
+	cb = &CustomBuilder{Builder: Oracle(), MultiRowInsert: InsertSelectUnionAll}
+	sql, err = convertBy(cb, `insert into t (a, b) values (1, default), (2, 3)`)
+	require.NoError(t, err)
+	require.Equal(t, `INSERT ALL
+INTO "t" ("a") VALUES (1)
+INTO "t" ("a", "b") VALUES (2, 3)
+SELECT 1 FROM DUAL`, sql)
+	require.Len(t, cb.Warnings(), 1)
+}
+
+func TestConvertMultiRowInsertBatches(t *testing.T) {
+	cb := &CustomBuilder{Builder: Oracle(), InsertBatchSize: 2}
+	sql, err := convertBy(cb, `insert into t (a) values ($1), ($2), ($3)`)
+	require.NoError(t, err)
+	require.Equal(t, `BEGIN
+INSERT ALL
+INTO "t" ("a") VALUES (:arg1)
+INTO "t" ("a") VALUES (:arg2)
+SELECT 1 FROM DUAL;
+INSERT ALL
+INTO "t" ("a") VALUES (:arg3)
+SELECT 1 FROM DUAL;
+END;`, sql)
+}
+
+func TestConvertInsertSelect(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`insert into t (a, b) select x, y from s where z = $1`: `INSERT INTO "t" ("a", "b") SELECT "x", "y" FROM "s" WHERE "z"=:arg1`,
+
+		`insert into t (a, b) select x, y from s on conflict (a) do update set b = 1`: `MERGE INTO "t" "t"
+USING (SELECT "x" "a", "y" "b" FROM "s") "s"
+ON ("t"."a" = "s"."a")
+WHEN MATCHED THEN
+UPDATE SET "b" = 1
+WHEN NOT MATCHED THEN
+INSERT ("a","b") VALUES("s"."a", "s"."b")`,
+
+		`insert into t (a, b, c) values (1, $1, default), (2, 'x', default) on conflict (a) do nothing`: `MERGE INTO "t" "t"
+USING (select 1 "a", :arg1 "b" FROM DUAL
+UNION ALL select 2 "a", 'x' "b" FROM DUAL) "s"
+ON ("t"."a" = "s"."a")
+WHEN NOT MATCHED THEN
+INSERT ("a","b") VALUES("s"."a", "s"."b")`,
+	})
+
+	_, err := convert(`insert into t (a, b) values (1, default), (2, 3) on conflict (a) do nothing`)
+	require.Error(t, err)
+	_, err = convert(`insert into t select x from s on conflict (a) do nothing`)
+	require.Error(t, err)
+	_, err = convert(`insert into t (a) select x from s returning a`)
+	require.Error(t, err)
+}
+
+func TestConvertOnConflict(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`insert into t (a, b, c) values (1, $1, 3) on conflict (a, b) do update set c = excluded.c + t.c, d = 'x' where t.c < excluded.c`: `MERGE INTO "t" "t"
+USING (select 1 "a", :arg1 "b", 3 "c" FROM DUAL) "s"
+ON ("t"."a" = "s"."a" AND "t"."b" = "s"."b")
+WHEN MATCHED THEN
+UPDATE SET "c" = "s"."c" + "t"."c", "d" = 'x' WHERE "t"."c"<("s"."c")
+WHEN NOT MATCHED THEN
+INSERT ("a","b","c") VALUES("s"."a", "s"."b", "s"."c")`,
+
+		`insert into t as o (a, c) select x, y from s on conflict (a) do update set (c) = (excluded.c) where o.c is null`: `MERGE INTO "t" "t"
+USING (SELECT "x" "a", "y" "c" FROM "s") "s"
+ON ("t"."a" = "s"."a")
+WHEN MATCHED THEN
+UPDATE SET "c" = "s"."c" WHERE "t"."c" IS NULL
+WHEN NOT MATCHED THEN
+INSERT ("a","c") VALUES("s"."a", "s"."c")`,
+
+		`insert into t (a, c) values (1, 2) on conflict (a) do update set c = c + 1 where c < 10`: `MERGE INTO "t" "t"
+USING (select 1 "a", 2 "c" FROM DUAL) "s"
+ON ("t"."a" = "s"."a")
+WHEN MATCHED THEN
+UPDATE SET "c" = "t"."c" + 1 WHERE "t"."c"<10
+WHEN NOT MATCHED THEN
+INSERT ("a","c") VALUES("s"."a", "s"."c")`,
+
+		`insert into t (a, c) values (1, 2) on conflict (a) do update set c = (select max(x) from o where o.k = excluded.a and o.c = t.c)`: `MERGE INTO "t" "t"
+USING (select 1 "a", 2 "c" FROM DUAL) "s"
+ON ("t"."a" = "s"."a")
+WHEN MATCHED THEN
+UPDATE SET "c" = (SELECT MAX("x") FROM "o" WHERE "o"."k"=("s"."a") AND "o"."c"=("t"."c"))
+WHEN NOT MATCHED THEN
+INSERT ("a","c") VALUES("s"."a", "s"."c")`,
+	})
+
+	_, err := convert(`insert into t (a, c) values (1, 2) on conflict (a) do update set a = excluded.a`)
+	require.Error(t, err)
+
+	cb := &CustomBuilder{Builder: Oracle(), NullSafeConflict: true}
+	sql, err := convertBy(cb, `insert into t (a, b) values (1, 2) on conflict (a) do nothing`)
+	require.NoError(t, err)
+	require.Equal(t, `MERGE INTO "t" "t"
+USING (select 1 "a", 2 "b" FROM DUAL) "s"
+ON ("t"."a" = "s"."a" OR ("t"."a" IS NULL AND "s"."a" IS NULL))
+WHEN NOT MATCHED THEN
+INSERT ("a","b") VALUES("s"."a", "s"."b")`, sql)
+}
@@ -0,0 +1,344 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/EchoUtopia/pg2oracle/pkg/postgres/util/duration"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	nanosInSecond = int64(time.Second)
+	// oracle days always have 24 hours.
+	nanosInDay = 24 * int64(time.Hour)
+)
+
+// interval is a postgres interval, it is kept apart from the other values of
+// an expression until it is written so that date arithmetic can be converted.
+type interval struct {
+	duration.Duration
+	// factor multiplies the interval, like n in interval '1 day' * n.
+	factor string
+}
+
+// difference is the subtraction of two values that are not intervals, which
+// yields an interval for timestamps, like in oracle.
+type difference struct {
+	left, right string
+}
+
+func (d difference) String() string {
+	return d.left + ` - ` + d.right
+}
+
+var dayTimeUnits = []struct {
+	name  string
+	nanos int64
+}{
+	{`DAY`, nanosInDay},
+	{`HOUR`, int64(time.Hour)},
+	{`MINUTE`, int64(time.Minute)},
+	{`SECOND`, nanosInSecond},
+}
+
+func (i interval) hasMonths() bool {
+	return i.Months != 0
+}
+
+func (i interval) hasDayTime() bool {
+	return i.Days != 0 || i.Nanos != 0
+}
+
+func (i interval) neg() interval {
+	i.Months, i.Days, i.Nanos = -i.Months, -i.Days, -i.Nanos
+	return i
+}
+
+// expr writes the interval as an oracle interval, which has either months or
+// days and smaller units.
+func (i interval) expr() (Cond, error) {
+	if i.hasMonths() && i.hasDayTime() {
+		return nil, errors.Wrapf(NotImplemented, `interval %s with months and days outside of date arithmetic`, i.Duration.String())
+	}
+	if i.hasMonths() {
+		n, unit := i.Months, `YEAR`
+		if n%12 == 0 {
+			n /= 12
+		} else {
+			unit = `MONTH`
+		}
+		if i.factor != `` {
+			return Expr(fmt.Sprintf(`NUMTOYMINTERVAL(%s, '%s')`, scaled(i.factor, strconv.FormatInt(n, 10)), unit)), nil
+		}
+		return Expr(intervalLiteral(strconv.FormatInt(n, 10), unit)), nil
+	}
+	nanos := i.Days*nanosInDay + i.Nanos
+	for _, u := range dayTimeUnits {
+		if nanos%u.nanos != 0 {
+			continue
+		}
+		n := strconv.FormatInt(nanos/u.nanos, 10)
+		if i.factor != `` {
+			return Expr(fmt.Sprintf(`NUMTODSINTERVAL(%s, '%s')`, scaled(i.factor, n), u.name)), nil
+		}
+		return Expr(intervalLiteral(n, u.name)), nil
+	}
+	if i.factor != `` {
+		return Expr(fmt.Sprintf(`NUMTODSINTERVAL(%s, 'SECOND')`, scaled(i.factor, formatSeconds(nanos)))), nil
+	}
+	sign := ``
+	if nanos < 0 {
+		sign, nanos = `-`, -nanos
+	}
+	days := nanos / nanosInDay
+	nanos %= nanosInDay
+	fraction := strings.TrimRight(fmt.Sprintf(`%09d`, nanos%nanosInSecond), `0`)
+	seconds := nanos / nanosInSecond
+	return Expr(fmt.Sprintf(`INTERVAL '%s%d %02d:%02d:%02d.%s' DAY%s TO SECOND(%d)`, sign, days,
+		seconds/3600, seconds/60%60, seconds%60, fraction, leadingPrecision(strconv.FormatInt(days, 10)), len(fraction))), nil
+}
+
+// epoch returns the number of seconds of the interval, postgres counts 30
+// days in a month and 365.25 days in a year.
+func (i interval) epoch() string {
+	days := float64(i.Months/12)*365.25 + float64(i.Months%12*30+i.Days)
+	seconds := strconv.FormatFloat(days*86400+float64(i.Nanos)/float64(nanosInSecond), 'f', -1, 64)
+	if i.factor != `` {
+		return fmt.Sprintf(`(%s)`, scaled(i.factor, seconds))
+	}
+	return seconds
+}
+
+// intervalLiteral writes an interval of n units, with the precision of the
+// leading field when n has more digits than the default two.
+func intervalLiteral(n, unit string) string {
+	return fmt.Sprintf(`INTERVAL '%s' %s%s`, n, unit, leadingPrecision(n))
+}
+
+func leadingPrecision(n string) string {
+	if digits := len(strings.TrimPrefix(n, `-`)); digits > 2 {
+		return fmt.Sprintf(`(%d)`, digits)
+	}
+	return ``
+}
+
+func formatSeconds(nanos int64) string {
+	sign := ``
+	if nanos < 0 {
+		sign, nanos = `-`, -nanos
+	}
+	fraction := strings.TrimRight(fmt.Sprintf(`%09d`, nanos%nanosInSecond), `0`)
+	return fmt.Sprintf(`%s%d.%s`, sign, nanos/nanosInSecond, fraction)
+}
+
+// scaled writes factor * n.
+func scaled(factor, n string) string {
+	if factor == `` {
+		return n
+	}
+	if strings.ContainsRune(factor, ' ') && !enclosed(factor) {
+		factor = `(` + factor + `)`
+	}
+	if n == `1` {
+		return factor
+	}
+	return factor + ` * ` + n
+}
+
+// enclosed reports if s is enclosed in a pair of parentheses.
+func enclosed(s string) bool {
+	if !strings.HasPrefix(s, `(`) {
+		return false
+	}
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+	return false
+}
+
+// settle writes a value of operand that is only known inside of arithmetic,
+// like an interval.
+func settle(value interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case interval:
+		return v.expr()
+	case difference:
+		return Expr(v.String()), nil
+	}
+	return value, nil
+}
+
+// operand converts an operand of arithmetic, intervals and differences are
+// returned as is.
+func (cb *CustomBuilder) operand(e parser.Expr) (interface{}, error) {
+	switch v := e.(type) {
+	case *parser.DInterval:
+		return interval{Duration: v.Duration}, nil
+	case *parser.CastExpr:
+		if _, ok := v.Type.(*parser.IntervalColType); ok {
+			return cb.castInterval(v.Expr)
+		}
+	case *parser.UnaryExpr:
+		if v.Operator == parser.UnaryMinus {
+			value, err := cb.operand(v.Expr)
+			if err != nil {
+				return nil, err
+			}
+			if i, ok := value.(interval); ok {
+				return i.neg(), nil
+			}
+			value, err = settle(value, nil)
+			if err != nil {
+				return nil, err
+			}
+			return Expr(`-` + getDisplayValue(value)), nil
+		}
+	case *parser.ParenExpr:
+		value, err := cb.operand(v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		// an interval is written as a single term.
+		if i, ok := value.(interval); ok {
+			return i, nil
+		}
+		value, err = settle(value, nil)
+		if err != nil {
+			return nil, err
+		}
+		return Expr(`(` + getDisplayValue(value) + `)`), nil
+	case *parser.BinaryExpr:
+		return cb.arithmetic(v)
+	}
+	return cb.getValueFromExpr(e)
+}
+
+func (cb *CustomBuilder) castInterval(e parser.Expr) (interval, error) {
+	if isParameter(e) {
+		// the interval is only known when the statement runs, it may have
+		// months which oracle can not add to a day interval.
+		return interval{}, errors.Wrapf(NotImplemented, `cast of parameter %s to interval`, location(e))
+	}
+	var s string
+	switch v := e.(type) {
+	case *parser.StrVal:
+		s = v.OriginalString()
+	case *parser.DString:
+		s = string(*v)
+	default:
+		return interval{}, errors.Wrapf(NotImplemented, `cast of %s to interval`, e.String())
+	}
+	d, err := parser.ParseDInterval(s)
+	if err != nil {
+		return interval{}, err
+	}
+	return interval{Duration: d.Duration}, nil
+}
+
+// arithmetic converts a binary expression, a date or timestamp plus or minus
+// an interval with months is converted to ADD_MONTHS, because oracle fails
+// to add months to the last days of a month. A date plus or minus a number
+// of days and the difference of timestamps mean the same in oracle.
+func (cb *CustomBuilder) arithmetic(v *parser.BinaryExpr) (interface{}, error) {
+	if v.Operator == parser.Concat {
+		e := cb.emptyStringConcat(v)
+		if e != parser.Expr(v) {
+			return cb.operand(e)
+		}
+	}
+	left, err := cb.operand(v.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := cb.operand(v.Right)
+	if err != nil {
+		return nil, err
+	}
+	li, lok := left.(interval)
+	ri, rok := right.(interval)
+	additive := v.Operator == parser.Plus || v.Operator == parser.Minus
+	switch {
+	case lok && rok:
+		if additive && li.factor == `` && ri.factor == `` {
+			if v.Operator == parser.Minus {
+				ri = ri.neg()
+			}
+			li.Months, li.Days, li.Nanos = li.Months+ri.Months, li.Days+ri.Days, li.Nanos+ri.Nanos
+			return li, nil
+		}
+	case rok && additive:
+		return cb.shift(left, v.Operator, ri)
+	case lok && v.Operator == parser.Plus:
+		return cb.shift(right, v.Operator, li)
+	case lok && v.Operator == parser.Mult && li.factor == ``:
+		if right, err = settle(right, nil); err != nil {
+			return nil, err
+		}
+		li.factor = getDisplayValue(right)
+		return li, nil
+	case rok && v.Operator == parser.Mult && ri.factor == ``:
+		if left, err = settle(left, nil); err != nil {
+			return nil, err
+		}
+		ri.factor = getDisplayValue(left)
+		return ri, nil
+	}
+	if left, err = settle(left, nil); err != nil {
+		return nil, err
+	}
+	if right, err = settle(right, nil); err != nil {
+		return nil, err
+	}
+	if v.Operator == parser.Minus && !lok && !rok {
+		return difference{left: getDisplayValue(left), right: getDisplayValue(right)}, nil
+	}
+	return Expr(fmt.Sprintf(`%s %s %s`, getDisplayValue(left), binaryOpName[v.Operator], getDisplayValue(right))), nil
+}
+
+// shift adds the interval i to the date or timestamp ts, or subtracts it.
+func (cb *CustomBuilder) shift(ts interface{}, op parser.BinaryOperator, i interval) (interface{}, error) {
+	ts, err := settle(ts, nil)
+	if err != nil {
+		return nil, err
+	}
+	sql := getDisplayValue(ts)
+	if i.hasMonths() {
+		months := i.Months
+		if op == parser.Minus {
+			months = -months
+		}
+		cb.warnf(`ADD_MONTHS returns a DATE and moves the last day of a month to the last day of the resulting month`)
+		sql = fmt.Sprintf(`ADD_MONTHS(%s, %s)`, sql, scaled(i.factor, strconv.FormatInt(months, 10)))
+		if !i.hasDayTime() {
+			return Expr(sql), nil
+		}
+		i.Months = 0
+	}
+	dayTime, err := i.expr()
+	if err != nil {
+		return nil, err
+	}
+	return Expr(fmt.Sprintf(`%s %s %s`, sql, binaryOpName[op], getDisplayValue(dayTime))), nil
+}
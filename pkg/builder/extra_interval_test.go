@@ -0,0 +1,53 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertIntervals(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select now() - interval '7 days', created + '1 hour'::interval from t`: `SELECT SYSTIMESTAMP - INTERVAL '7' DAY, "created" + INTERVAL '1' HOUR FROM "t"`,
+
+		`select interval '100 days', interval '90 minutes', -interval '2 years', interval '1' hour from t`: `SELECT INTERVAL '100' DAY(3), INTERVAL '90' MINUTE, INTERVAL '-2' YEAR, INTERVAL '1' HOUR FROM "t"`,
+
+		`select a + interval '3 days 04:05:06.5', interval '1 day' + interval '2 hours' from t`: `SELECT "a" + INTERVAL '3 04:05:06.5' DAY TO SECOND(1), INTERVAL '26' HOUR FROM "t"`,
+
+		`select * from t where created > now() - interval '1 day' * $1`: `SELECT * FROM "t" WHERE "created">(SYSTIMESTAMP - NUMTODSINTERVAL(:arg1, 'DAY'))`,
+
+		`select 3 * interval '1 month', interval '1 minute' * (n + 1) from t`: `SELECT NUMTOYMINTERVAL(3, 'MONTH'), NUMTODSINTERVAL(("n" + 1), 'MINUTE') FROM "t"`,
+
+		`select a - b, d + 1, d - 7 from t`: `SELECT "a" - "b", "d" + 1, "d" - 7 FROM "t"`,
+
+		`select extract(epoch from (a - b)), extract(epoch from interval '1 day 1 hour') from t`: `SELECT (EXTRACT(DAY FROM (CAST("a" AS TIMESTAMP) - CAST("b" AS TIMESTAMP))) * 86400 + EXTRACT(HOUR FROM (CAST("a" AS TIMESTAMP) - CAST("b" AS TIMESTAMP))) * 3600 + EXTRACT(MINUTE FROM (CAST("a" AS TIMESTAMP) - CAST("b" AS TIMESTAMP))) * 60 + EXTRACT(SECOND FROM (CAST("a" AS TIMESTAMP) - CAST("b" AS TIMESTAMP)))), 90000 FROM "t"`,
+	})
+
+	for _, sql := range []string{
+		`select interval '1 month 1 day' from t`,
+		`select (n || ' days')::interval from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+
+	_, err := convert(`select created + $1::interval from t`)
+	require.EqualError(t, err, `cast of parameter $1 to interval: not implemented`)
+}
+
+func TestConvertAddMonths(t *testing.T) {
+	for sql, expected := range map[string]string{
+		`select a + interval '1 month' from t`:                     `SELECT ADD_MONTHS("a", 1) FROM "t"`,
+		`select interval '1 year' + a from t`:                      `SELECT ADD_MONTHS("a", 12) FROM "t"`,
+		`select a - interval '1 year 2 mons 3 days' from t`:        `SELECT ADD_MONTHS("a", -14) - INTERVAL '3' DAY FROM "t"`,
+		`select a + interval '1 month' * $1 from t`:                `SELECT ADD_MONTHS("a", :arg1) FROM "t"`,
+		`select a + interval '1 month' - interval '2 days' from t`: `SELECT ADD_MONTHS("a", 1) - INTERVAL '2' DAY FROM "t"`,
+	} {
+		cb := &CustomBuilder{Builder: Oracle()}
+		require.NoError(t, cb.Convert(sql), sql)
+		converted, err := cb.ToBoundSQL()
+		require.NoError(t, err)
+		require.Equal(t, expected, converted, sql)
+		require.Len(t, cb.Warnings(), 1, sql)
+	}
+}
@@ -0,0 +1,162 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The ROWNUM rewrite must number the rows after they are sorted, the ORDER BY
+// therefore stays in the innermost query and ROWNUM is only used outside it.
+func TestConvertLimitRownum(t *testing.T) {
+	testTranslateVersion(t, Oracle11g, map[string]string{
+		`select a from b order by created desc limit 10`: `SELECT * FROM (SELECT "a" FROM "b" ORDER BY "created" DESC) at WHERE ROWNUM<=10`,
+
+		`select a from b where c = 1 order by created desc, a limit 10 offset 20`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b" WHERE "c"=1 ORDER BY "created" DESC, "a") at WHERE ROWNUM<=30) att WHERE att.RN>20`,
+
+		`select x.a, b c from t x order by x.a limit 5 offset 5`: `SELECT "a","c" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "x"."a", "b" "c" FROM "t" "x" ORDER BY "x"."a") at WHERE ROWNUM<=10) att WHERE att.RN>5`,
+
+		`select a from b limit 3`: `SELECT * FROM (SELECT "a" FROM "b") at WHERE ROWNUM<=3`,
+
+		`select a from b union select a from c order by a desc limit 3 offset 2`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT * FROM ((SELECT "a" FROM "b") UNION (SELECT "a" FROM "c")) u ORDER BY "a" DESC) at WHERE ROWNUM<=5) att WHERE att.RN>2`,
+
+		`select a from b union all select a from c limit 3`: `SELECT * FROM (SELECT * FROM ((SELECT "a" FROM "b") UNION ALL (SELECT "a" FROM "c")) u) at WHERE ROWNUM<=3`,
+
+		`(select a from b order by a limit 1) union (select a from c)`: `(SELECT * FROM (SELECT "a" FROM "b" ORDER BY "a") at WHERE ROWNUM<=1) UNION (SELECT "a" FROM "c")`,
+
+		`select a from (select a, b from t order by b desc limit 5) x order by a`: `SELECT "a" FROM (SELECT * FROM (SELECT "a", "b" FROM "t" ORDER BY "b" DESC) at WHERE ROWNUM<=5) "x" ORDER BY "a"`,
+
+		`select a from b order by a offset 5`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b" ORDER BY "a") at) att WHERE att.RN>5`,
+
+		`select a from b limit all offset 0`: `SELECT "a" FROM "b"`,
+
+		`select a from b limit 0`: `SELECT * FROM (SELECT "a" FROM "b") at WHERE ROWNUM<=0`,
+
+		`select a from b order by a limit null`: `SELECT "a" FROM "b" ORDER BY "a"`,
+
+		`select a from b limit null offset 5`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b") at) att WHERE att.RN>5`,
+
+		`select a from b limit 3 offset null`: `SELECT * FROM (SELECT "a" FROM "b") at WHERE ROWNUM<=3`,
+	})
+
+	for _, sql := range []string{`select a from b limit -1`, `select a from b limit 3 offset (-2)`} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestConvertLimitFetchFirst(t *testing.T) {
+	testTranslateVersion(t, Oracle19c, map[string]string{
+		`select a from b order by created desc limit 10`: `SELECT "a" FROM "b" ORDER BY "created" DESC FETCH NEXT 10 ROWS ONLY`,
+
+		`select a from b union select a from c order by a desc limit 3 offset 2`: `SELECT * FROM ((SELECT "a" FROM "b") UNION (SELECT "a" FROM "c")) u ORDER BY "a" DESC OFFSET 2 ROWS FETCH NEXT 3 ROWS ONLY`,
+
+		`select a from b order by a offset 5`: `SELECT "a" FROM "b" ORDER BY "a" OFFSET 5 ROWS`,
+
+		`select a from b limit 0`: `SELECT "a" FROM "b" FETCH NEXT 0 ROWS ONLY`,
+	})
+}
+
+func TestBuilderOutputColumns(t *testing.T) {
+	for selects, expected := range map[string][]string{
+		`a,b`:                    {`a`, `b`},
+		`x."a", "b" c, y.d AS e`: {`"a"`, `c`, `e`},
+		`nvl(a, 1) v, 'x' w`:     {`v`, `w`},
+		`"a" + 1`:                nil,
+		`count(*)`:               nil,
+		`x.*`:                    nil,
+		`CASE WHEN a THEN 1 END`: nil,
+	} {
+		columns, ok := Select(selects).outputColumns()
+		require.Equal(t, expected != nil, ok, selects)
+		require.Equal(t, expected, columns, selects)
+	}
+
+	sql, err := Dialect(ORACLE).Select("t1.a", "b AS x", "count(*) c").From("table1 t1").GroupBy("t1.a, b").
+		OrderBy("a").Limit(5, 10).ToBoundSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT a,x,c FROM (SELECT at.*,ROWNUM RN FROM (SELECT t1.a,b AS x,count(*) c FROM table1 t1 GROUP BY t1.a, b ORDER BY a) at WHERE ROWNUM<=15) att WHERE att.RN>10`, sql)
+
+	// without names, RN is selected too.
+	sql, err = Dialect(ORACLE).Select("*").From("table1").OrderBy("a").Limit(5, 10).ToBoundSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM (SELECT at.*,ROWNUM RN FROM (SELECT * FROM table1 ORDER BY a) at WHERE ROWNUM<=15) att WHERE att.RN>10`, sql)
+}
+
+func TestConvertOffsetColumns(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select x.a, b c, coalesce(d, 1) e from t x order by a limit 3 offset 2`: `SELECT "a","c","e" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "x"."a", "b" "c", NVL("d", 1) "e" FROM "t" "x" ORDER BY "a") at WHERE ROWNUM<=5) att WHERE att.RN>2`,
+	})
+
+	for _, sql := range []string{
+		`select a + 1 from t order by a limit 10 offset 5`,
+		`select count(*) from t union select a from s limit 10 offset 5`,
+	} {
+		_, err := convert(sql)
+		require.EqualError(t, err, `offset of a query with a column without alias: not implemented`, sql)
+	}
+
+	// the columns of * are selected by name when the catalog knows them,
+	// otherwise RN is selected too.
+	testTranslateVersion(t, Oracle11g, map[string]string{
+		`select * from t order by a limit $1 offset $2`: `SELECT * FROM (SELECT at.*,ROWNUM RN FROM (SELECT * FROM "t" ORDER BY "a") at WHERE ROWNUM<=(:arg2 + :arg1)) att WHERE att.RN>:arg2`,
+	})
+	cb := &CustomBuilder{Builder: Oracle(), Catalog: NewCatalog(Table{Name: `t`, Columns: []Column{{Name: `a`}, {Name: `b`}}})}
+	sql, err := convertBy(cb, `select * from t order by a limit 10 offset 5`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "a","b" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a", "b" FROM "t" ORDER BY "a") at WHERE ROWNUM<=15) att WHERE att.RN>5`, sql)
+
+	testTranslateVersion(t, Oracle19c, map[string]string{
+		`select a + 1 from t order by a limit 10 offset 5`: `SELECT "a" + 1 FROM "t" ORDER BY "a" OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY`,
+	})
+}
+
+func TestTranslateLimitBinds(t *testing.T) {
+	sql := `select a from b order by a limit $1 offset $2`
+	res, err := NewTranslator(TranslatorOptions{}).Translate(context.Background(), sql)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b" ORDER BY "a") at WHERE ROWNUM<=(:arg2 + :arg1)) att WHERE att.RN>:arg2`, res.SQL)
+	require.Equal(t, []Bind{{`arg2`, 2}, {`arg1`, 1}, {`arg2`, 2}}, res.Binds)
+
+	res, err = NewTranslator(TranslatorOptions{Version: Oracle19c}).Translate(context.Background(), sql)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "a" FROM "b" ORDER BY "a" OFFSET :arg2 ROWS FETCH NEXT :arg1 ROWS ONLY`, res.SQL)
+	require.Equal(t, []Bind{{`arg2`, 2}, {`arg1`, 1}}, res.Binds)
+
+	testTranslateVersion(t, Oracle11g, map[string]string{
+		`select a from b limit $1`:                         `SELECT * FROM (SELECT "a" FROM "b") at WHERE ROWNUM<=:arg1`,
+		`select a from b limit 2 * 5 offset ($1 - 1) * 10`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b") at WHERE ROWNUM<=(((:arg1 - 1) * 10) + (2 * 5))) att WHERE att.RN>((:arg1 - 1) * 10)`,
+		`select a from b union select a from c limit $1`:   `SELECT * FROM (SELECT * FROM ((SELECT "a" FROM "b") UNION (SELECT "a" FROM "c")) u) at WHERE ROWNUM<=:arg1`,
+		`select a from b offset $1`:                        `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b") at) att WHERE att.RN>:arg1`,
+	})
+	testTranslateVersion(t, Oracle19c, map[string]string{
+		`select a from b limit 2 * 5 offset $1`: `SELECT "a" FROM "b" OFFSET :arg1 ROWS FETCH NEXT (2 * 5) ROWS ONLY`,
+	})
+
+	for _, sql := range []string{`select a from b limit 2.5`, `select a from b limit 'x'`} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestBuilderLimitExpr(t *testing.T) {
+	sql, args, err := Dialect(ORACLE).Select("a").From("t").OrderBy("a").LimitExpr(10, Expr("?", 20)).ToSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT a FROM (SELECT at.*,ROWNUM RN FROM (SELECT a FROM t ORDER BY a) at WHERE ROWNUM<=((:p1) + 10)) att WHERE att.RN>(:p2)`, sql)
+	require.Len(t, args, 2)
+
+	sql, err = Dialect(MYSQL).Select("a").From("t").LimitExpr("5", Expr("2 * 3")).ToBoundSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT a FROM t LIMIT '5' OFFSET (2 * 3)`, sql)
+
+	_, err = Dialect(MSSQL).Select("a").From("t").LimitExpr("5").ToBoundSQL()
+	require.Equal(t, ErrInvalidLimitation, err)
+
+	sql, err = Dialect(POSTGRES).Select("a").From("t").LimitExpr(nil, 5).ToBoundSQL()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT a FROM t OFFSET 5`, sql)
+
+	_, err = Dialect(MYSQL).Select("a").From("t").LimitExpr(nil, 5).ToBoundSQL()
+	require.Equal(t, ErrInvalidLimitation, err)
+}
@@ -0,0 +1,95 @@
+package builder
+
+import (
+	"strings"
+)
+
+// QuotingMode selects how the identifiers of tables, columns and aliases are
+// written in oracle. Postgres folds unquoted identifiers to lower case and
+// oracle to upper case, a name is the same in both databases only when it is
+// quoted or when its case is changed.
+type QuotingMode int
+
+const (
+	// QuotePreserve quotes every identifier, the oracle names are the
+	// postgres names exactly, in lower case unless they were quoted in
+	// postgres.
+	QuotePreserve QuotingMode = iota
+	// QuoteFoldUpper writes the identifiers postgres folded to lower case in
+	// upper case, like oracle folds them, and preserves the quoted postgres
+	// identifiers exactly.
+	QuoteFoldUpper
+	// QuoteMinimal writes identifiers as they are, and quotes only those
+	// oracle can not read unquoted. The unquoted names are folded to upper
+	// case by oracle.
+	QuoteMinimal
+)
+
+// quoteIdentifier writes the postgres identifier name as cb.Quoting selects.
+// A name reserved in oracle is renamed as cb.ReservedNames maps it, or quoted
+// where it would be written unquoted, and reported.
+func (cb *CustomBuilder) quoteIdentifier(name string) string {
+	reserved := oracleReservedWords[strings.ToUpper(name)]
+	if to, ok := cb.ReservedNames[name]; ok && reserved {
+		cb.reportReserved(name, `identifier is reserved in oracle, it is renamed to %s`, to)
+		name, reserved = to, oracleReservedWords[strings.ToUpper(to)]
+	}
+	quoted := cb.writeIdentifier(name)
+	if reserved && quoted[0] != '"' {
+		cb.reportReserved(name, `identifier is reserved in oracle, it is quoted`)
+		quoted = `"` + strings.ToUpper(name) + `"`
+	}
+	return quoted
+}
+
+// reportReserved reports once that the reserved identifier name was renamed
+// or quoted.
+func (cb *CustomBuilder) reportReserved(name string, format string, args ...interface{}) {
+	st := cb.getState()
+	if st.reserved[name] {
+		return
+	}
+	if st.reserved == nil {
+		st.reserved = make(map[string]bool)
+	}
+	st.reserved[name] = true
+	cb.warnAt(name, format, args...)
+}
+
+// writeIdentifier writes name as cb.Quoting selects.
+func (cb *CustomBuilder) writeIdentifier(name string) string {
+	if cb.Quoting == QuotePreserve || !isFoldedIdentifier(name) {
+		return `"` + name + `"`
+	}
+	if !isBareIdentifier(name) {
+		return `"` + strings.ToUpper(name) + `"`
+	}
+	if cb.Quoting == QuoteFoldUpper {
+		return strings.ToUpper(name)
+	}
+	return name
+}
+
+// isFoldedIdentifier reports whether name reads the same as an unquoted
+// postgres identifier, which postgres folds to lower case.
+func isFoldedIdentifier(name string) bool {
+	if name == `` {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c == '_':
+		case i > 0 && (c >= '0' && c <= '9' || c == '$'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isBareIdentifier reports whether oracle reads the folded postgres identifier
+// name without quotes, oracle identifiers start with a letter.
+func isBareIdentifier(name string) bool {
+	return name[0] >= 'a' && name[0] <= 'z'
+}
@@ -0,0 +1,79 @@
+package builder
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestConvertQuoting(t *testing.T) {
+	sql := `select u.id, "Nick" n, _x, count(*) from app.users u where u."Email" = $1 group by u.id, "Nick", _x`
+	for mode, expected := range map[QuotingMode]string{
+		QuotePreserve:  `SELECT "u"."id", "Nick" "n", "_x", COUNT(*) FROM "app"."users" "u" WHERE "u"."Email"=:arg1 GROUP BY "u"."id", "Nick", "_x"`,
+		QuoteFoldUpper: `SELECT U.ID, "Nick" N, "_X", COUNT(*) FROM APP.USERS U WHERE U."Email"=:arg1 GROUP BY U.ID, "Nick", "_X"`,
+		QuoteMinimal:   `SELECT u.id, "Nick" n, "_X", COUNT(*) FROM app.users u WHERE u."Email"=:arg1 GROUP BY u.id, "Nick", "_X"`,
+	} {
+		converted, err := convertBy(&CustomBuilder{Builder: Oracle(), Quoting: mode}, sql)
+		require.NoError(t, err)
+		require.Equal(t, expected, converted)
+	}
+
+	converted, err := convertBy(&CustomBuilder{Builder: Oracle(), Quoting: QuoteFoldUpper},
+		`insert into t (a, "B") values (1, 2) on conflict (a) do update set "B" = excluded."B"`)
+	require.NoError(t, err)
+	require.Equal(t, `MERGE INTO T T
+USING (select 1 A, 2 "B" FROM DUAL) S
+ON (T.A = S.A)
+WHEN MATCHED THEN
+UPDATE SET "B" = S."B"
+WHEN NOT MATCHED THEN
+INSERT (A,"B") VALUES(S.A, S."B")`, converted)
+
+	converted, err = convertBy(&CustomBuilder{Builder: Oracle(), Quoting: QuoteMinimal},
+		`create table t (id serial primary key, "Name" text not null)`)
+	require.NoError(t, err)
+	require.Equal(t, `CREATE TABLE t (
+id NUMBER PRIMARY KEY,
+"Name" CLOB NOT NULL
+)`, converted)
+}
+
+func TestTranslateReservedWords(t *testing.T) {
+	sql := `select comment, size, c.level from t c where size > 1 order by date`
+	for mode, expected := range map[QuotingMode]string{
+		QuotePreserve:  `SELECT "comment_text", "size", "c"."level" FROM "t" "c" WHERE "size">1 ORDER BY "date"`,
+		QuoteFoldUpper: `SELECT COMMENT_TEXT, "SIZE", C."LEVEL" FROM T C WHERE "SIZE">1 ORDER BY "DATE"`,
+		QuoteMinimal:   `SELECT comment_text, "SIZE", c."LEVEL" FROM t c WHERE "SIZE">1 ORDER BY "DATE"`,
+	} {
+		tr := NewTranslator(TranslatorOptions{Quoting: mode, ReservedNames: map[string]string{`comment`: `comment_text`}})
+		res, err := tr.Translate(context.Background(), sql)
+		require.NoError(t, err)
+		require.Equal(t, expected, res.SQL)
+		reported := make(map[string]string, len(res.Warnings))
+		for _, w := range res.Warnings {
+			reported[w.Location] = w.Message
+		}
+		if mode == QuotePreserve {
+			require.Equal(t, map[string]string{`comment`: `identifier is reserved in oracle, it is renamed to comment_text`}, reported)
+			continue
+		}
+		require.Equal(t, map[string]string{
+			`comment`: `identifier is reserved in oracle, it is renamed to comment_text`,
+			`size`:    `identifier is reserved in oracle, it is quoted`,
+			`level`:   `identifier is reserved in oracle, it is quoted`,
+			`date`:    `identifier is reserved in oracle, it is quoted`,
+		}, reported)
+	}
+}
+
+func TestTranslateReservedWordsInExpressions(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{Quoting: QuoteMinimal})
+	res, err := tr.Translate(context.Background(), `select case when size > 1 then level end, nullif(date, 0) from t where mode like 'x%' and file in (select file from s) and uid = any (select access from s)`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CASE WHEN "SIZE">1 THEN "LEVEL" END, NULLIF("DATE", 0) FROM t WHERE ("MODE" LIKE 'x%') AND ("FILE" IN (SELECT "FILE" FROM s)) AND ("UID" = ANY (SELECT "ACCESS" FROM s))`, res.SQL)
+	reported := make([]string, 0, len(res.Warnings))
+	for _, w := range res.Warnings {
+		reported = append(reported, w.Location)
+	}
+	require.ElementsMatch(t, []string{`size`, `level`, `date`, `mode`, `file`, `uid`, `access`}, reported)
+}
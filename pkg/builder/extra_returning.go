@@ -0,0 +1,137 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// ReturningMode selects how a RETURNING clause is converted.
+type ReturningMode int
+
+const (
+	// ReturningSelect runs the statement and selects the returned columns
+	// afterwards, inside a transaction unless InTx is set.
+	ReturningSelect ReturningMode = iota
+	// ReturningInto appends RETURNING ... INTO out binds to the statement,
+	// the caller reads the returned values from the out binds.
+	ReturningInto
+)
+
+// OutBind describes an out bind variable of RETURNING ... INTO.
+type OutBind struct {
+	// Name is the oracle bind name without the leading colon, e.g. out1.
+	Name string
+	// Column is the name of the returned column, or its alias.
+	Column string
+	// Type is the oracle type of the returned column, it is empty when the
+	// catalog does not know it.
+	Type string
+}
+
+// convertReturningInto appends RETURNING ... INTO out binds to the converted
+// statement, which changes table.
+func (cb *CustomBuilder) convertReturningInto(table parser.TableExpr, returning parser.ReturningExprs) error {
+	if cb.optype == insertType {
+		if cb.OnConflict != nil {
+			return errors.Wrap(NotImplemented, `returning into of merge`)
+		}
+		if cb.insertSelect != `` || len(cb.insertRows) > 1 {
+			return errors.Wrap(NotImplemented, `returning into of an insert of several rows`)
+		}
+	}
+	found := cb.returningCatalogTable(table)
+	exprs := make([]string, 0, len(returning))
+	binds := make([]string, 0, len(returning))
+	for k, v := range returning {
+		if isStar(v.Expr) {
+			return errors.Errorf(`returning * into needs the columns of table %s in the catalog`, returningTableName(table))
+		}
+		value, err := cb.getValueFromExpr(v.Expr)
+		if err != nil {
+			return err
+		}
+		out := OutBind{Name: fmt.Sprintf(`out%d`, k+1), Column: string(v.As)}
+		if name, ok := v.Expr.(parser.UnresolvedName); ok {
+			column, ok := name[len(name)-1].(parser.Name)
+			if ok && out.Column == `` {
+				out.Column = string(column)
+			}
+			if ok && found != nil {
+				out.Type = found.oracleType(string(column))
+			}
+		}
+		if out.Column == `` {
+			out.Column = formatNode(v.Expr)
+		}
+		exprs = append(exprs, getDisplayValue(value))
+		binds = append(binds, `:`+out.Name)
+		cb.outBinds = append(cb.outBinds, out)
+	}
+	sql, err := cb.rawBoundSQL()
+	if err != nil {
+		return err
+	}
+	cb.CustomSqlStr = fmt.Sprintf(`%s RETURNING %s INTO %s`, sql, strings.Join(exprs, `, `), strings.Join(binds, `, `))
+	return nil
+}
+
+// expandReturning replaces the stars of a RETURNING clause by the columns of
+// table, when the catalog knows them.
+func (cb *CustomBuilder) expandReturning(table parser.TableExpr, returning parser.ReturningExprs) parser.ReturningExprs {
+	found := cb.returningCatalogTable(table)
+	if found == nil || len(found.Columns) == 0 {
+		return returning
+	}
+	out := make(parser.ReturningExprs, 0, len(returning))
+	for _, v := range returning {
+		if !isStar(v.Expr) {
+			out = append(out, v)
+			continue
+		}
+		for _, column := range found.Columns {
+			out = append(out, parser.SelectExpr{Expr: parser.UnresolvedName{parser.Name(column.Name)}})
+		}
+	}
+	return out
+}
+
+// returningCatalogTable returns the catalog table of table, which the
+// converted statement changes, or nil.
+func (cb *CustomBuilder) returningCatalogTable(table parser.TableExpr) *Table {
+	found, err := cb.lookupTable(table)
+	if err != nil {
+		return nil
+	}
+	return found
+}
+
+// returningTableName returns the name of table without its alias.
+func returningTableName(table parser.TableExpr) string {
+	if t, ok := table.(*parser.AliasedTableExpr); ok {
+		table = t.Expr
+	}
+	return formatNode(table)
+}
+
+// oracleType returns the oracle type of the column named name, or an empty
+// string.
+func (t *Table) oracleType(name string) string {
+	for _, v := range t.Columns {
+		if !strings.EqualFold(v.Name, name) {
+			continue
+		}
+		typ := strings.ToUpper(v.Type)
+		if ot, err := ConvertPGTypeToOracle(typ); err == nil {
+			return ot
+		}
+		if idx := strings.IndexByte(typ, '('); idx != -1 {
+			if ot, err := ConvertPGTypeToOracle(strings.TrimSpace(typ[:idx])); err == nil {
+				return ot
+			}
+		}
+		return ``
+	}
+	return ``
+}
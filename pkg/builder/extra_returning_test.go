@@ -0,0 +1,87 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateReturningInto(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{
+		ReturningMode: ReturningInto,
+		Catalog: NewCatalog(Table{
+			Name:    `tasks`,
+			Columns: []Column{{Name: `id`, Type: `bigint`}, {Name: `title`, Type: `varchar(20)`}},
+		}),
+	})
+	res, err := tr.Translate(context.Background(), `insert into tasks (title) values ($1) returning id`)
+	require.NoError(t, err)
+	require.Equal(t, `INSERT INTO "tasks" ("title") Values (:arg1) RETURNING "id" INTO :out1`, res.SQL)
+	require.Equal(t, []Bind{{`arg1`, 1}}, res.Binds)
+	require.Equal(t, []OutBind{{Name: `out1`, Column: `id`, Type: `NUMBER`}}, res.OutBinds)
+
+	res, err = tr.Translate(context.Background(), `update tasks set title = $1 where id = $2 returning title, id + 1 as next`)
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "tasks" SET "title"=:arg1 WHERE "id"=:arg2 RETURNING "title", "id" + 1 INTO :out1, :out2`, res.SQL)
+	require.Equal(t, []OutBind{{Name: `out1`, Column: `title`, Type: `VARCHAR2(4000)`}, {Name: `out2`, Column: `next`}}, res.OutBinds)
+	require.Empty(t, res.Warnings)
+
+	res, err = tr.Translate(context.Background(), `delete from other where id = $1 returning id`)
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "other" WHERE "id"=:arg1 RETURNING "id" INTO :out1`, res.SQL)
+	require.Equal(t, []OutBind{{Name: `out1`, Column: `id`}}, res.OutBinds)
+
+	_, err = tr.Translate(context.Background(), `insert into tasks (id) values (1) on conflict (id) do nothing returning id`)
+	require.Error(t, err)
+	_, err = tr.Translate(context.Background(), `insert into tasks (id) values (1), (2) returning id`)
+	require.Error(t, err)
+}
+
+func TestTranslateDeleteReturning(t *testing.T) {
+	catalog := NewCatalog(Table{
+		Name:    `jobs`,
+		Columns: []Column{{Name: `id`, Type: `bigint`}, {Name: `payload`, Type: `text`}},
+	})
+	tr := NewTranslator(TranslatorOptions{Catalog: catalog})
+	res, err := tr.Translate(context.Background(), `delete from jobs where id = $1 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `Savepoint a;
+SELECT "id", "payload" FROM "jobs" WHERE "id"=:arg1 FOR UPDATE;
+DELETE FROM "jobs" WHERE "id"=:arg1;
+commit;`, res.SQL)
+	require.Equal(t, []Bind{{`arg1`, 1}, {`arg1`, 1}}, res.Binds)
+	require.Len(t, res.Warnings, 1)
+
+	res, err = NewTranslator(TranslatorOptions{InTx: true}).Translate(context.Background(), `delete from jobs where id < 3 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM "jobs" WHERE "id"<3 FOR UPDATE;
+DELETE FROM "jobs" WHERE "id"<3`, res.SQL)
+
+	tr = NewTranslator(TranslatorOptions{Catalog: catalog, ReturningMode: ReturningInto})
+	res, err = tr.Translate(context.Background(), `delete from jobs where id = $1 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "jobs" WHERE "id"=:arg1 RETURNING "id", "payload" INTO :out1, :out2`, res.SQL)
+	require.Equal(t, []OutBind{{Name: `out1`, Column: `id`, Type: `NUMBER`}, {Name: `out2`, Column: `payload`, Type: `CLOB`}}, res.OutBinds)
+
+	res, err = tr.Translate(context.Background(), `delete from jobs x where x.id = 1 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "jobs" "x" WHERE "x"."id"=1 RETURNING "id", "payload" INTO :out1, :out2`, res.SQL)
+	require.Equal(t, []OutBind{{Name: `out1`, Column: `id`, Type: `NUMBER`}, {Name: `out2`, Column: `payload`, Type: `CLOB`}}, res.OutBinds)
+
+	res, err = tr.Translate(context.Background(), `update jobs as x set payload = $1 where x.id = 1 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "jobs" "x" SET "payload"=:arg1 WHERE "x"."id"=1 RETURNING "id", "payload" INTO :out1, :out2`, res.SQL)
+
+	_, err = tr.Translate(context.Background(), `delete from other where id = $1 returning *`)
+	require.Error(t, err)
+	_, err = tr.Translate(context.Background(), `delete from other o where o.id = $1 returning *`)
+	require.EqualError(t, err, `returning * into needs the columns of table other in the catalog`)
+
+	res, err = NewTranslator(TranslatorOptions{Catalog: catalog}).Translate(context.Background(), `delete from jobs x where x.id = 1 returning *`)
+	require.NoError(t, err)
+	require.Equal(t, `Savepoint a;
+SELECT "id", "payload" FROM "jobs" "x" WHERE "x"."id"=1 FOR UPDATE;
+DELETE FROM "jobs" "x" WHERE "x"."id"=1;
+commit;`, res.SQL)
+}
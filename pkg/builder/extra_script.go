@@ -0,0 +1,80 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// StatementError is the failure of one statement of a script.
+type StatementError struct {
+	// Index is the zero based position of the statement in the script.
+	Index int
+	Err   error
+}
+
+func (e *StatementError) Error() string {
+	return fmt.Sprintf(`statement %d: %v`, e.Index, e.Err)
+}
+
+func (e *StatementError) Unwrap() error {
+	return e.Err
+}
+
+// ScriptResult is the outcome of translating a postgres script.
+type ScriptResult struct {
+	// Statements holds one result per statement of the script, in order,
+	// the result of a statement that failed is nil.
+	Statements []*Result
+	Errors     []*StatementError
+	// Script is the oracle script, every statement carries the terminator
+	// sqlplus expects.
+	Script string
+}
+
+// TranslateScript translates every statement of a postgres script.
+// A statement that fails to translate does not stop the others, its error is
+// reported in ScriptResult.Errors and it is left as a comment in the script.
+func (t *Translator) TranslateScript(ctx context.Context, pgSQL string) (*ScriptResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	stmts, err := t.newBuilder().parse(pgSQL)
+	if err != nil {
+		return nil, err
+	}
+	out := &ScriptResult{Statements: make([]*Result, len(stmts))}
+	var script strings.Builder
+	for k, stmt := range stmts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		res, err := t.translateStatement(stmt)
+		if err != nil {
+			out.Errors = append(out.Errors, &StatementError{Index: k, Err: err})
+			fmt.Fprintf(&script, "-- statement %d not translated: %s\n", k, strings.Replace(err.Error(), "\n", " ", -1))
+			continue
+		}
+		out.Statements[k] = res
+		script.WriteString(terminateStatement(res.SQL))
+	}
+	out.Script = script.String()
+	return out, nil
+}
+
+// terminateStatement appends the sqlplus terminator to sql, pl/sql blocks are
+// terminated by a slash on its own line.
+func terminateStatement(sql string) string {
+	sql = strings.TrimSpace(sql)
+	upper := strings.ToUpper(sql)
+	if strings.HasPrefix(upper, `BEGIN`) || strings.HasPrefix(upper, `DECLARE`) {
+		if !strings.HasSuffix(sql, `;`) {
+			sql += `;`
+		}
+		return sql + "\n/\n"
+	}
+	if !strings.HasSuffix(sql, `;`) {
+		sql += `;`
+	}
+	return sql + "\n"
+}
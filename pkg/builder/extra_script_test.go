@@ -0,0 +1,43 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateScript(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{})
+	res, err := tr.TranslateScript(context.Background(), `insert into a(x) values ($1);
+truncate table x;
+update t set a = 1 where b = $2;
+delete from a where id = 2`)
+	require.NoError(t, err)
+	require.Len(t, res.Statements, 4)
+	require.Nil(t, res.Statements[1])
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, 1, res.Errors[0].Index)
+	require.Equal(t, []Bind{{`arg2`, 2}}, res.Statements[2].Binds)
+	require.Equal(t, `INSERT INTO "a" ("x") Values (:arg1);
+-- statement 1 not translated: statement TRUNCATE: not implemented
+UPDATE "t" SET "a"=1 WHERE "b"=:arg2;
+DELETE FROM "a" WHERE "id"=2;
+`, res.Script)
+}
+
+func TestTranslateScriptParseError(t *testing.T) {
+	_, err := NewTranslator(TranslatorOptions{}).TranslateScript(context.Background(), `select a from; select b from c`)
+	require.Error(t, err)
+}
+
+func TestTerminateStatement(t *testing.T) {
+	require.Equal(t, "SELECT 1 FROM DUAL;\n", terminateStatement(`SELECT 1 FROM DUAL`))
+	require.Equal(t, "Savepoint a;\ncommit;\n", terminateStatement("Savepoint a;\ncommit;"))
+	require.Equal(t, "BEGIN\nNULL;\nEND;\n/\n", terminateStatement("BEGIN\nNULL;\nEND"))
+}
+
+func TestConvertMoreThanOneStatement(t *testing.T) {
+	cb := &CustomBuilder{Builder: Oracle()}
+	require.Equal(t, MoreThanOneStatement, cb.Convert(`select a from b; select c from d`))
+}
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"fmt"
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
 	"github.com/pkg/errors"
 	"strings"
@@ -10,31 +11,32 @@ func (cb *CustomBuilder) convertSelectStatement(stmt parser.SelectStatement) (*C
 	switch s := stmt.(type) {
 	case *parser.ValuesClause:
 		if cb.optype == insertType {
-			if len(s.Tuples) > 1 || len(s.Tuples) == 0 {
-				return nil, errors.New(`only one values supported`)
-			}
-			tuple := s.Tuples[0]
-			for _, v := range tuple.Exprs {
-				value, err := getValueFromExpr(v)
-				if err != nil {
-					return nil, err
-				}
-				cb.insertVals = append(cb.insertVals, value)
+			if err := cb.convertValues(s); err != nil {
+				return nil, err
 			}
 		}
 		return cb, nil
 	case *parser.SelectClause:
-		columns, err := cb.convertSelectExpr(s.Exprs)
+		cb.windows = s.Window
+		columns, names, err := cb.convertSelectExprAs(s.Exprs, cb.selectAliases)
 		if err != nil {
 			return nil, err
 		}
-		if err := cb.convertFrom(s.From); err != nil {
+		cb.columns = names
+		if s.From == nil || len(s.From.Tables) == 0 {
+			cb.From(`DUAL`)
+		} else if err := cb.convertFrom(s.From); err != nil {
 			return nil, err
 		}
 		if err := cb.convertWhere(s.Where); err != nil {
 			return nil, err
 		}
-		cb.convertGroupBy(s.GroupBy)
+		if err := cb.convertGroupBy(s.GroupBy); err != nil {
+			return nil, err
+		}
+		if err := cb.convertWhere(s.Having); err != nil {
+			return nil, err
+		}
 
 		if s.Distinct {
 			columns = `DISTINCT ` + columns
@@ -57,27 +59,238 @@ func (cb *CustomBuilder) convertSelect(slt *parser.Select) (*CustomBuilder, erro
 	if slt == nil {
 		return nil, nil
 	}
+	if slt.With != nil {
+		if err := cb.convertWith(slt.With); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := slt.Select.(*parser.UnionClause); ok && (len(slt.OrderBy) > 0 || slt.Limit != nil) {
+		// ORDER BY and LIMIT apply to the whole set operation, which is
+		// selected from as a derived table so that they are not taken for
+		// those of its first query.
+		ncb := cb.subBuilder(selectType)
+		if _, err := ncb.convertSelectStatement(slt.Select); err != nil {
+			return nil, err
+		}
+		cb.Select(`*`).From(ncb.Builder, `u`)
+		if err := cb.convertOrderBy(slt.OrderBy); err != nil {
+			return nil, err
+		}
+		if err := cb.convertLimit(slt.Limit); err != nil {
+			return nil, err
+		}
+		return cb, nil
+	}
+	if s, ok := slt.Select.(*parser.SelectClause); ok && s.DistinctOn != nil {
+		return cb.convertDistinctOn(s, slt.OrderBy, slt.Limit)
+	}
 	if err := cb.convertOrderBy(slt.OrderBy);err != nil {
 		return nil, err
 	}
+	if _, err := cb.convertSelectStatement(cb.expandPagedStar(slt)); err != nil {
+		return nil, err
+	}
+	// the limit is converted after the select list, which it may select
+	// again by name.
 	if err := cb.convertLimit(slt.Limit); err != nil {
 		return nil, err
 	}
-	return cb.convertSelectStatement(slt.Select)
+	return cb, nil
+}
+
+// convertDistinctOn numbers the rows of every DISTINCT ON group in the order
+// of the query and selects the first row of each group, oracle has no
+// DISTINCT ON.
+func (cb *CustomBuilder) convertDistinctOn(s *parser.SelectClause, orders parser.OrderBy, limit *parser.Limit) (*CustomBuilder, error) {
+	clause := *s
+	clause.Distinct, clause.DistinctOn = false, nil
+	if len(s.Exprs) == 1 {
+		if _, ok := s.Exprs[0].Expr.(parser.UnqualifiedStar); ok {
+			exprs, err := cb.expandStar(s.From)
+			if err != nil {
+				return nil, errors.Wrap(err, `distinct on with *`)
+			}
+			clause.Exprs = exprs
+		}
+	}
+	inner := cb.subBuilder(selectType)
+	if _, err := inner.convertSelectStatement(&clause); err != nil {
+		return nil, err
+	}
+	columns, ok := inner.outputColumns()
+	if !ok {
+		return nil, errors.Wrap(NotImplemented, `distinct on with a column without alias`)
+	}
+
+	// the window can not refer to the select list, the positions and the
+	// aliases are replaced by the expressions they select.
+	distinctOn := make(parser.Exprs, 0, len(s.DistinctOn))
+	for _, v := range s.DistinctOn {
+		expr, err := selectedExpr(v, clause.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		distinctOn = append(distinctOn, expr)
+	}
+	resolved := make(parser.OrderBy, 0, len(orders))
+	for _, v := range orders {
+		order := *v
+		expr, err := selectedExpr(v.Expr, clause.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		order.Expr = expr
+		resolved = append(resolved, &order)
+	}
+	leading, err := distinctOnOrder(distinctOn, resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]string, 0, len(distinctOn))
+	for _, v := range distinctOn {
+		partition, err := inner.getExprDisplayValue(v)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+	}
+	items, err := inner.orderByItems(resolved)
+	if err != nil {
+		return nil, err
+	}
+	rowOrder := `NULL`
+	if len(items) > 0 {
+		rowOrder = strings.Join(items, `, `)
+	}
+	inner.selects = append(inner.selects, fmt.Sprintf(`ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s) RN`, strings.Join(partitions, `, `), rowOrder))
+
+	// the first row of a group is unique on the leading items, which are the
+	// DISTINCT ON expressions, so the rest of the order does not change the
+	// order of the result. The leading items are selected by the inner query
+	// for the outer one to sort on them.
+	var sortKeys []string
+	for k, v := range resolved[:leading] {
+		key := fmt.Sprintf(`SK%d`, k+1)
+		value, err := inner.getExprDisplayValue(v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		inner.selects = append(inner.selects, value+` `+key)
+		if v.Direction != parser.DefaultDirection {
+			key += ` ` + v.Direction.String()
+		}
+		sortKeys = append(sortKeys, key)
+	}
+
+	cb.Select(strings.Join(columns, `, `)).From(inner.Builder, `d`).Where(Eq{`RN`: 1})
+	cb.columns = columns
+	if len(sortKeys) > 0 {
+		cb.OrderBy(strings.Join(sortKeys, `, `))
+	}
+	if err := cb.convertLimit(limit); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
+
+// selectedExpr returns the expression of exprs that the ORDER BY or DISTINCT
+// ON item expr refers to by its position or by its alias, or expr when it
+// refers to none.
+func selectedExpr(expr parser.Expr, exprs parser.SelectExprs) (parser.Expr, error) {
+	switch t := expr.(type) {
+	case *parser.NumVal:
+		n, err := t.AsInt64()
+		if err != nil {
+			return nil, errors.Errorf(`non-integer constant %s in order by`, formatNode(t))
+		}
+		if n < 1 || n > int64(len(exprs)) {
+			return nil, errors.Errorf(`position %d is not in select list`, n)
+		}
+		return exprs[n-1].Expr, nil
+	case parser.UnresolvedName:
+		if len(t) != 1 {
+			break
+		}
+		for _, v := range exprs {
+			if n, ok := t[0].(parser.Name); ok && v.As == n {
+				return v.Expr, nil
+			}
+		}
+	}
+	return expr, nil
+}
+
+// distinctOnOrder checks that the order begins with the DISTINCT ON
+// expressions in any order, as postgres requires, and returns the number of
+// the leading items that are DISTINCT ON expressions.
+func distinctOnOrder(distinctOn parser.Exprs, orders parser.OrderBy) (int, error) {
+	keys := make(map[string]bool, len(distinctOn))
+	for _, v := range distinctOn {
+		keys[formatNode(v)] = false
+	}
+	matched := 0
+	for k, v := range orders {
+		if matched == len(keys) {
+			return k, nil
+		}
+		key := formatNode(v.Expr)
+		seen, ok := keys[key]
+		if !ok {
+			return 0, errors.New(`select distinct on expressions must match initial order by expressions`)
+		}
+		if !seen {
+			keys[key] = true
+			matched++
+		}
+	}
+	return len(orders), nil
+}
+
+// expandPagedStar returns the select of slt, with its * expanded to the
+// columns the catalog knows when an offset is skipped by ROWNUM, so that the
+// numbered query is selected again by name rather than with its RN column.
+func (cb *CustomBuilder) expandPagedStar(slt *parser.Select) parser.SelectStatement {
+	s, ok := slt.Select.(*parser.SelectClause)
+	if !ok || slt.Limit == nil || slt.Limit.Offset == nil || cb.version.fetchFirst() || cb.Catalog == nil || len(s.Exprs) != 1 {
+		return slt.Select
+	}
+	if _, ok := s.Exprs[0].Expr.(parser.UnqualifiedStar); !ok {
+		return slt.Select
+	}
+	exprs, err := cb.expandStar(s.From)
+	if err != nil {
+		return slt.Select
+	}
+	clause := *s
+	clause.Exprs = exprs
+	return &clause
+}
+
+// expandStar returns the columns of the table of from, which the catalog
+// describes.
+func (cb *CustomBuilder) expandStar(from *parser.From) (parser.SelectExprs, error) {
+	if from == nil || len(from.Tables) != 1 {
+		return nil, errors.Wrap(NotImplemented, `* of several tables`)
+	}
+	t, err := cb.lookupTable(from.Tables[0])
+	if err != nil {
+		return nil, err
+	}
+	exprs := make(parser.SelectExprs, 0, len(t.Columns))
+	for _, v := range t.Columns {
+		exprs = append(exprs, parser.SelectExpr{Expr: parser.UnresolvedName{parser.Name(v.Name)}})
+	}
+	return exprs, nil
 }
 
 func (cb *CustomBuilder) convertUnion(expr *parser.UnionClause) error {
 
-	if _, err := cb.convertSelect(expr.Right); err != nil {
+	if _, err := cb.convertSelect(expr.Left); err != nil {
 		return err
 	}
-	ncb := &CustomBuilder{
-		Builder: &Builder{
-			cond:    NewCond(),
-			dialect: cb.dialect,
-		},
-	}
-	left, err := ncb.convertSelect(expr.Left)
+	ncb := cb.subBuilder(selectType)
+	right, err := ncb.convertSelect(expr.Right)
 	if err != nil {
 		return err
 	}
@@ -87,11 +300,21 @@ func (cb *CustomBuilder) convertUnion(expr *parser.UnionClause) error {
 	}
 	switch expr.Type {
 	case parser.UnionOp:
-		cb.Builder = cb.Union(distinctType, left.Builder)
+		cb.Builder = cb.Union(distinctType, right.Builder)
 	case parser.IntersectOp:
-		cb.Builder = cb.Intersect(distinctType, left.Builder)
+		if expr.All && !cb.version.setOpAll() {
+			return errors.Wrapf(NotImplemented, `intersect all on oracle %s`, cb.version)
+		}
+		cb.Builder = cb.Intersect(distinctType, right.Builder)
 	case parser.ExceptOp:
-		cb.Builder = cb.Except(distinctType, left.Builder)
+		if expr.All && !cb.version.setOpAll() {
+			return errors.Wrapf(NotImplemented, `except all on oracle %s`, cb.version)
+		}
+		if cb.version.setOpAll() {
+			cb.Builder = cb.Except(distinctType, right.Builder)
+		} else {
+			cb.Builder = cb.setOperation(MINUS, distinctType, right.Builder)
+		}
 	default:
 		return errors.New(`invalid union type`)
 	}
@@ -99,7 +322,20 @@ func (cb *CustomBuilder) convertUnion(expr *parser.UnionClause) error {
 }
 
 func (cb *CustomBuilder) convertSelectExpr(exprs parser.SelectExprs) (string, error) {
+	columns, _, err := cb.convertSelectExprAs(exprs, nil)
+	return columns, err
+}
+
+// convertSelectExprAs converts the select expressions, aliases replace the
+// aliases of the expressions when given. It returns the names of the selected
+// columns as they are written too, the name of an expression without alias is
+// empty and that of a star is *.
+func (cb *CustomBuilder) convertSelectExprAs(exprs parser.SelectExprs, aliases []string) (string, []string, error) {
+	if aliases != nil && len(aliases) != len(exprs) {
+		return ``, nil, errors.Errorf(`%d columns selected for %d columns`, len(exprs), len(aliases))
+	}
 	convertedCols := ``
+	names := make([]string, len(exprs))
 
 	for k, v := range exprs {
 		if k > 0 {
@@ -107,42 +343,60 @@ func (cb *CustomBuilder) convertSelectExpr(exprs parser.SelectExprs) (string, er
 		}
 		switch t := v.Expr.(type) {
 		case *parser.CastExpr:
-			c, err := getValueFromExpr(t)
+			c, err := cb.getValueFromExpr(t)
 			if err != nil {
-				return ``, err
+				return ``, nil, err
 			}
 			convertedCols += getDisplayValue(c)
-		case *parser.CaseExpr:
-			if _, ok := t.Expr.(*parser.CastExpr); ok {
-				return ``, errors.Wrap(NotImplemented, `cast in case`)
-			}
-			convertedCols += t.String()
-		case *parser.FuncExpr:
-			cond, err := convertFunc(t)
+		case *parser.FuncExpr, *parser.CoalesceExpr, *parser.DInterval, *parser.UnaryExpr, *parser.CaseExpr:
+			value, err := cb.getValueFromExpr(t)
 			if err != nil {
-				return ``, err
+				return ``, nil, err
 			}
-			return getDisplayValue(cond), nil
+			convertedCols += getDisplayValue(value)
 		case *parser.BinaryExpr:
-			cond, err := convertBinary(t)
+			cond, err := cb.convertBinary(t)
 			if err != nil {
-				return ``, err
+				return ``, nil, err
 			}
-			return getDisplayValue(cond), nil
+			convertedCols += getDisplayValue(cond)
+		case *parser.ComparisonExpr, *parser.AndExpr, *parser.OrExpr, *parser.NotExpr, *parser.RangeCond:
+			p, err := cb.convertPredicate(t)
+			if err != nil {
+				return ``, nil, err
+			}
+			convertedCols += cb.boolValue(p)
 		case parser.UnresolvedName:
-			c, err := convertUnresolvedName(t)
+			c, err := cb.convertUnresolvedName(t)
 			if err != nil {
-				return ``, err
+				return ``, nil, err
 			}
 			convertedCols += c
+			if n, ok := t[len(t)-1].(parser.Name); ok {
+				names[k] = cb.quoteIdentifier(string(n))
+			}
+		case parser.UnqualifiedStar:
+			convertedCols += `*`
+			names[k] = `*`
 		default:
-			convertedCols += t.String()
+			value, err := cb.getExprDisplayValue(t)
+			if err != nil {
+				return ``, nil, err
+			}
+			convertedCols += value
 		}
-		if v.As != `` {
-			convertedCols += ` ` + string(v.As)
+		if aliases != nil {
+			names[k] = aliases[k]
+			convertedCols += ` ` + names[k]
+		} else if v.As != `` {
+			if err := cb.checkIdentifier(string(v.As)); err != nil {
+				return ``, nil, err
+			}
+			names[k] = cb.quoteIdentifier(string(v.As))
+			convertedCols += ` ` + names[k]
 		}
 	}
-	return convertedCols, nil
+	return convertedCols, names, nil
 }
 
 var binaryOpName = [...]string{
@@ -165,80 +419,323 @@ func (cb *CustomBuilder) convertOrderBy(orders parser.OrderBy) error{
 	if len(orders) == 0 {
 		return nil
 	}
-	var builder strings.Builder
-	for k, v := range orders {
+	items, err := cb.orderByItems(orders)
+	if err != nil {
+		return err
+	}
+	cb.OrderBy(strings.Join(items, `, `))
+	return nil
+}
+
+// orderByItems converts the items of an ORDER BY, of a statement or inside
+// of an aggregate call.
+func (cb *CustomBuilder) orderByItems(orders parser.OrderBy) ([]string, error) {
+	items := make([]string, 0, len(orders))
+	for _, v := range orders {
 		if v.OrderType != 0 || v.Table.TableNameReference != nil {
-			return errors.Wrap(NotImplemented, `order by`)
-		}
-		expr, ok := v.Expr.(parser.UnresolvedName)
-		if !ok {
-			return errors.Wrap(NotImplemented, ` order by`)
+			return nil, errors.Wrap(NotImplemented, `order by`)
 		}
-		clStr, err := convertUnresolvedName(expr)
+		item, err := cb.getExprDisplayValue(v.Expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		builder.WriteString(clStr)
-		if k != len(orders)-1{
-			builder.WriteString(`, `)
+		if v.Direction != parser.DefaultDirection {
+			item += ` ` + v.Direction.String()
 		}
+		items = append(items, item)
 	}
-
-	cb.OrderBy(builder.String())
-	return nil
+	return items, nil
 }
 
 func (cb *CustomBuilder) convertLimit(limit *parser.Limit) error {
 	if limit == nil {
 		return nil
 	}
-	o := []int{}
+	var (
+		count  interface{}
+		offset interface{} = 0
+		err    error
+	)
+	// without a count, like LIMIT ALL or LIMIT NULL, only the offset rows
+	// are skipped.
+	if limit.Count != nil {
+		if count, err = cb.limitValue(limit.Count); err != nil {
+			return err
+		}
+	}
 	if limit.Offset != nil {
-		oi, err := getValueFromExpr(limit.Offset)
-		if err != nil {
+		if offset, err = cb.limitValue(limit.Offset); err != nil {
 			return err
 		}
-		o = append(o, int(oi.(int64)))
+		if offset == nil {
+			offset = 0
+		}
 	}
-	li, err := getValueFromExpr(limit.Count)
-	if err != nil {
-		return err
+	l, lok := count.(int)
+	o, ook := offset.(int)
+	if lok && ook {
+		cb.Limit(l, o)
+	} else {
+		cb.LimitExpr(count, offset)
+	}
+	// without OFFSET the rows are numbered by ROWNUM, and the numbered query
+	// is selected from by the names of its columns. A star is selected with
+	// the row number RN as an extra last column, an expression needs an
+	// alias.
+	if src := cb.columnSource(); !cb.limitation.noOffset() && !cb.version.fetchFirst() && src != nil {
+		for _, v := range src.columns {
+			if v == `` {
+				return errors.Wrap(NotImplemented, `offset of a query with a column without alias`)
+			}
+		}
 	}
-	l := int(li.(int64))
-	cb.Limit(l, o...)
 	return nil
 }
 
-func (cb *CustomBuilder) convertGroupBy(groupBy parser.GroupBy) {
+// limitValue converts the count or the offset of a LIMIT clause to an int, a
+// placeholder or an expression, NULL is converted to nil. A constant must not
+// be negative.
+func (cb *CustomBuilder) limitValue(expr parser.Expr) (interface{}, error) {
+	switch v := parser.StripParens(expr).(type) {
+	case *parser.UnaryExpr:
+		if _, ok := parser.StripParens(v.Expr).(*parser.NumVal); ok && v.Operator == parser.UnaryMinus {
+			return nil, errors.Errorf(`limit %s is negative`, formatNode(expr))
+		}
+	default:
+		if v == parser.DNull {
+			return nil, nil
+		}
+	}
+	value, err := cb.getValueFromExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return nil, errors.Errorf(`limit %s is negative`, formatNode(expr))
+		}
+		return int(v), nil
+	case string:
+		if strings.HasPrefix(v, CustomPlaceHolder) {
+			return v, nil
+		}
+	case Cond:
+		return v, nil
+	}
+	return nil, errors.Errorf(`limit %s is not an integer`, formatNode(expr))
+}
+
+func (cb *CustomBuilder) convertGroupBy(groupBy parser.GroupBy) error {
 	if groupBy == nil {
-		return
+		return nil
 	}
-	cb.GroupBy(`"` + formatNode(groupBy)[10:] + `"`)
+	items := make([]string, 0, len(groupBy))
+	for _, v := range groupBy {
+		item, err := cb.getExprDisplayValue(v)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	cb.GroupBy(strings.Join(items, `, `))
+	return nil
 }
 
-func  convertUnresolvedName(name parser.UnresolvedName) (string, error){
-	builder := strings.Builder{}
-	if _, ok := name[0].(parser.Name); !ok {
-		return ``, errors.Wrap(NotImplemented, `convertUnresolvedName`)
+// convertUnresolvedName writes a name qualified by at most one name, like a
+// column of a table, as cb.Quoting selects.
+func (cb *CustomBuilder) convertUnresolvedName(name parser.UnresolvedName) (string, error) {
+	if len(name) > 2 {
+		return ``, errors.Wrap(NotImplemented, `unResolvedName len`)
 	}
-	if len(name) == 1 {
-		builder.WriteByte('"')
-		builder.WriteString(name.String())
-		builder.WriteByte('"')
-	}else if len(name) == 2 {
-		if _, ok := name[1].(parser.Name); !ok {
+	parts := make([]string, 0, len(name))
+	for _, v := range name {
+		n, ok := v.(parser.Name)
+		if !ok {
 			return ``, errors.Wrap(NotImplemented, `convertUnresolvedName`)
 		}
-		first := name[0].(parser.Name)
-		second := name[1].(parser.Name)
-		builder.WriteString(first.String())
-		builder.WriteString(`."`)
-		builder.WriteString(strings.Trim(second.String(), `"`))
-		builder.WriteByte('"')
+		if err := cb.checkIdentifier(string(n)); err != nil {
+			return ``, err
+		}
+		parts = append(parts, cb.quoteIdentifier(string(n)))
+	}
+	return strings.Join(parts, `.`), nil
+}
 
-	}else {
-		return ``, errors.Wrap(NotImplemented, `unResolvedName len`)
+// convertWith converts a WITH clause to oracle subquery factoring. Oracle has
+// no RECURSIVE keyword, but a recursive query must name its columns.
+func (cb *CustomBuilder) convertWith(with *parser.With) error {
+	var builder strings.Builder
+	if cb.with != `` {
+		builder.WriteString(strings.TrimSuffix(cb.with, ` `))
+		builder.WriteString(`, `)
+	} else {
+		builder.WriteString(`WITH `)
+	}
+	for k, cte := range with.CTEList {
+		slt, ok := cte.Stmt.(*parser.Select)
+		if !ok {
+			return errors.Wrapf(NotImplemented, `cte %s statement`, cte.Stmt.StatementTag())
+		}
+		name, err := cb.convertUnresolvedName(parser.UnresolvedName{cte.Name.Alias})
+		if err != nil {
+			return err
+		}
+		// under WITH RECURSIVE, only the ctes that read themselves are
+		// recursive.
+		recursive := with.Recursive && selfReferencing(slt, cte.Name.Alias)
+		cols := cte.Name.Cols
+		if len(cols) == 0 && recursive {
+			cols, err = cteColumns(slt)
+			if err != nil {
+				return errors.Wrapf(err, `recursive cte %s`, cte.Name.Alias)
+			}
+		}
+		var sql string
+		if recursive {
+			sql, err = cb.convertRecursiveQuery(slt)
+		} else {
+			sql, err = cb.convertSubSelect(slt)
+		}
+		if err != nil {
+			return err
+		}
+		if k > 0 {
+			builder.WriteString(`, `)
+		}
+		builder.WriteString(name)
+		if len(cols) > 0 {
+			builder.WriteString(` (`)
+			for i, col := range cols {
+				if i > 0 {
+					builder.WriteString(`, `)
+				}
+				colStr, err := cb.convertUnresolvedName(parser.UnresolvedName{col})
+				if err != nil {
+					return err
+				}
+				builder.WriteString(colStr)
+			}
+			builder.WriteByte(')')
+		}
+		builder.WriteString(` AS (`)
+		builder.WriteString(sql)
+		builder.WriteByte(')')
+	}
+	builder.WriteByte(' ')
+	cb.with = builder.String()
+	return nil
+}
+
+func (cb *CustomBuilder) convertSubSelect(slt *parser.Select) (string, error) {
+	ncb := cb.subBuilder(selectType)
+	if _, err := ncb.convertSelect(slt); err != nil {
+		return ``, err
+	}
+	return ncb.rawBoundSQL()
+}
+
+// convertRecursiveQuery converts the query of a recursive cte. Oracle expects
+// the anchor and the recursive member joined by a plain UNION ALL, so the
+// union is not written by the builder, which parenthesizes its members.
+func (cb *CustomBuilder) convertRecursiveQuery(slt *parser.Select) (string, error) {
+	u, ok := slt.Select.(*parser.UnionClause)
+	if !ok || slt.OrderBy != nil || slt.Limit != nil || slt.With != nil {
+		return cb.convertSubSelect(slt)
+	}
+	if u.Type != parser.UnionOp || !u.All {
+		return ``, errors.New(`recursive cte must use UNION ALL`)
+	}
+	anchor, err := cb.convertSubSelect(u.Left)
+	if err != nil {
+		return ``, err
+	}
+	recursive, err := cb.convertSubSelect(u.Right)
+	if err != nil {
+		return ``, err
 	}
-	return builder.String(), nil
+	return anchor + ` UNION ALL ` + recursive, nil
+}
+
+// selfReferencing reports whether the query slt reads the table name.
+func selfReferencing(slt *parser.Select, name parser.Name) bool {
+	switch s := slt.Select.(type) {
+	case *parser.ParenSelect:
+		return selfReferencing(s.Select, name)
+	case *parser.UnionClause:
+		return selfReferencing(s.Left, name) || selfReferencing(s.Right, name)
+	case *parser.SelectClause:
+		if s.From == nil {
+			return false
+		}
+		for _, t := range s.From.Tables {
+			if tableReferences(t, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func tableReferences(table parser.TableExpr, name parser.Name) bool {
+	switch t := table.(type) {
+	case *parser.AliasedTableExpr:
+		return tableReferences(t.Expr, name)
+	case *parser.ParenTableExpr:
+		return tableReferences(t.Expr, name)
+	case *parser.JoinTableExpr:
+		return tableReferences(t.Left, name) || tableReferences(t.Right, name)
+	case *parser.Subquery:
+		return selfReferencing(&parser.Select{Select: t.Select}, name)
+	case *parser.NormalizableTableName:
+		un, ok := t.TableNameReference.(parser.UnresolvedName)
+		if !ok || len(un) != 1 {
+			return false
+		}
+		n, ok := un[0].(parser.Name)
+		return ok && n == name
+	}
+	return false
+}
 
+// cteColumns derives the column names of a cte from the select list of its
+// first query block.
+func cteColumns(slt *parser.Select) (parser.NameList, error) {
+	s := firstSelectClause(slt.Select)
+	if s == nil {
+		return nil, errors.Wrap(NotImplemented, `cte columns`)
+	}
+	cols := make(parser.NameList, 0, len(s.Exprs))
+	for _, v := range s.Exprs {
+		if v.As != `` {
+			cols = append(cols, v.As)
+			continue
+		}
+		name, ok := v.Expr.(parser.UnresolvedName)
+		if !ok {
+			return nil, errors.Errorf(`column %s needs an alias`, v.Expr)
+		}
+		col, ok := name[len(name)-1].(parser.Name)
+		if !ok {
+			return nil, errors.Errorf(`column %s needs an alias`, v.Expr)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// firstSelectClause returns the query block that names the columns of stmt.
+func firstSelectClause(stmt parser.SelectStatement) *parser.SelectClause {
+	for {
+		switch s := stmt.(type) {
+		case *parser.ParenSelect:
+			stmt = s.Select.Select
+		case *parser.UnionClause:
+			stmt = s.Left.Select
+		case *parser.SelectClause:
+			return s
+		default:
+			return nil
+		}
+	}
 }
@@ -0,0 +1,195 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+func registerStringFuncs(r *FuncRegistry) {
+	for name, tmpl := range map[string]string{
+		`char_length`:      `LENGTH($1)`,
+		`character_length`: `LENGTH($1)`,
+		`octet_length`:     `LENGTHB($1)`,
+		`initcap`:          `INITCAP($1)`,
+		`btrim`:            `TRIM($1)`,
+		`ltrim`:            `LTRIM($1)`,
+		`rtrim`:            `RTRIM($1)`,
+	} {
+		r.Register(name, 1, Template(tmpl))
+	}
+	for name, tmpl := range map[string]string{
+		// oracle TRIM removes a single character, LTRIM and RTRIM a set.
+		`btrim`: `LTRIM(RTRIM($1, $2), $2)`,
+		`ltrim`: `LTRIM($1, $2)`,
+		`rtrim`: `RTRIM($1, $2)`,
+		`lpad`:  `LPAD($1, $2)`,
+		`rpad`:  `RPAD($1, $2)`,
+		// RPAD returns NULL for a count that is not positive, the empty
+		// string of oracle.
+		`repeat`: `RPAD($1, LENGTH($1) * $2, $1)`,
+	} {
+		r.Register(name, 2, Template(tmpl))
+	}
+	r.Register(`lpad`, 3, Template(`LPAD($1, $2, $3)`))
+	r.Register(`rpad`, 3, Template(`RPAD($1, $2, $3)`))
+	r.Register(`replace`, 3, Template(`REPLACE($1, $2, $3)`))
+	r.Register(`substring`, 2, translateSubstring)
+	r.Register(`substr`, 2, translateSubstr)
+	r.Register(`substring`, 3, translateSubstring)
+	r.Register(`substr`, 3, translateSubstr)
+	r.Register(`left`, 2, translateLeft)
+	r.Register(`right`, 2, translateRight)
+	r.Register(`split_part`, 3, translateSplitPart)
+	r.Register(`concat`, AnyArity, translateConcat)
+	r.Register(`concat_ws`, AnyArity, translateConcatWs)
+	// oracle REVERSE is undocumented and reverses the bytes of a string.
+	r.Register(`reverse`, 1, Template(`(SELECT LISTAGG(SUBSTR($1, LEVEL, 1)) WITHIN GROUP (ORDER BY LEVEL DESC) FROM DUAL CONNECT BY LEVEL <= LENGTH($1))`))
+	r.Register(`md5`, 1, translateMd5)
+}
+
+// stringLiteral returns the content of a string literal argument, a
+// placeholder is not a literal.
+func stringLiteral(arg string) (string, bool) {
+	if len(arg) < 2 || arg[0] != '\'' || arg[len(arg)-1] != '\'' || strings.HasPrefix(arg[1:], CustomPlaceHolder) {
+		return ``, false
+	}
+	return arg[1 : len(arg)-1], true
+}
+
+// intLiteral returns the value of an integer literal argument.
+func intLiteral(arg string) (int, bool) {
+	n, err := strconv.Atoi(arg)
+	return n, err == nil
+}
+
+// translateSubstring translates substring, whose start counts from the end
+// of the string in oracle when it is negative. A string literal as second
+// argument is a regular expression.
+func translateSubstring(call *FuncCall) (string, error) {
+	s, start := call.Args[0], call.Args[1]
+	if pattern, ok := stringLiteral(start); ok {
+		if len(call.Args) == 3 {
+			return ``, errors.Wrapf(NotImplemented, `%s with SIMILAR`, call.Name)
+		}
+		call.Warnf(`the regular expression %s of %s is passed to oracle unchanged`, start, call.Name)
+		if strings.ContainsRune(pattern, '(') {
+			// postgres returns the first parenthesized subexpression.
+			return fmt.Sprintf(`REGEXP_SUBSTR(%s, %s, 1, 1, NULL, 1)`, s, start), nil
+		}
+		return fmt.Sprintf(`REGEXP_SUBSTR(%s, %s)`, s, start), nil
+	}
+	return translateSubstr(call)
+}
+
+// translateSubstr translates substr, and substring with an integer start.
+func translateSubstr(call *FuncCall) (string, error) {
+	s, start := call.Args[0], call.Args[1]
+	n, literal := intLiteral(start)
+	if len(call.Args) == 2 {
+		if literal && n >= 1 {
+			return fmt.Sprintf(`SUBSTR(%s, %s)`, s, start), nil
+		}
+		if literal {
+			return s, nil
+		}
+		return fmt.Sprintf(`SUBSTR(%s, GREATEST(%s, 1))`, s, start), nil
+	}
+	count := call.Args[2]
+	if literal && n >= 1 {
+		return fmt.Sprintf(`SUBSTR(%s, %s, %s)`, s, start, count), nil
+	}
+	// the characters before the first one are counted too.
+	if c, ok := intLiteral(count); literal && ok {
+		return fmt.Sprintf(`SUBSTR(%s, 1, %d)`, s, c+n-1), nil
+	}
+	return fmt.Sprintf(`SUBSTR(%s, GREATEST(%[2]s, 1), %[2]s + %[3]s - GREATEST(%[2]s, 1))`, s, start, count), nil
+}
+
+// translateLeft translates left, whose negative count drops characters from
+// the end.
+func translateLeft(call *FuncCall) (string, error) {
+	s, count := call.Args[0], call.Args[1]
+	if n, ok := intLiteral(count); ok {
+		if n >= 0 {
+			return fmt.Sprintf(`SUBSTR(%s, 1, %d)`, s, n), nil
+		}
+		return fmt.Sprintf(`SUBSTR(%[1]s, 1, LENGTH(%[1]s) - %d)`, s, -n), nil
+	}
+	return fmt.Sprintf(`SUBSTR(%[1]s, 1, CASE WHEN %[2]s >= 0 THEN %[2]s ELSE LENGTH(%[1]s) + %[2]s END)`, s, count), nil
+}
+
+// translateRight translates right, oracle SUBSTR returns NULL when a
+// negative start is before the first character.
+func translateRight(call *FuncCall) (string, error) {
+	s, count := call.Args[0], call.Args[1]
+	if n, ok := intLiteral(count); ok {
+		if n > 0 {
+			return fmt.Sprintf(`SUBSTR(%[1]s, GREATEST(LENGTH(%[1]s) - %d, 1))`, s, n-1), nil
+		}
+		if n == 0 {
+			return fmt.Sprintf(`SUBSTR(%s, 1, 0)`, s), nil
+		}
+		return fmt.Sprintf(`SUBSTR(%s, %d)`, s, 1-n), nil
+	}
+	return fmt.Sprintf(`SUBSTR(%[1]s, CASE WHEN %[2]s >= 0 THEN GREATEST(LENGTH(%[1]s) - %[2]s + 1, 1) ELSE 1 - %[2]s END)`, s, count), nil
+}
+
+// regexpSpecial are the characters that are escaped in a regular expression.
+const regexpSpecial = `\^$.|?*+()[]{}`
+
+// translateSplitPart translates split_part to the field of a regular
+// expression, which needs the delimiter as literal.
+func translateSplitPart(call *FuncCall) (string, error) {
+	delimiter, ok := stringLiteral(call.Args[1])
+	if !ok || delimiter == `` {
+		return ``, errors.Errorf(`the delimiter of split_part must be a literal that is not empty`)
+	}
+	if n, ok := intLiteral(call.Args[2]); ok && n < 1 {
+		return ``, errors.Wrapf(NotImplemented, `split_part with field %d`, n)
+	}
+	var escaped strings.Builder
+	for _, c := range delimiter {
+		if strings.ContainsRune(regexpSpecial, c) {
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteRune(c)
+	}
+	return fmt.Sprintf(`REGEXP_SUBSTR(%s, '(.*?)(%s|$)', 1, %s, 'n', 1)`, call.Args[0], escaped.String(), call.Args[2]), nil
+}
+
+// translateConcat translates concat, the concatenation of oracle skips NULL
+// like concat does.
+func translateConcat(call *FuncCall) (string, error) {
+	if len(call.Args) == 0 {
+		return ``, errors.Errorf(`%s needs arguments`, call.Name)
+	}
+	return `(` + strings.Join(call.Args, ` || `) + `)`, nil
+}
+
+// translateConcatWs writes the separator before every argument that is not
+// NULL and cuts the first one.
+func translateConcatWs(call *FuncCall) (string, error) {
+	if len(call.Args) < 2 {
+		return ``, errors.Errorf(`%s needs a separator and arguments`, call.Name)
+	}
+	separator := call.Args[0]
+	parts := make([]string, 0, len(call.Args)-1)
+	for _, arg := range call.Args[1:] {
+		parts = append(parts, fmt.Sprintf(`CASE WHEN %[1]s IS NOT NULL THEN %[2]s || %[1]s END`, arg, separator))
+	}
+	start := fmt.Sprintf(`LENGTH(%s) + 1`, separator)
+	if s, ok := stringLiteral(separator); ok {
+		start = strconv.Itoa(len([]rune(strings.Replace(s, `''`, `'`, -1))) + 1)
+	}
+	return fmt.Sprintf(`SUBSTR(%s, %s)`, strings.Join(parts, ` || `), start), nil
+}
+
+// translateMd5 writes the hash in lower case hexadecimal digits like md5.
+func translateMd5(call *FuncCall) (string, error) {
+	if !call.Version.standardHash() {
+		return ``, errors.Wrapf(NotImplemented, `md5 needs STANDARD_HASH of oracle 12c, the target is %s`, call.Version)
+	}
+	return fmt.Sprintf(`LOWER(RAWTOHEX(STANDARD_HASH(%s, 'MD5')))`, call.Args[0]), nil
+}
@@ -0,0 +1,44 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertStringFuncs(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select substring(a from 0 for 3), substring(a from $1 for 2), substring(a, -1) from t`: `SELECT SUBSTR("a", 1, 2), SUBSTR("a", GREATEST(:arg1, 1), :arg1 + 2 - GREATEST(:arg1, 1)), "a" FROM "t"`,
+
+		`select substr(a, '2'), substr(a, 2, 3) from t`: `SELECT SUBSTR("a", GREATEST('2', 1)), SUBSTR("a", 2, 3) FROM "t"`,
+
+		`select split_part(a, '.', 2), split_part(a, ', ', $1) from t`: `SELECT REGEXP_SUBSTR("a", '(.*?)(\.|$)', 1, 2, 'n', 1), REGEXP_SUBSTR("a", '(.*?)(, |$)', 1, :arg1, 'n', 1) FROM "t"`,
+
+		`select concat(a, b, 1), concat_ws(', ', a, b) from t`: `SELECT ("a" || "b" || 1), SUBSTR(CASE WHEN "a" IS NOT NULL THEN ', ' || "a" END || CASE WHEN "b" IS NOT NULL THEN ', ' || "b" END, 3) FROM "t"`,
+
+		`select left(a, 2), left(a, -2), right(a, 3), right(a, -1) from t`: `SELECT SUBSTR("a", 1, 2), SUBSTR("a", 1, LENGTH("a") - 2), SUBSTR("a", GREATEST(LENGTH("a") - 2, 1)), SUBSTR("a", 2) FROM "t"`,
+
+		`select left(a, $1) from t`: `SELECT SUBSTR("a", 1, CASE WHEN :arg1 >= 0 THEN :arg1 ELSE LENGTH("a") + :arg1 END) FROM "t"`,
+
+		`select lpad(a, 5, '0'), rpad(a, 5), repeat(a, 3) from t`: `SELECT LPAD("a", 5, '0'), RPAD("a", 5), RPAD("a", LENGTH("a") * 3, "a") FROM "t"`,
+
+		`select btrim(a, 'xy'), trim(both 'x' from a), trim(a), ltrim(a, 'x'), rtrim(a) from t`: `SELECT LTRIM(RTRIM("a", 'xy'), 'xy'), LTRIM(RTRIM("a", 'x'), 'x'), TRIM("a"), LTRIM("a", 'x'), RTRIM("a") FROM "t"`,
+
+		`select initcap(a), char_length(a), strpos(a, '?'), position('x' in a) from t`: `SELECT INITCAP("a"), LENGTH("a"), INSTR("a", '?'), INSTR("a", 'x') FROM "t"`,
+
+		`select reverse(a) from t`: `SELECT (SELECT LISTAGG(SUBSTR("a", LEVEL, 1)) WITHIN GROUP (ORDER BY LEVEL DESC) FROM DUAL CONNECT BY LEVEL <= LENGTH("a")) FROM "t"`,
+	})
+
+	for _, sql := range []string{
+		`select split_part(a, b, 2) from t`,
+		`select split_part(a, ',', 0) from t`,
+		`select md5(a) from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+
+	testTranslateVersion(t, Oracle12c, map[string]string{
+		`select md5(a) from t`: `SELECT LOWER(RAWTOHEX(STANDARD_HASH("a", 'MD5'))) FROM "t"`,
+	})
+}
@@ -0,0 +1,169 @@
+package builder
+
+import (
+	"context"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"strconv"
+	"strings"
+)
+
+// StatementKind is the kind of the translated postgres statement.
+type StatementKind string
+
+const (
+	SelectKind StatementKind = `SELECT`
+	InsertKind StatementKind = `INSERT`
+	UpdateKind StatementKind = `UPDATE`
+	DeleteKind StatementKind = `DELETE`
+	DDLKind    StatementKind = `DDL`
+)
+
+var optypeKinds = map[optype]StatementKind{
+	selectType: SelectKind,
+	setOpType:  SelectKind,
+	insertType: InsertKind,
+	updateType: UpdateKind,
+	deleteType: DeleteKind,
+}
+
+// TranslatorOptions configures a Translator.
+type TranslatorOptions struct {
+	// InTx tells the translator that statements run inside a transaction
+	// owned by the caller, so no transaction control is emitted.
+	InTx bool
+	// MultiRowInsert selects how an insert of several rows is written.
+	MultiRowInsert MultiRowInsertMode
+	// InsertBatchSize is the maximum number of rows written by one insert
+	// statement, DefaultInsertBatchSize if zero.
+	InsertBatchSize int
+	// NullSafeConflict matches the conflict columns of an upsert NULL-safe.
+	NullSafeConflict bool
+	// Catalog holds the metadata of the tables the statements refer to.
+	Catalog *Catalog
+	// ReturningMode selects how a RETURNING clause is converted.
+	ReturningMode ReturningMode
+	// Version is the oracle version the statements are written for.
+	Version OracleVersion
+	// Funcs translates function calls, DefaultFuncRegistry if nil.
+	Funcs *FuncRegistry
+	// EmptyString selects what is done with the comparisons, concatenations
+	// and COALESCE calls with the empty string, which is NULL in oracle.
+	EmptyString EmptyStringMode
+	// Quoting selects how identifiers are quoted and folded.
+	Quoting QuotingMode
+	// ReservedNames maps the identifiers that are reserved words in oracle to
+	// the names they have in oracle, the others are quoted.
+	ReservedNames map[string]string
+}
+
+// Bind maps an oracle bind variable to the postgres placeholder it replaces.
+type Bind struct {
+	// Name is the oracle bind name without the leading colon, e.g. arg1.
+	Name string
+	// Placeholder is the index of the postgres placeholder, 1 for $1.
+	Placeholder int
+}
+
+// Result is the outcome of translating one postgres statement.
+type Result struct {
+	SQL  string
+	Kind StatementKind
+	// Binds lists the bind variables in the order they occur in SQL, a
+	// placeholder used several times occurs several times.
+	Binds []Bind
+	// OutBinds lists the out binds of RETURNING ... INTO in the order they
+	// occur in SQL.
+	OutBinds []OutBind
+	Warnings []Warning
+}
+
+// Translator translates postgres statements to oracle.
+// It is safe for concurrent use.
+type Translator struct {
+	opts TranslatorOptions
+}
+
+// NewTranslator creates a Translator.
+func NewTranslator(opts TranslatorOptions) *Translator {
+	return &Translator{opts: opts}
+}
+
+// Translate translates a single postgres statement.
+func (t *Translator) Translate(ctx context.Context, pgSQL string) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	cb := t.newBuilder()
+	if err := cb.Convert(pgSQL); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return cb.result()
+}
+
+func (t *Translator) translateStatement(stmt parser.Statement) (*Result, error) {
+	cb := t.newBuilder()
+	if err := cb.convertStatement(stmt); err != nil {
+		return nil, err
+	}
+	return cb.result()
+}
+
+func (t *Translator) newBuilder() *CustomBuilder {
+	return &CustomBuilder{
+		Builder:          Oracle().TargetVersion(t.opts.Version),
+		InTx:             t.opts.InTx,
+		MultiRowInsert:   t.opts.MultiRowInsert,
+		InsertBatchSize:  t.opts.InsertBatchSize,
+		NullSafeConflict: t.opts.NullSafeConflict,
+		Catalog:          t.opts.Catalog,
+		ReturningMode:    t.opts.ReturningMode,
+		Funcs:            t.opts.Funcs,
+		EmptyString:      t.opts.EmptyString,
+		Quoting:          t.opts.Quoting,
+		ReservedNames:    t.opts.ReservedNames,
+	}
+}
+
+func (cb *CustomBuilder) result() (*Result, error) {
+	raw, err := cb.rawBoundSQL()
+	if err != nil {
+		return nil, err
+	}
+	sql, err := convertPlaceHolder(raw)
+	if err != nil {
+		return nil, err
+	}
+	kind := optypeKinds[cb.optype]
+	if cb.kind != `` {
+		kind = cb.kind
+	}
+	return &Result{
+		SQL:      sql,
+		Kind:     kind,
+		Binds:    collectBinds(raw),
+		OutBinds: cb.outBinds,
+		Warnings: cb.Warnings(),
+	}, nil
+}
+
+// collectBinds lists the placeholders of input in the way convertPlaceHolder
+// names them.
+func collectBinds(input string) []Bind {
+	var binds []Bind
+	for idx := strings.Index(input, CustomPlaceHolder); idx != -1; idx = strings.Index(input, CustomPlaceHolder) {
+		input = input[idx+len(CustomPlaceHolder):]
+		end := 0
+		for end < len(input) && input[end] >= '0' && input[end] <= '9' {
+			end++
+		}
+		n, err := strconv.Atoi(input[:end])
+		if err != nil {
+			continue
+		}
+		binds = append(binds, Bind{Name: `arg` + input[:end], Placeholder: n})
+	}
+	return binds
+}
@@ -0,0 +1,57 @@
+package builder
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslate(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{})
+	res, err := tr.Translate(context.Background(), `select title from tasks where id = $1 and owner = $2 or owner = $1`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "title" FROM "tasks" WHERE ("id"=:arg1 AND "owner"=:arg2) OR "owner"=:arg1`, res.SQL)
+	require.Equal(t, SelectKind, res.Kind)
+	require.Equal(t, []Bind{{`arg1`, 1}, {`arg2`, 2}, {`arg1`, 1}}, res.Binds)
+	require.Empty(t, res.Warnings)
+
+	res, err = tr.Translate(context.Background(), `delete from a where id = 1`)
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "a" WHERE "id"=1`, res.SQL)
+	require.Equal(t, DeleteKind, res.Kind)
+}
+
+func TestTranslateWarnings(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{InTx: true})
+	res, err := tr.Translate(context.Background(), `update a set b = $1 where c = $2 returning e`)
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "a" SET "b"=:arg1 WHERE "c"=:arg2;
+SELECT "e" FROM "a" WHERE "c"=:arg2`, res.SQL)
+	require.Equal(t, UpdateKind, res.Kind)
+	require.Equal(t, []Bind{{`arg1`, 1}, {`arg2`, 2}, {`arg2`, 2}}, res.Binds)
+	require.Len(t, res.Warnings, 1)
+}
+
+func TestTranslateCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := NewTranslator(TranslatorOptions{}).Translate(ctx, `select a from b`)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestTranslateConcurrent(t *testing.T) {
+	tr := NewTranslator(TranslatorOptions{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := tr.Translate(context.Background(), `select a from b where c = $1`)
+			require.NoError(t, err)
+			require.Equal(t, `SELECT "a" FROM "b" WHERE "c"=:arg1`, res.SQL)
+		}()
+	}
+	wg.Wait()
+}
@@ -1,15 +1,20 @@
 package builder
 
 import (
+	"fmt"
 	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
 	"github.com/pkg/errors"
+	"strings"
 )
 
 func (cb *CustomBuilder) convertUpdate(update *parser.Update) error {
+	if len(update.From) > 0 {
+		return cb.convertUpdateFrom(update)
+	}
 	if err := cb.convertTable(update.Table); err != nil {
 		return err
 	}
-	eq, err := generateUpdateExpr(update.Exprs)
+	eq, err := cb.generateUpdateExpr(update.Exprs)
 	if err != nil {
 		return err
 	}
@@ -20,35 +25,146 @@ func (cb *CustomBuilder) convertUpdate(update *parser.Update) error {
 			return err
 		}
 	}
-	if err := cb.convertReturning(update.Returning); err != nil {
+	if err := cb.convertReturning(update.Table, update.Returning); err != nil {
 		return err
 	}
 	return nil
 }
 
-func generateUpdateExpr(exprs parser.UpdateExprs) (Eq, error) {
+func (cb *CustomBuilder) generateUpdateExpr(exprs parser.UpdateExprs) (Eq, error) {
+	columns, values, err := cb.updateColumns(exprs)
+	if err != nil {
+		return nil, err
+	}
 	eq := make(Eq)
+	for k, v := range columns {
+		eq[v] = values[k]
+	}
+	return eq, nil
+}
+
+// updateColumns returns the updated columns and their values in the order
+// of the SET clause.
+func (cb *CustomBuilder) updateColumns(exprs parser.UpdateExprs) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
 	for _, v := range exprs {
+		var vs []parser.Expr
 		if !v.Tuple {
 			if len(v.Names) != 1 {
-				return nil, errors.Wrap(NotImplemented, `generateUpdateExpr`)
+				return nil, nil, errors.Wrap(NotImplemented, `generateUpdateExpr`)
 			}
-			value, err := getValueFromExpr(v.Expr)
+			vs = []parser.Expr{v.Expr}
+		} else {
+			tuple, ok := v.Expr.(*parser.Tuple)
+			if !ok || len(tuple.Exprs) != len(v.Names) {
+				return nil, nil, errors.Wrapf(NotImplemented, `update %s`, formatNode(v))
+			}
+			vs = tuple.Exprs
+		}
+		for k, name := range v.Names {
+			value, err := cb.getValueFromExpr(vs[k])
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			leftStr, err := convertUnresolvedName(v.Names[0])
-			eq[leftStr] = value
-		} else {
-			for k, name := range v.Names {
-				value, err := getValueFromExpr(v.Expr.(*parser.Tuple).Exprs[k])
-				if err != nil {
-					return nil, err
-				}
-				leftStr, err := convertUnresolvedName(name)
-				eq[leftStr] = value
+			column, err := cb.convertUnresolvedName(name)
+			if err != nil {
+				return nil, nil, err
 			}
+			columns = append(columns, column)
+			values = append(values, value)
 		}
 	}
-	return eq, nil
+	return columns, values, nil
+}
+
+const updateFromTemplate = `MERGE INTO %s
+USING (%s) s
+ON (%s.ROWID = s.rid)
+WHEN MATCHED THEN
+UPDATE SET %s`
+
+// convertUpdateFrom converts UPDATE ... FROM. Without RETURNING it becomes a
+// MERGE whose source joins the target with the FROM list and matches the
+// target by ROWID, otherwise the new values and the join are moved to
+// correlated subqueries of the UPDATE.
+// The target is aliased by its own name, so that the columns it qualifies
+// resolve as in postgres. Where postgres updates a row joined to several rows
+// of the FROM list with one of them, oracle fails with ORA-30926.
+func (cb *CustomBuilder) convertUpdateFrom(update *parser.Update) error {
+	target, alias, err := cb.targetWithAlias(update.Table)
+	if err != nil {
+		return err
+	}
+	columns, values, err := cb.updateColumns(update.Exprs)
+	if err != nil {
+		return err
+	}
+	from := &parser.From{Tables: update.From}
+	if parser.HasReturningClause(update.Returning) {
+		return cb.convertUpdateCorrelated(update, target, columns, values, from)
+	}
+
+	source := cb.subBuilder(selectType)
+	selects := []string{alias + `.ROWID rid`}
+	sets := make([]string, 0, len(columns))
+	for k, v := range columns {
+		selects = append(selects, getDisplayValue(values[k])+` `+v)
+		sets = append(sets, fmt.Sprintf(`%s = s.%s`, v, v))
+	}
+	source.Select(strings.Join(selects, `, `))
+	if err := source.convertFrom(from); err != nil {
+		return err
+	}
+	source.from = target + `, ` + source.from
+	if err := source.convertWhere(update.Where); err != nil {
+		return err
+	}
+	using, err := source.rawBoundSQL()
+	if err != nil {
+		return err
+	}
+	cb.optype = updateType
+	cb.CustomSqlStr = fmt.Sprintf(updateFromTemplate, target, using, alias, strings.Join(sets, `, `))
+	return nil
+}
+
+func (cb *CustomBuilder) convertUpdateCorrelated(update *parser.Update, target string, columns []string, values []interface{}, from *parser.From) error {
+	displays := make([]string, 0, len(values))
+	for _, v := range values {
+		displays = append(displays, getDisplayValue(v))
+	}
+	set := cb.subBuilder(selectType)
+	set.Select(strings.Join(displays, `, `))
+	if err := set.convertFrom(from); err != nil {
+		return err
+	}
+	if err := set.convertWhere(update.Where); err != nil {
+		return err
+	}
+	setSQL, err := set.rawBoundSQL()
+	if err != nil {
+		return err
+	}
+	exists := cb.subBuilder(selectType)
+	exists.Select(`1`)
+	if err := exists.convertFrom(from); err != nil {
+		return err
+	}
+	if err := exists.convertWhere(update.Where); err != nil {
+		return err
+	}
+	existsSQL, err := exists.rawBoundSQL()
+	if err != nil {
+		return err
+	}
+	column := columns[0]
+	if len(columns) > 1 {
+		column = `(` + strings.Join(columns, `, `) + `)`
+	}
+	cb.From(target)
+	cb.Update(Eq{column: Expr(setSQL)})
+	cb.cond = NewCond()
+	cb.Where(Expr(`EXISTS (` + existsSQL + `)`))
+	return cb.convertReturning(update.Table, update.Returning)
 }
@@ -0,0 +1,42 @@
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertUpdateFrom(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`update a set b = c.d, e = $1 from c where a.id = c.aid and c.k > 2`: `MERGE INTO "a" "a"
+USING (SELECT "a".ROWID rid, "c"."d" "b", :arg1 "e" FROM "a" "a", "c" WHERE "a"."id"=("c"."aid") AND "c"."k">2) s
+ON ("a".ROWID = s.rid)
+WHEN MATCHED THEN
+UPDATE SET "b" = s."b", "e" = s."e"`,
+
+		`update a as x set (b, e) = (y.d, y.e + 1) from c y, f where x.id = y.aid and y.f = f.id`: `MERGE INTO "a" "x"
+USING (SELECT "x".ROWID rid, "y"."d" "b", "y"."e" + 1 "e" FROM "a" "x", "c" "y", "f" WHERE "x"."id"=("y"."aid") AND "y"."f"=("f"."id")) s
+ON ("x".ROWID = s.rid)
+WHEN MATCHED THEN
+UPDATE SET "b" = s."b", "e" = s."e"`,
+
+		`update a as x set b = 1 where x.c = 2`: `UPDATE "a" "x" SET "b"=1 WHERE "x"."c"=2`,
+
+		`select a.x, b.y from a, b where a.id = b.aid`: `SELECT "a"."x", "b"."y" FROM "a", "b" WHERE "a"."id"=("b"."aid")`,
+	})
+
+	cb := &CustomBuilder{Builder: Oracle(), InTx: true, ReturningMode: ReturningInto}
+	sql, err := convertBy(cb, `update a set (b, e) = (c.d, $1) from c where a.id = c.aid returning a.b`)
+	require.NoError(t, err)
+	require.Equal(t, `UPDATE "a" "a" SET ("b", "e")=(SELECT "c"."d", :arg1 FROM "c" WHERE "a"."id"=("c"."aid")) WHERE EXISTS (SELECT 1 FROM "c" WHERE "a"."id"=("c"."aid")) RETURNING "a"."b" INTO :out1`, sql)
+
+	_, err = convert(`update a set (b, c) = (select 1, 2)`)
+	require.Error(t, err)
+}
+
+func TestTranslateUpdateFromSubquery(t *testing.T) {
+	res, err := NewTranslator(TranslatorOptions{}).Translate(context.Background(), `update a set b = s.n from (select aid, d as n from c where k = $1) s where a.id = s.aid`)
+	require.NoError(t, err)
+	require.Equal(t, []Bind{{`arg1`, 1}}, res.Binds)
+}
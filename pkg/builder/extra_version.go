@@ -0,0 +1,98 @@
+package builder
+
+import (
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// OracleVersion is the oracle release the converted statements run on, the
+// features of later releases are only used when the target has them.
+type OracleVersion int
+
+const (
+	// Oracle11g is the default target.
+	Oracle11g OracleVersion = iota
+	// Oracle12c is 12.1, it adds OFFSET ... FETCH NEXT and identity columns.
+	Oracle12c
+	// Oracle19c allows identifiers of 128 bytes.
+	Oracle19c
+	// Oracle23ai adds BOOLEAN, JSON, EXCEPT ALL and IF [NOT] EXISTS.
+	Oracle23ai
+)
+
+var oracleVersionNames = map[OracleVersion]string{
+	Oracle11g:  `11g`,
+	Oracle12c:  `12c`,
+	Oracle19c:  `19c`,
+	Oracle23ai: `23ai`,
+}
+
+func (v OracleVersion) String() string {
+	if name, ok := oracleVersionNames[v]; ok {
+		return name
+	}
+	return `unknown`
+}
+
+// ParseOracleVersion parses the name of an oracle version, e.g. 19c.
+func ParseOracleVersion(s string) (OracleVersion, error) {
+	for v, name := range oracleVersionNames {
+		if strings.EqualFold(name, s) {
+			return v, nil
+		}
+	}
+	return Oracle11g, errors.Errorf(`unknown oracle version %s`, s)
+}
+
+// TargetVersion sets the oracle version the statement is written for.
+func (b *Builder) TargetVersion(v OracleVersion) *Builder {
+	b.version = v
+	return b
+}
+
+func (v OracleVersion) fetchFirst() bool {
+	return v >= Oracle12c
+}
+
+func (v OracleVersion) identity() bool {
+	return v >= Oracle12c
+}
+
+func (v OracleVersion) standardHash() bool {
+	return v >= Oracle12c
+}
+
+func (v OracleVersion) listaggDistinct() bool {
+	return v >= Oracle19c
+}
+
+func (v OracleVersion) boolean() bool {
+	return v >= Oracle23ai
+}
+
+// setOpAll reports whether EXCEPT ALL and INTERSECT ALL are supported, before
+// EXCEPT ALL is supported EXCEPT is written MINUS.
+func (v OracleVersion) setOpAll() bool {
+	return v >= Oracle23ai
+}
+
+func (v OracleVersion) ifExists() bool {
+	return v >= Oracle23ai
+}
+
+// maxIdentifierLength is the maximum length of an identifier in bytes.
+func (v OracleVersion) maxIdentifierLength() int {
+	if v >= Oracle19c {
+		return 128
+	}
+	return 30
+}
+
+// checkIdentifier fails when name is too long for the target version.
+func (cb *CustomBuilder) checkIdentifier(name string) error {
+	name = strings.Trim(name, `"`)
+	if max := cb.version.maxIdentifierLength(); len(name) > max {
+		return errors.Errorf(`identifier %s is longer than %d bytes, the limit of oracle %s`, name, max, cb.version)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package builder
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testTranslateVersion(t *testing.T, v OracleVersion, cases map[string]string) {
+	t.Helper()
+	tr := NewTranslator(TranslatorOptions{Version: v})
+	for sql, expected := range cases {
+		res, err := tr.Translate(context.Background(), sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, expected, res.SQL, sql)
+	}
+}
+
+func TestParseOracleVersion(t *testing.T) {
+	v, err := ParseOracleVersion(`19C`)
+	require.NoError(t, err)
+	require.Equal(t, Oracle19c, v)
+	require.Equal(t, `19c`, v.String())
+	_, err = ParseOracleVersion(`10g`)
+	require.Error(t, err)
+}
+
+func TestTranslateVersion11g(t *testing.T) {
+	testTranslateVersion(t, Oracle11g, map[string]string{
+		`select a from b order by a limit 10 offset 20`: `SELECT "a" FROM (SELECT at.*,ROWNUM RN FROM (SELECT "a" FROM "b" ORDER BY "a") at WHERE ROWNUM<=30) att WHERE att.RN>20`,
+		`(select a from b) except (select a from c)`:    `(SELECT "a" FROM "b") MINUS (SELECT "a" FROM "c")`,
+		`select a from b where c = true`:                `SELECT "a" FROM "b" WHERE "c"=1`,
+		`select cast(a as bool) from b`:                 `SELECT CAST("a" AS NUMBER(1)) FROM "b"`,
+
+		`create table t (id serial primary key, nick varchar(20) not null default 'x', ok bool, constraint t_nick_key unique (nick))`: `CREATE TABLE "t" (
+"id" NUMBER PRIMARY KEY,
+"nick" VARCHAR2(20) DEFAULT 'x' NOT NULL,
+"ok" NUMBER(1),
+CONSTRAINT "t_nick_key" UNIQUE ("nick")
+)`,
+		`create table if not exists t (a int)`: `BEGIN
+EXECUTE IMMEDIATE 'CREATE TABLE "t" (
+"a" NUMBER
+)';
+EXCEPTION
+WHEN OTHERS THEN
+IF SQLCODE != -955 THEN
+RAISE;
+END IF;
+END;`,
+		`drop table t cascade`: `DROP TABLE "t" CASCADE CONSTRAINTS`,
+		`drop table if exists t, u`: `BEGIN
+BEGIN
+EXECUTE IMMEDIATE 'DROP TABLE "t"';
+EXCEPTION
+WHEN OTHERS THEN
+IF SQLCODE != -942 THEN
+RAISE;
+END IF;
+END;
+BEGIN
+EXECUTE IMMEDIATE 'DROP TABLE "u"';
+EXCEPTION
+WHEN OTHERS THEN
+IF SQLCODE != -942 THEN
+RAISE;
+END IF;
+END;
+END;`,
+	})
+}
+
+func TestTranslateVersion19c(t *testing.T) {
+	testTranslateVersion(t, Oracle19c, map[string]string{
+		`select a from b order by a limit 10 offset 20`: `SELECT "a" FROM "b" ORDER BY "a" OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY`,
+		`select a from b limit 10`:                      `SELECT "a" FROM "b" FETCH NEXT 10 ROWS ONLY`,
+		`(select a from b) except (select a from c)`:    `(SELECT "a" FROM "b") MINUS (SELECT "a" FROM "c")`,
+		`create table t (id serial primary key)`: `CREATE TABLE "t" (
+"id" NUMBER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY
+)`,
+	})
+}
+
+func TestTranslateVersion23ai(t *testing.T) {
+	testTranslateVersion(t, Oracle23ai, map[string]string{
+		`(select a from b) except (select a from c)`: `(SELECT "a" FROM "b") EXCEPT (SELECT "a" FROM "c")`,
+		`select cast(a as bool) from b`:              `SELECT CAST("a" AS BOOLEAN) FROM "b"`,
+		`create table if not exists t (ok bool)`: `CREATE TABLE IF NOT EXISTS "t" (
+"ok" BOOLEAN
+)`,
+		`drop table if exists t, u cascade`: `BEGIN
+EXECUTE IMMEDIATE 'DROP TABLE IF EXISTS "t" CASCADE CONSTRAINTS';
+EXECUTE IMMEDIATE 'DROP TABLE IF EXISTS "u" CASCADE CONSTRAINTS';
+END;`,
+	})
+}
+
+func TestTranslateVersionSerial(t *testing.T) {
+	res, err := NewTranslator(TranslatorOptions{}).Translate(context.Background(), `create table t (id serial)`)
+	require.NoError(t, err)
+	require.Equal(t, DDLKind, res.Kind)
+	require.Len(t, res.Warnings, 1)
+}
+
+func TestTranslateVersionIdentifierLength(t *testing.T) {
+	sql := `select ` + strings.Repeat(`a`, 31) + ` from b`
+	_, err := NewTranslator(TranslatorOptions{Version: Oracle11g}).Translate(context.Background(), sql)
+	require.Error(t, err)
+	_, err = NewTranslator(TranslatorOptions{Version: Oracle19c}).Translate(context.Background(), sql)
+	require.NoError(t, err)
+}
+
+func TestTranslateVersionExceptAll(t *testing.T) {
+	sql := `(select a from b) except all (select a from c)`
+	_, err := NewTranslator(TranslatorOptions{Version: Oracle19c}).Translate(context.Background(), sql)
+	require.Error(t, err)
+	testTranslateVersion(t, Oracle23ai, map[string]string{
+		sql: `(SELECT "a" FROM "b") EXCEPT ALL (SELECT "a" FROM "c")`,
+	})
+}
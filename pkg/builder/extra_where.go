@@ -13,64 +13,80 @@ func (cb *CustomBuilder) convertWhere(where *parser.Where) error {
 	}
 	switch where.Type {
 	case `WHERE`:
-		cond, err := convertExprToCond(where.Expr)
+		cond, err := cb.convertExprToCond(where.Expr)
 		if err != nil {
 			return err
 		}
 		cb.Where(cond)
 	case `HAVING`:
-		return errors.Wrap(NotImplemented, `having`)
+		cond, err := cb.convertExprToCond(where.Expr)
+		if err != nil {
+			return err
+		}
+		sql, err := ToBoundSQL(cond)
+		if err != nil {
+			return err
+		}
+		cb.Having(sql)
 	}
 	return nil
 }
 
-func convertExprToCond(expr parser.Expr) (Cond, error) {
+func (cb *CustomBuilder) convertExprToCond(expr parser.Expr) (Cond, error) {
 	switch e := expr.(type) {
 	case *parser.AndExpr:
-		left, err := convertExprToCond(e.Left)
+		left, err := cb.convertExprToCond(e.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := convertExprToCond(e.Right)
+		right, err := cb.convertExprToCond(e.Right)
 		if err != nil {
 			return nil, err
 		}
 		return And(left, right), nil
 	case *parser.OrExpr:
-		left, err := convertExprToCond(e.Left)
+		left, err := cb.convertExprToCond(e.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := convertExprToCond(e.Right)
+		right, err := cb.convertExprToCond(e.Right)
 		if err != nil {
 			return nil, err
 		}
 		return Or(left, right), nil
 	case *parser.ComparisonExpr:
-		return convertComparisonExpr(e)
+		return cb.convertComparisonExpr(e)
 	case *parser.ParenExpr:
-		return convertExprToCond(e.Expr)
+		return cb.convertExprToCond(e.Expr)
 	case *parser.RangeCond:
-		return convertRangeCond(e)
+		return cb.convertRangeCond(e)
+	case *parser.NotExpr:
+		cond, err := cb.convertExprToCond(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return Not{cond}, nil
+	case parser.UnresolvedName, *parser.DBool, *parser.FuncExpr, *parser.CoalesceExpr:
+		return cb.boolCond(e)
 	default:
 		return nil, errors.Wrapf(NotImplemented, `sql: %s, type: %s`, e.String(), reflect.TypeOf(e))
 	}
 }
 
-func convertRangeCond(expr *parser.RangeCond) (Cond, error) {
+func (cb *CustomBuilder) convertRangeCond(expr *parser.RangeCond) (Cond, error) {
 	left, ok := expr.Left.(parser.UnresolvedName)
 	if !ok {
 		return nil, errors.Wrap(NotImplemented, `RangeCond Left`)
 	}
-	leftStr, err := convertUnresolvedName(left)
+	leftStr, err := cb.convertUnresolvedName(left)
 	if err != nil {
 		return nil, err
 	}
-	from, err := getValueFromExpr(expr.From)
+	from, err := cb.getValueFromExpr(expr.From)
 	if err != nil {
 		return nil, err
 	}
-	to, err := getValueFromExpr(expr.To)
+	to, err := cb.getValueFromExpr(expr.To)
 	if err != nil {
 		return nil, err
 	}
@@ -81,15 +97,36 @@ func convertRangeCond(expr *parser.RangeCond) (Cond, error) {
 	return Expr(fmt.Sprintf(`%s%s BETWEEN %s AND %s`, leftStr, not, getDisplayValue(from), getDisplayValue(to))), nil
 }
 
-func convertComparisonExpr(expr *parser.ComparisonExpr) (Cond, error) {
-	if expr.SubOperator != 0 {
-		return nil, errors.Wrap(NotImplemented, `subOperator`)
+func (cb *CustomBuilder) convertComparisonExpr(expr *parser.ComparisonExpr) (Cond, error) {
+	switch expr.Operator {
+	case parser.Any, parser.Some, parser.All:
+		return cb.convertQuantified(expr)
+	case parser.Is, parser.IsNot:
+		if b, ok := expr.Right.(*parser.DBool); ok {
+			return cb.convertBoolTest(expr.Left, expr.Operator == parser.IsNot, bool(*b))
+		}
+		if expr.Right != parser.DNull {
+			return nil, errors.Wrap(NotImplemented, expr.Right.String())
+		}
+	case parser.EQ, parser.NE, parser.In, parser.NotIn:
+	case parser.GT, parser.GE, parser.LT, parser.LE, parser.Like, parser.NotLike, parser.ILike, parser.NotILike:
+		// oracle compares lists of values only for equality.
+		if _, ok := parser.StripParens(expr.Left).(*parser.Tuple); ok {
+			return nil, errors.Wrapf(NotImplemented, `row comparison %s`, expr.Operator)
+		}
+	default:
+		return nil, errors.Wrapf(NotImplemented, `operator %s`, expr.Operator)
 	}
-	value, err := getValueFromExpr(expr.Right)
+	if cond, ok, err := cb.emptyStringComparison(expr); ok || err != nil {
+		return cond, err
+	}
+	// the operands are converted once the comparison is known to be written,
+	// so that the reserved names are reported only when they are written.
+	value, err := cb.getValueFromExpr(expr.Right)
 	if err != nil {
 		return nil, err
 	}
-	leftValue, err := getExprDisplayValue(expr.Left)
+	leftValue, err := cb.getExprDisplayValue(expr.Left)
 	if err != nil {
 		return nil, errors.Wrap(NotImplemented, `comparisonExpr Left`)
 	}
@@ -106,22 +143,38 @@ func convertComparisonExpr(expr *parser.ComparisonExpr) (Cond, error) {
 		return Lte{leftValue: value}, nil
 	case parser.NE:
 		return Neq{leftValue: value}, nil
-	case parser.Is, parser.IsNot:
-		if expr.Right != parser.DNull {
-			return nil, errors.Wrap(NotImplemented, expr.Right.String())
-		}
-		if expr.Operator == parser.Is {
-			return IsNull{leftValue}, nil
-		} else {
-			return NotNull{leftValue}, nil
-		}
-	case parser.NotILike, parser.ILike:
-		not := ``
-		if expr.Operator == parser.NotILike {
-			not = ` NOT`
-		}
-		return Expr(fmt.Sprintf(`UPPER(%s)%s LIKE UPPER(%s)`, leftValue, not, expr.Right.String())), nil
+	case parser.Is:
+		return IsNull{leftValue}, nil
+	case parser.IsNot:
+		return NotNull{leftValue}, nil
+	case parser.In, parser.NotIn, parser.Like, parser.NotLike:
+		return Expr(fmt.Sprintf(`%s %s %s`, leftValue, expr.Operator, getDisplayValue(value))), nil
+	}
+	not := ``
+	if expr.Operator == parser.NotILike {
+		not = ` NOT`
+	}
+	return Expr(fmt.Sprintf(`UPPER(%s)%s LIKE UPPER(%s)`, leftValue, not, getDisplayValue(value))), nil
+}
+
+// convertQuantified converts a comparison with ANY, SOME or ALL of the rows
+// of a subquery or of a list of values, postgres arrays are not converted.
+func (cb *CustomBuilder) convertQuantified(expr *parser.ComparisonExpr) (Cond, error) {
+	switch parser.StripParens(expr.Right).(type) {
+	case *parser.Subquery, *parser.Tuple:
 	default:
-		return Expr(expr.String()), nil
+		return nil, errors.Wrapf(NotImplemented, `%s of an array`, expr.Operator)
+	}
+	if list, ok := parser.StripParens(expr.Right).(*parser.Tuple); ok {
+		cb.emptyStringList(expr, list)
+	}
+	left, err := cb.getExprDisplayValue(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := cb.getExprDisplayValue(parser.StripParens(expr.Right))
+	if err != nil {
+		return nil, err
 	}
+	return Expr(fmt.Sprintf(`%s %s %s %s`, left, expr.SubOperator, expr.Operator, right)), nil
 }
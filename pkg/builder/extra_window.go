@@ -0,0 +1,170 @@
+package builder
+
+import (
+	"fmt"
+	parser "github.com/EchoUtopia/pg2oracle/pkg/postgres"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+func registerWindowFuncs(r *FuncRegistry) {
+	for _, name := range []string{`row_number`, `rank`, `dense_rank`, `percent_rank`, `cume_dist`} {
+		r.Register(name, 0, windowFunc(strings.ToUpper(name)))
+	}
+	for _, name := range []string{`ntile`, `first_value`, `last_value`} {
+		r.Register(name, 1, windowFunc(strings.ToUpper(name)))
+	}
+	r.Register(`nth_value`, 2, windowFunc(`NTH_VALUE`))
+	for _, name := range []string{`lag`, `lead`} {
+		for arity := 1; arity <= 3; arity++ {
+			r.Register(name, arity, windowFunc(strings.ToUpper(name)))
+		}
+	}
+}
+
+// windowFunc translates a window function that is written the same way in
+// oracle.
+func windowFunc(name string) FuncTranslator {
+	return func(call *FuncCall) (string, error) {
+		if call.Over == `` {
+			return ``, errors.Errorf(`window function %s needs OVER`, call.Name)
+		}
+		return call.Windowed(fmt.Sprintf(`%s(%s)`, name, strings.Join(call.Args, `, `))), nil
+	}
+}
+
+// resolveWindow returns the window of OVER, a named window is inlined
+// because oracle has no WINDOW clause before 21c.
+func (cb *CustomBuilder) resolveWindow(def *parser.WindowDef, windows parser.Window) (*parser.WindowDef, error) {
+	name := def.Name
+	if name == `` {
+		name = def.RefName
+	}
+	if name == `` {
+		return def, nil
+	}
+	var (
+		base *parser.WindowDef
+		i    int
+	)
+	for i = range windows {
+		if windows[i].Name == name {
+			base = windows[i]
+			break
+		}
+	}
+	if base == nil {
+		return nil, errors.Errorf(`window %s does not exist`, name)
+	}
+	// a window definition refers to the definitions before it.
+	base, err := cb.resolveWindow(&parser.WindowDef{
+		RefName:    base.RefName,
+		Partitions: base.Partitions,
+		OrderBy:    base.OrderBy,
+		Frame:      base.Frame,
+	}, windows[:i])
+	if err != nil {
+		return nil, err
+	}
+	if def.Name != `` {
+		return base, nil
+	}
+	window := *base
+	if def.Partitions != nil {
+		return nil, errors.Errorf(`cannot override PARTITION BY clause of window %s`, name)
+	}
+	if def.OrderBy != nil {
+		if base.OrderBy != nil {
+			return nil, errors.Errorf(`cannot override ORDER BY clause of window %s`, name)
+		}
+		window.OrderBy = def.OrderBy
+	}
+	if base.Frame != nil {
+		return nil, errors.Errorf(`cannot copy window %s because it has a frame clause`, name)
+	}
+	window.Frame = def.Frame
+	return &window, nil
+}
+
+// convertWindow converts the window of OVER.
+func (cb *CustomBuilder) convertWindow(def *parser.WindowDef) (string, error) {
+	def, err := cb.resolveWindow(def, cb.windows)
+	if err != nil {
+		return ``, err
+	}
+	var clauses []string
+	if len(def.Partitions) > 0 {
+		partitions := make([]string, 0, len(def.Partitions))
+		for _, v := range def.Partitions {
+			partition, err := cb.getExprDisplayValue(v)
+			if err != nil {
+				return ``, err
+			}
+			partitions = append(partitions, partition)
+		}
+		clauses = append(clauses, `PARTITION BY `+strings.Join(partitions, `, `))
+	}
+	orders, err := cb.orderByItems(def.OrderBy)
+	if err != nil {
+		return ``, err
+	}
+	frame := def.Frame
+	if frame != nil && len(orders) == 0 {
+		// oracle needs an ORDER BY for a frame. Without one all the rows
+		// of a partition are peers in RANGE mode, which frames them all.
+		if frame.Mode == parser.RangeMode {
+			frame = nil
+		} else {
+			orders = []string{`NULL`}
+		}
+	}
+	if len(orders) > 0 {
+		clauses = append(clauses, `ORDER BY `+strings.Join(orders, `, `))
+	}
+	if frame != nil {
+		converted, err := cb.convertFrame(frame)
+		if err != nil {
+			return ``, err
+		}
+		clauses = append(clauses, converted)
+	}
+	return `OVER (` + strings.Join(clauses, ` `) + `)`, nil
+}
+
+func (cb *CustomBuilder) convertFrame(frame *parser.FrameSpec) (string, error) {
+	mode := `RANGE`
+	if frame.Mode == parser.RowsMode {
+		mode = `ROWS`
+	}
+	start, err := cb.convertFrameBound(frame.Bounds.StartBound)
+	if err != nil {
+		return ``, err
+	}
+	if frame.Bounds.EndBound == nil {
+		return mode + ` ` + start, nil
+	}
+	end, err := cb.convertFrameBound(frame.Bounds.EndBound)
+	if err != nil {
+		return ``, err
+	}
+	return fmt.Sprintf(`%s BETWEEN %s AND %s`, mode, start, end), nil
+}
+
+func (cb *CustomBuilder) convertFrameBound(bound *parser.FrameBound) (string, error) {
+	switch bound.BoundType {
+	case parser.UnboundedPreceding:
+		return `UNBOUNDED PRECEDING`, nil
+	case parser.UnboundedFollowing:
+		return `UNBOUNDED FOLLOWING`, nil
+	case parser.CurrentRow:
+		return `CURRENT ROW`, nil
+	}
+	offset, err := cb.getExprDisplayValue(bound.OffsetExpr)
+	if err != nil {
+		return ``, err
+	}
+	if bound.BoundType == parser.OffsetPreceding {
+		return offset + ` PRECEDING`, nil
+	}
+	return offset + ` FOLLOWING`, nil
+}
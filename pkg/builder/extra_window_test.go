@@ -0,0 +1,34 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertWindows(t *testing.T) {
+	testConvertCases(t, map[string]string{
+		`select row_number() over (partition by a order by b desc), lag(x, 1, 0) over (order by b), count(*) over () from t`: `SELECT ROW_NUMBER() OVER (PARTITION BY "a" ORDER BY "b" DESC), LAG("x", 1, 0) OVER (ORDER BY "b"), COUNT(*) OVER () FROM "t"`,
+
+		`select rank() over w, sum(x) over (w rows between 1 preceding and current row) from t window w as (partition by a order by b)`: `SELECT RANK() OVER (PARTITION BY "a" ORDER BY "b"), SUM("x") OVER (PARTITION BY "a" ORDER BY "b" ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM "t"`,
+
+		`select first_value(x) over (w order by b) from t window v as (partition by a), w as (v)`: `SELECT FIRST_VALUE("x") OVER (PARTITION BY "a" ORDER BY "b") FROM "t"`,
+
+		`select first_value(x) over (rows between unbounded preceding and unbounded following), last_value(x) over (range between unbounded preceding and unbounded following) from t`: `SELECT FIRST_VALUE("x") OVER (ORDER BY NULL ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING), LAST_VALUE("x") OVER () FROM "t"`,
+
+		`select sum(x) over (order by d range between interval '1 day' preceding and current row) from t`: `SELECT SUM("x") OVER (ORDER BY "d" RANGE BETWEEN INTERVAL '1' DAY PRECEDING AND CURRENT ROW) FROM "t"`,
+
+		`select count(*) filter (where a > 1) over (partition by b) from t`: `SELECT COUNT(CASE WHEN "a">1 THEN 1 END) OVER (PARTITION BY "b") FROM "t"`,
+	})
+
+	for _, sql := range []string{
+		`select row_number() from t`,
+		`select rank() over v from t window w as (order by a)`,
+		`select rank() over (w order by b) from t window w as (order by a)`,
+		`select rank() over (w) from t window w as (rows 1 preceding)`,
+		`select string_agg(a, ',') over (partition by b) from t`,
+	} {
+		_, err := convert(sql)
+		require.Error(t, err, sql)
+	}
+}
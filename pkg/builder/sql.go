@@ -92,12 +92,17 @@ func noSQLQuoteNeeded(a interface{}) bool {
 	return false
 }
 
-// ConvertToBoundSQL will convert SQL and args to a bound SQL
+// ConvertToBoundSQL will convert SQL and args to a bound SQL, a ? in a string
+// literal is kept
 func ConvertToBoundSQL(sql string, args []interface{}) (string, error) {
 	buf := strings.Builder{}
 	var i, j, start int
+	var ready = true
 	for ; i < len(sql); i++ {
-		if sql[i] == '?' {
+		if sql[i] == '\'' {
+			ready = !ready
+		}
+		if ready && sql[i] == '?' {
 			_, err := buf.WriteString(sql[start:i])
 			if err != nil {
 				return "", err
@@ -74,6 +74,10 @@ func TestBoundSQLConverter(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualValues(t, ErrNeedMoreArguments, err)
 
+	newSQL, err = ConvertToBoundSQL("SELECT 'a?', 'it''s?' FROM table_a WHERE id=?", []interface{}{1})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "SELECT 'a?', 'it''s?' FROM table_a WHERE id=1", newSQL)
+
 	newSQL, err = ToBoundSQL(Select("id").From("table").Where(In("a", 1, 2)))
 	assert.NoError(t, err)
 	assert.EqualValues(t, "SELECT id FROM table WHERE a IN (1,2)", newSQL)
@@ -24,6 +24,7 @@ func init() {
 		},
 	},
 	Rows: &parser.Select{
+		With: nil,
 		Select: &parser.ValuesClause{
 			Tuples: []*parser.Tuple{
 				&parser.Tuple{
@@ -57,6 +58,7 @@ func init() {
 		},
 	},
 	Rows: &parser.Select{
+		With: nil,
 		Select: &parser.ValuesClause{
 			Tuples: []*parser.Tuple{
 				&parser.Tuple{
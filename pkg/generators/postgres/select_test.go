@@ -29,8 +29,10 @@ func init() {
 		"LIMIT 5 OFFSET 10")
 
 	SelectQueries = append(SelectQueries, `&parser.Select{
+	With: nil,
 	Select: &parser.SelectClause{
-		Distinct: false,
+		Distinct:   false,
+		DistinctOn: nil,
 		Exprs: parser.SelectExprs{
 			parser.SelectExpr{
 				Expr: parser.UnresolvedName{
@@ -92,8 +94,10 @@ func init() {
 }`)
 
 	SelectQueries = append(SelectQueries, `&parser.Select{
+	With: nil,
 	Select: &parser.SelectClause{
-		Distinct: false,
+		Distinct:   false,
+		DistinctOn: nil,
 		Exprs: parser.SelectExprs{
 			parser.SelectExpr{
 				Expr: parser.UnresolvedName{
@@ -175,8 +179,10 @@ func init() {
 }`)
 
 	SelectQueries = append(SelectQueries, `&parser.Select{
+	With: nil,
 	Select: &parser.SelectClause{
-		Distinct: false,
+		Distinct:   false,
+		DistinctOn: nil,
 		Exprs: parser.SelectExprs{
 			parser.SelectExpr{
 				Expr: parser.UnresolvedName{
@@ -292,8 +298,10 @@ func init() {
 }`)
 
 	SelectQueries = append(SelectQueries, `&parser.Select{
+	With: nil,
 	Select: &parser.SelectClause{
-		Distinct: false,
+		Distinct:   false,
+		DistinctOn: nil,
 		Exprs: parser.SelectExprs{
 			parser.SelectExpr{
 				Expr: &parser.CaseExpr{
@@ -396,8 +404,10 @@ func init() {
 }`)
 
 	SelectQueries = append(SelectQueries, `&parser.Select{
+	With: nil,
 	Select: &parser.SelectClause{
-		Distinct: false,
+		Distinct:   false,
+		DistinctOn: nil,
 		Exprs: parser.SelectExprs{
 			parser.SelectExpr{
 				Expr: parser.UnresolvedName{
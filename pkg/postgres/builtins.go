@@ -977,6 +977,18 @@ var Builtins = map[string][]Builtin{
 	"current_timestamp":     txnTSImpl,
 	"transaction_timestamp": txnTSImpl,
 
+	"localtimestamp": {
+		Builtin{
+			Types:      ArgTypes{},
+			ReturnType: fixedReturnType(TypeTimestamp),
+			impure:     true,
+			fn: func(ctx *EvalContext, args Datums) (Datum, error) {
+				return ctx.GetTxnTimestampNoZone(time.Microsecond), nil
+			},
+			Info: "Returns the current transaction's timestamp without time zone.",
+		},
+	},
+
 	"statement_timestamp": {
 		Builtin{
 			Types:             ArgTypes{},
@@ -27,6 +27,7 @@ import "bytes"
 // Delete represents a DELETE statement.
 type Delete struct {
 	Table     TableExpr
+	Using     TableExprs
 	Where     *Where
 	Returning ReturningClause
 }
@@ -35,6 +36,14 @@ type Delete struct {
 func (node *Delete) Format(buf *bytes.Buffer, f FmtFlags) {
 	buf.WriteString("DELETE FROM ")
 	FormatNode(buf, f, node.Table)
+	for i, n := range node.Using {
+		if i == 0 {
+			buf.WriteString(" USING ")
+		} else {
+			buf.WriteString(", ")
+		}
+		FormatNode(buf, f, n)
+	}
 	FormatNode(buf, f, node.Where)
 	FormatNode(buf, f, node.Returning)
 }
@@ -882,6 +882,8 @@ type FuncExpr struct {
 	Func  ResolvableFunctionReference
 	Type  funcType
 	Exprs Exprs
+	// OrderBy orders the input of aggregates: STRING_AGG(k, ',' ORDER BY k)
+	OrderBy OrderBy
 	// Filter is used for filters on aggregates: SUM(k) FILTER (WHERE k > 0)
 	Filter    Expr
 	WindowDef *WindowDef
@@ -965,6 +967,7 @@ func (node *FuncExpr) Format(buf *bytes.Buffer, f FmtFlags) {
 	buf.WriteByte('(')
 	buf.WriteString(typ)
 	FormatNode(buf, f, node.Exprs)
+	FormatNode(buf, f, node.OrderBy)
 	buf.WriteByte(')')
 	if window := node.WindowDef; window != nil {
 		buf.WriteString(" OVER ")
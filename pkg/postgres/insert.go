@@ -60,6 +60,10 @@ func (node *Insert) Format(buf *bytes.Buffer, f FmtFlags) {
 			FormatNode(buf, f, node.OnConflict.Columns)
 			buf.WriteString(")")
 		}
+		if node.OnConflict.Constraint != "" {
+			buf.WriteString(" ON CONSTRAINT ")
+			FormatNode(buf, f, node.OnConflict.Constraint)
+		}
 		if node.OnConflict.DoNothing {
 			buf.WriteString(" DO NOTHING")
 		} else {
@@ -85,13 +89,15 @@ func (node *Insert) DefaultValues() bool {
 // uses the primary key for as the conflict index and the values being inserted
 // for Exprs.
 type OnConflict struct {
-	Columns   NameList
-	Exprs     UpdateExprs
-	Where     *Where
-	DoNothing bool
+	Columns NameList
+	// Constraint names the conflict target of ON CONFLICT ON CONSTRAINT.
+	Constraint Name
+	Exprs      UpdateExprs
+	Where      *Where
+	DoNothing  bool
 }
 
 // IsUpsertAlias returns true if the UPSERT syntactic sugar was used.
 func (oc *OnConflict) IsUpsertAlias() bool {
-	return oc != nil && oc.Columns == nil && oc.Exprs == nil && oc.Where == nil && !oc.DoNothing
+	return oc != nil && oc.Columns == nil && oc.Constraint == "" && oc.Exprs == nil && oc.Where == nil && !oc.DoNothing
 }
@@ -173,6 +173,8 @@ func TestParse(t *testing.T) {
 		{`DELETE FROM a WHERE a = b RETURNING 1, 2`},
 		{`DELETE FROM a WHERE a = b RETURNING a + b`},
 		{`DELETE FROM a WHERE a = b RETURNING NOTHING`},
+		{`DELETE FROM a USING b WHERE a.id = b.aid`},
+		{`DELETE FROM a AS x USING b AS y, c WHERE (x.id = y.aid) AND (y.c = c.id) RETURNING x.id`},
 
 		{`DISCARD ALL`},
 
@@ -335,6 +337,8 @@ func TestParse(t *testing.T) {
 
 		{`INSERT INTO a VALUES (1) ON CONFLICT DO NOTHING`},
 		{`INSERT INTO a VALUES (1) ON CONFLICT (a) DO NOTHING`},
+		{`INSERT INTO a VALUES (1) ON CONFLICT ON CONSTRAINT a_pkey DO NOTHING`},
+		{`INSERT INTO a VALUES (1) ON CONFLICT ON CONSTRAINT a_pkey DO UPDATE SET a = excluded.a`},
 		{`INSERT INTO a VALUES (1) ON CONFLICT DO UPDATE SET a = 1`},
 		{`INSERT INTO a VALUES (1) ON CONFLICT (a) DO UPDATE SET a = 1`},
 		{`INSERT INTO a VALUES (1) ON CONFLICT (a, b) DO UPDATE SET a = 1`},
@@ -454,6 +458,9 @@ func TestParse(t *testing.T) {
 		{`SELECT a FROM s.t`},
 
 		{`SELECT count(DISTINCT a) FROM t`},
+		{`SELECT string_agg(a, ',' ORDER BY b DESC) FROM t`},
+		{`SELECT array_agg(DISTINCT a ORDER BY a) FROM t`},
+		{`SELECT DISTINCT ON (a, b) a, c FROM t ORDER BY a, b, c DESC`},
 		{`SELECT count(ALL a) FROM t`},
 
 		{`SELECT a FROM t WHERE a = b`},
@@ -540,6 +547,9 @@ func TestParse(t *testing.T) {
 		{`SELECT avg(1) OVER (ORDER BY c) FROM t`},
 		{`SELECT avg(1) OVER (PARTITION BY b ORDER BY c) FROM t`},
 		{`SELECT avg(1) OVER (w PARTITION BY b ORDER BY c) FROM t`},
+		{`SELECT avg(1) OVER (ORDER BY c ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM t`},
+		{`SELECT avg(1) OVER (PARTITION BY b RANGE UNBOUNDED PRECEDING) FROM t`},
+		{`SELECT avg(1) OVER (ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING) FROM t`},
 
 		{`SELECT a FROM t UNION SELECT 1 FROM t`},
 		{`SELECT a FROM t UNION SELECT 1 FROM t UNION SELECT 1 FROM t`},
@@ -620,6 +630,8 @@ func TestParse(t *testing.T) {
 		{`UPDATE a SET b = 3 WHERE a = b RETURNING 1, 2`},
 		{`UPDATE a SET b = 3 WHERE a = b RETURNING a, a + b`},
 		{`UPDATE a SET b = 3 WHERE a = b RETURNING NOTHING`},
+		{`UPDATE a SET b = c.d FROM c WHERE a.id = c.id`},
+		{`UPDATE a AS x SET b = y.d FROM c AS y, e WHERE (x.id = y.id) AND (y.e = e.id) RETURNING x.b`},
 
 		{`UPDATE t AS "0" SET k = ''`},                 // "0" lost its quotes
 		{`SELECT * FROM "0" JOIN "0" USING (id, "0")`}, // last "0" lost its quotes.
@@ -709,6 +721,10 @@ func TestParse(t *testing.T) {
 
 		// Regression for #15926
 		{`SELECT * FROM ((t1 NATURAL JOIN t2 WITH ORDINALITY AS o1)) WITH ORDINALITY AS o2`},
+
+		{`WITH a AS (SELECT 1) SELECT * FROM a`},
+		{`WITH a (x, y) AS (SELECT c, d FROM e), b AS (SELECT x FROM a) SELECT * FROM b ORDER BY x LIMIT 1`},
+		{`WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 5) SELECT n FROM t`},
 	}
 	for _, d := range testData {
 		stmts, err := Parse(d.sql)
@@ -824,6 +840,8 @@ func TestParse2(t *testing.T) {
 			`SELECT current_timestamp()`},
 		{`SELECT CURRENT_DATE`,
 			`SELECT current_date()`},
+		{`SELECT LOCALTIMESTAMP`,
+			`SELECT localtimestamp()`},
 		{`SELECT POSITION(a IN b)`,
 			`SELECT strpos(b, a)`},
 		{`SELECT TRIM(BOTH a FROM b)`,
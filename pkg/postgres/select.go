@@ -40,6 +40,7 @@ func (*ValuesClause) selectStatement() {}
 
 // Select represents a SelectStatement with an ORDER and/or LIMIT.
 type Select struct {
+	With    *With
 	Select  SelectStatement
 	OrderBy OrderBy
 	Limit   *Limit
@@ -47,11 +48,49 @@ type Select struct {
 
 // Format implements the NodeFormatter interface.
 func (node *Select) Format(buf *bytes.Buffer, f FmtFlags) {
+	if node.With != nil {
+		FormatNode(buf, f, node.With)
+		buf.WriteByte(' ')
+	}
 	FormatNode(buf, f, node.Select)
 	FormatNode(buf, f, node.OrderBy)
 	FormatNode(buf, f, node.Limit)
 }
 
+// With represents a WITH statement.
+type With struct {
+	Recursive bool
+	CTEList   []*CTE
+}
+
+// Format implements the NodeFormatter interface.
+func (node *With) Format(buf *bytes.Buffer, f FmtFlags) {
+	buf.WriteString("WITH ")
+	if node.Recursive {
+		buf.WriteString("RECURSIVE ")
+	}
+	for i, cte := range node.CTEList {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		FormatNode(buf, f, cte)
+	}
+}
+
+// CTE represents a common table expression inside of a WITH clause.
+type CTE struct {
+	Name AliasClause
+	Stmt Statement
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CTE) Format(buf *bytes.Buffer, f FmtFlags) {
+	FormatNode(buf, f, node.Name)
+	buf.WriteString(" AS (")
+	FormatNode(buf, f, node.Stmt)
+	buf.WriteByte(')')
+}
+
 // ParenSelect represents a parenthesized SELECT/UNION/VALUES statement.
 type ParenSelect struct {
 	Select *Select
@@ -67,6 +106,7 @@ func (node *ParenSelect) Format(buf *bytes.Buffer, f FmtFlags) {
 // SelectClause represents a SELECT statement.
 type SelectClause struct {
 	Distinct    bool
+	DistinctOn  DistinctOn
 	Exprs       SelectExprs
 	From        *From
 	Where       *Where
@@ -84,7 +124,12 @@ func (node *SelectClause) Format(buf *bytes.Buffer, f FmtFlags) {
 	} else {
 		buf.WriteString("SELECT ")
 		if node.Distinct {
-			buf.WriteString("DISTINCT ")
+			if node.DistinctOn != nil {
+				FormatNode(buf, f, node.DistinctOn)
+				buf.WriteByte(' ')
+			} else {
+				buf.WriteString("DISTINCT ")
+			}
 		}
 		FormatNode(buf, f, node.Exprs)
 		FormatNode(buf, f, node.From)
@@ -95,6 +140,16 @@ func (node *SelectClause) Format(buf *bytes.Buffer, f FmtFlags) {
 	}
 }
 
+// DistinctOn represents a DISTINCT ON (...) clause.
+type DistinctOn []Expr
+
+// Format implements the NodeFormatter interface.
+func (node DistinctOn) Format(buf *bytes.Buffer, f FmtFlags) {
+	buf.WriteString("DISTINCT ON (")
+	FormatNode(buf, f, Exprs(node))
+	buf.WriteByte(')')
+}
+
 // SelectExprs represents SELECT expressions.
 type SelectExprs []SelectExpr
 
@@ -548,6 +603,7 @@ type WindowDef struct {
 	RefName    Name
 	Partitions Exprs
 	OrderBy    OrderBy
+	Frame      *FrameSpec
 }
 
 // Format implements the NodeFormatter interface.
@@ -576,9 +632,92 @@ func (node *WindowDef) Format(buf *bytes.Buffer, f FmtFlags) {
 			buf.WriteString(tmpBuf.String()[1:])
 		}
 		needSpaceSeparator = true
-		_ = needSpaceSeparator // avoid compiler warning until TODO below is addressed.
 	}
-	// TODO(nvanbenschoten): Support Window Frames.
-	// if node.Frame != nil {}
+	if node.Frame != nil {
+		if needSpaceSeparator {
+			buf.WriteRune(' ')
+		}
+		FormatNode(buf, f, node.Frame)
+	}
 	buf.WriteRune(')')
 }
+
+// FrameMode indicates which mode of framing is used.
+type FrameMode int
+
+const (
+	// RangeMode is the mode of specifying frame in terms of logical range (e.g. 100 units cheaper).
+	RangeMode FrameMode = iota
+	// RowsMode is the mode of specifying frame in terms of physical offsets (e.g. 1 row before etc).
+	RowsMode
+)
+
+// FrameBoundType indicates which type of boundary is used.
+type FrameBoundType int
+
+const (
+	// UnboundedPreceding represents UNBOUNDED PRECEDING type of boundary.
+	UnboundedPreceding FrameBoundType = iota
+	// OffsetPreceding represents 'value' PRECEDING type of boundary.
+	OffsetPreceding
+	// CurrentRow represents CURRENT ROW type of boundary.
+	CurrentRow
+	// OffsetFollowing represents 'value' FOLLOWING type of boundary.
+	OffsetFollowing
+	// UnboundedFollowing represents UNBOUNDED FOLLOWING type of boundary.
+	UnboundedFollowing
+)
+
+// FrameBound specifies the offset and the type of boundary.
+type FrameBound struct {
+	BoundType  FrameBoundType
+	OffsetExpr Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *FrameBound) Format(buf *bytes.Buffer, f FmtFlags) {
+	switch node.BoundType {
+	case UnboundedPreceding:
+		buf.WriteString("UNBOUNDED PRECEDING")
+	case OffsetPreceding:
+		FormatNode(buf, f, node.OffsetExpr)
+		buf.WriteString(" PRECEDING")
+	case CurrentRow:
+		buf.WriteString("CURRENT ROW")
+	case OffsetFollowing:
+		FormatNode(buf, f, node.OffsetExpr)
+		buf.WriteString(" FOLLOWING")
+	case UnboundedFollowing:
+		buf.WriteString("UNBOUNDED FOLLOWING")
+	}
+}
+
+// FrameBounds specifies boundaries of the window frame.
+type FrameBounds struct {
+	StartBound *FrameBound
+	EndBound   *FrameBound
+}
+
+// FrameSpec represents static state of window frame over which calculations are made.
+type FrameSpec struct {
+	Mode   FrameMode // the mode of framing being used
+	Bounds FrameBounds
+}
+
+// Format implements the NodeFormatter interface.
+func (node *FrameSpec) Format(buf *bytes.Buffer, f FmtFlags) {
+	switch node.Mode {
+	case RangeMode:
+		buf.WriteString("RANGE ")
+	case RowsMode:
+		buf.WriteString("ROWS ")
+	}
+	if node.Bounds.EndBound != nil {
+		buf.WriteString("BETWEEN ")
+		FormatNode(buf, f, node.Bounds.StartBound)
+		buf.WriteString(" AND ")
+		FormatNode(buf, f, node.Bounds.EndBound)
+	} else {
+		FormatNode(buf, f, node.Bounds.StartBound)
+	}
+}
@@ -1,21 +1,27 @@
 // Code generated by goyacc.
 // GENERATED FILE DO NOT EDIT
+// Code generated by goyacc -o sql.go -p sql sql.y. DO NOT EDIT.
+
 //line sql.y:20
 package parser
 
+import __yyfmt__ "fmt"
+
+//line sql.y:20
+
 import (
 	"fmt"
-	__yyfmt__ "fmt"
+
 	"go/constant"
-	"go/token" //line sql.y:20
-	// MaxUint is the maximum value of an uint.
-	// MaxInt is the maximum value of an int.
+	"go/token"
 
 	"github.com/EchoUtopia/pg2oracle/pkg/postgres/privilege"
 )
 
+// MaxUint is the maximum value of an uint.
 const MaxUint = ^uint(0)
 
+// MaxInt is the maximum value of an int.
 const MaxInt = int(MaxUint >> 1)
 
 func unimplemented(sqllex sqlLexer, feature string) int {
@@ -28,8 +34,6 @@ func unimplementedWithIssue(sqllex sqlLexer, issue int) int {
 	return 1
 }
 
-//line sql.y:48
-
 // sqlSymUnion represents a union of types, providing accessor methods
 // to retrieve the underlying type stored in the union's empty interface.
 // The purpose of the sqlSymUnion struct is to reduce the memory footprint of
@@ -45,10 +49,16 @@ func unimplementedWithIssue(sqllex sqlLexer, issue int) int {
 // nil values should be typed so that they are stored as nil instances in the
 // empty interface, instead of setting the empty interface to nil. This means
 // that:
-//     $$ = []String(nil)
+//
+//	$$ = []String(nil)
+//
 // should be used, instead of:
-//     $$ = nil
+//
+//	$$ = nil
+//
 // to assign a nil string slice to the union.
+//
+//line sql.y:48
 type sqlSymUnion struct {
 	val interface{}
 }
@@ -56,25 +66,24 @@ type sqlSymUnion struct {
 // The following accessor methods come in three forms, depending on the
 // type of the value being accessed and whether a nil value is admissible
 // for the corresponding grammar rule.
-// - Values and pointers are directly type asserted from the empty
-//   interface, regardless of whether a nil value is admissible or
-//   not. A panic occurs if the type assertion is incorrect; no panic occurs
-//   if a nil is not expected but present. (TODO(knz): split this category of
-//   accessor in two; with one checking for unexpected nils.)
-//   Examples: bool(), tableWithIdx().
-//
-// - Interfaces where a nil is admissible are handled differently
-//   because a nil instance of an interface inserted into the empty interface
-//   becomes a nil instance of the empty interface and therefore will fail a
-//   direct type assertion. Instead, a guarded type assertion must be used,
-//   which returns nil if the type assertion fails.
-//   Examples: expr(), stmt().
+//   - Values and pointers are directly type asserted from the empty
+//     interface, regardless of whether a nil value is admissible or
+//     not. A panic occurs if the type assertion is incorrect; no panic occurs
+//     if a nil is not expected but present. (TODO(knz): split this category of
+//     accessor in two; with one checking for unexpected nils.)
+//     Examples: bool(), tableWithIdx().
 //
-// - Interfaces where a nil is not admissible are implemented as a direct
-//   type assertion, which causes a panic to occur if an unexpected nil
-//   is encountered.
-//   Examples: namePart(), tblDef().
+//   - Interfaces where a nil is admissible are handled differently
+//     because a nil instance of an interface inserted into the empty interface
+//     becomes a nil instance of the empty interface and therefore will fail a
+//     direct type assertion. Instead, a guarded type assertion must be used,
+//     which returns nil if the type assertion fails.
+//     Examples: expr(), stmt().
 //
+//   - Interfaces where a nil is not admissible are implemented as a direct
+//     type assertion, which causes a panic to occur if an unexpected nil
+//     is encountered.
+//     Examples: namePart(), tblDef().
 func (u *sqlSymUnion) numVal() *NumVal {
 	return u.val.(*NumVal)
 }
@@ -312,6 +321,18 @@ func (u *sqlSymUnion) windowDef() *WindowDef {
 func (u *sqlSymUnion) window() Window {
 	return u.val.(Window)
 }
+func (u *sqlSymUnion) distinctOn() DistinctOn {
+	return u.val.(DistinctOn)
+}
+func (u *sqlSymUnion) frameSpec() *FrameSpec {
+	return u.val.(*FrameSpec)
+}
+func (u *sqlSymUnion) frameBounds() FrameBounds {
+	return u.val.(FrameBounds)
+}
+func (u *sqlSymUnion) frameBound() *FrameBound {
+	return u.val.(*FrameBound)
+}
 func (u *sqlSymUnion) op() operator {
 	return u.val.(operator)
 }
@@ -333,8 +354,17 @@ func (u *sqlSymUnion) kvOptions() []KVOption {
 func (u *sqlSymUnion) transactionModes() TransactionModes {
 	return u.val.(TransactionModes)
 }
+func (u *sqlSymUnion) with() *With {
+	return u.val.(*With)
+}
+func (u *sqlSymUnion) cte() *CTE {
+	return u.val.(*CTE)
+}
+func (u *sqlSymUnion) ctes() []*CTE {
+	return u.val.([]*CTE)
+}
 
-//line sql.y:465
+//line sql.y:486
 type sqlSymType struct {
 	yys   int
 	id    int
@@ -1038,260 +1068,261 @@ var sqlToknames = [...]string{
 	"'}'",
 	"':'",
 }
+
 var sqlStatenames = [...]string{}
 
 const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlInitialStackSize = 16
 
-//line sql.y:5882
+//line sql.y:6057
 
 //line yacctab:1
-var sqlExca = [...]int{
+var sqlExca = [...]int16{
 	-1, 0,
 	1, 33,
 	354, 33,
-	-2, 506,
+	-2, 509,
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 66,
-	1, 478,
-	197, 478,
-	241, 478,
-	350, 478,
-	352, 478,
-	354, 478,
-	-2, 488,
+	1, 480,
+	197, 480,
+	241, 480,
+	350, 480,
+	352, 480,
+	354, 480,
+	-2, 490,
 	-1, 112,
-	1, 481,
-	197, 481,
-	241, 481,
-	350, 481,
-	352, 481,
-	354, 481,
-	-2, 487,
+	1, 483,
+	197, 483,
+	241, 483,
+	350, 483,
+	352, 483,
+	354, 483,
+	-2, 489,
 	-1, 130,
 	1, 33,
 	354, 33,
-	-2, 506,
+	-2, 509,
 	-1, 511,
-	122, 1087,
-	293, 1087,
-	336, 1087,
-	353, 1087,
+	122, 1093,
+	293, 1093,
+	336, 1093,
+	353, 1093,
 	-2, 0,
 	-1, 522,
-	1, 215,
-	354, 215,
-	-2, 1092,
+	1, 217,
+	354, 217,
+	-2, 1098,
 	-1, 534,
-	111, 516,
-	170, 516,
-	195, 516,
-	-2, 484,
+	111, 520,
+	170, 520,
+	195, 520,
+	-2, 486,
 	-1, 542,
-	111, 515,
-	170, 515,
-	195, 515,
-	-2, 482,
-	-1, 695,
-	351, 1019,
-	-2, 1012,
+	111, 519,
+	170, 519,
+	195, 519,
+	-2, 484,
 	-1, 696,
-	351, 1020,
-	-2, 1013,
-	-1, 702,
-	5, 686,
-	351, 686,
-	-2, 1217,
-	-1, 727,
-	5, 645,
-	-2, 1187,
-	-1, 728,
-	5, 680,
-	351, 680,
-	-2, 1189,
+	351, 1025,
+	-2, 1018,
+	-1, 697,
+	351, 1026,
+	-2, 1019,
+	-1, 703,
+	5, 690,
+	351, 690,
+	-2, 1223,
 	-1, 729,
-	5, 655,
-	-2, 1190,
+	5, 649,
+	-2, 1193,
 	-1, 730,
-	5, 654,
-	-2, 1191,
+	5, 684,
+	351, 684,
+	-2, 1195,
 	-1, 731,
-	5, 680,
-	351, 680,
-	-2, 1194,
+	5, 659,
+	-2, 1196,
 	-1, 732,
-	5, 680,
-	351, 680,
-	-2, 1195,
+	5, 658,
+	-2, 1197,
 	-1, 733,
-	5, 681,
-	-2, 1198,
+	5, 684,
+	351, 684,
+	-2, 1200,
 	-1, 734,
-	5, 637,
-	-2, 1199,
+	5, 684,
+	351, 684,
+	-2, 1201,
 	-1, 735,
-	5, 637,
-	-2, 1200,
-	-1, 736,
-	5, 662,
+	5, 685,
 	-2, 1204,
-	-1, 737,
-	5, 647,
+	-1, 736,
+	5, 641,
 	-2, 1205,
-	-1, 738,
-	5, 648,
+	-1, 737,
+	5, 641,
 	-2, 1206,
+	-1, 738,
+	5, 666,
+	-2, 1210,
 	-1, 739,
-	5, 638,
+	5, 651,
 	-2, 1211,
 	-1, 740,
-	5, 639,
+	5, 652,
 	-2, 1212,
 	-1, 741,
-	5, 640,
-	-2, 1213,
+	5, 642,
+	-2, 1217,
 	-1, 742,
-	5, 641,
-	-2, 1214,
+	5, 643,
+	-2, 1218,
 	-1, 743,
-	5, 642,
-	-2, 1215,
+	5, 644,
+	-2, 1219,
 	-1, 744,
-	5, 643,
-	-2, 1216,
+	5, 645,
+	-2, 1220,
 	-1, 745,
-	5, 637,
+	5, 646,
 	-2, 1221,
 	-1, 746,
-	5, 646,
-	-2, 1226,
+	5, 647,
+	-2, 1222,
 	-1, 747,
-	5, 644,
-	-2, 1229,
+	5, 641,
+	-2, 1227,
 	-1, 748,
-	5, 678,
-	351, 678,
-	-2, 1231,
+	5, 650,
+	-2, 1232,
 	-1, 749,
-	5, 682,
-	-2, 1234,
-	-1, 750,
-	5, 684,
+	5, 648,
 	-2, 1235,
+	-1, 750,
+	5, 682,
+	351, 682,
+	-2, 1237,
 	-1, 751,
-	5, 677,
-	351, 677,
+	5, 686,
 	-2, 1240,
-	-1, 795,
-	211, 504,
-	-2, 378,
-	-1, 800,
-	111, 515,
-	170, 515,
-	195, 515,
-	-2, 485,
-	-1, 903,
-	102, 488,
-	111, 488,
-	151, 488,
-	170, 488,
-	195, 488,
-	201, 488,
-	304, 488,
-	-2, 572,
-	-1, 980,
-	102, 488,
-	111, 488,
-	151, 488,
-	170, 488,
-	195, 488,
-	201, 488,
-	304, 488,
-	-2, 805,
-	-1, 989,
-	351, 996,
-	-2, 984,
-	-1, 1234,
-	1, 573,
-	70, 573,
-	102, 573,
-	111, 573,
-	123, 573,
-	127, 573,
-	129, 573,
-	142, 573,
-	151, 573,
-	158, 573,
-	167, 573,
-	170, 573,
-	182, 573,
-	195, 573,
-	197, 573,
-	201, 573,
-	241, 573,
-	243, 573,
-	304, 573,
-	312, 573,
-	323, 573,
-	324, 573,
-	333, 573,
-	350, 573,
-	352, 573,
-	354, 573,
-	355, 573,
-	-2, 572,
-	-1, 1283,
+	-1, 752,
+	5, 688,
+	-2, 1241,
+	-1, 753,
+	5, 681,
+	351, 681,
+	-2, 1246,
+	-1, 797,
+	211, 507,
+	-2, 380,
+	-1, 802,
+	111, 519,
+	170, 519,
+	195, 519,
+	-2, 487,
+	-1, 907,
+	102, 490,
+	111, 490,
+	151, 490,
+	170, 490,
+	195, 490,
+	201, 490,
+	304, 490,
+	-2, 576,
+	-1, 984,
+	102, 490,
+	111, 490,
+	151, 490,
+	170, 490,
+	195, 490,
+	201, 490,
+	304, 490,
+	-2, 809,
+	-1, 993,
+	351, 1002,
+	-2, 990,
+	-1, 1241,
+	1, 577,
+	70, 577,
+	102, 577,
+	111, 577,
+	123, 577,
+	127, 577,
+	129, 577,
+	142, 577,
+	151, 577,
+	158, 577,
+	167, 577,
+	170, 577,
+	182, 577,
+	195, 577,
+	197, 577,
+	201, 577,
+	241, 577,
+	243, 577,
+	304, 577,
+	312, 577,
+	323, 577,
+	324, 577,
+	333, 577,
+	350, 577,
+	352, 577,
+	354, 577,
+	355, 577,
+	-2, 576,
+	-1, 1290,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 721,
-	-1, 1284,
+	-2, 725,
+	-1, 1291,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 722,
-	-1, 1285,
+	-2, 726,
+	-1, 1292,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 723,
-	-1, 1289,
+	-2, 727,
+	-1, 1296,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 727,
-	-1, 1290,
+	-2, 731,
+	-1, 1297,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 728,
-	-1, 1291,
+	-2, 732,
+	-1, 1298,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 729,
-	-1, 1294,
+	-2, 733,
+	-1, 1301,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1302,8 +1333,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 734,
-	-1, 1300,
+	-2, 738,
+	-1, 1307,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1314,8 +1345,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 736,
-	-1, 1302,
+	-2, 740,
+	-1, 1309,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1326,8 +1357,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 740,
-	-1, 1303,
+	-2, 744,
+	-1, 1310,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1338,8 +1369,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 741,
-	-1, 1304,
+	-2, 745,
+	-1, 1311,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1350,8 +1381,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 742,
-	-1, 1305,
+	-2, 746,
+	-1, 1312,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1362,33 +1393,33 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 743,
-	-1, 1331,
-	206, 878,
-	-2, 881,
-	-1, 1368,
-	122, 918,
-	351, 1019,
-	-2, 1012,
-	-1, 1369,
-	122, 919,
-	-2, 1183,
-	-1, 1370,
-	122, 920,
-	-2, 1091,
-	-1, 1371,
-	122, 921,
-	-2, 1055,
-	-1, 1372,
-	122, 922,
-	-2, 1072,
-	-1, 1373,
-	122, 923,
-	-2, 1090,
-	-1, 1374,
+	-2, 747,
+	-1, 1338,
+	206, 884,
+	-2, 887,
+	-1, 1376,
 	122, 924,
-	-2, 1142,
-	-1, 1569,
+	351, 1025,
+	-2, 1018,
+	-1, 1377,
+	122, 925,
+	-2, 1189,
+	-1, 1378,
+	122, 926,
+	-2, 1097,
+	-1, 1379,
+	122, 927,
+	-2, 1061,
+	-1, 1380,
+	122, 928,
+	-2, 1078,
+	-1, 1381,
+	122, 929,
+	-2, 1096,
+	-1, 1382,
+	122, 930,
+	-2, 1148,
+	-1, 1580,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1399,8 +1430,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 735,
-	-1, 1570,
+	-2, 739,
+	-1, 1581,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1411,8 +1442,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 737,
-	-1, 1575,
+	-2, 741,
+	-1, 1586,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1423,11 +1454,11 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 738,
-	-1, 1593,
-	206, 877,
-	-2, 880,
-	-1, 1792,
+	-2, 742,
+	-1, 1604,
+	206, 883,
+	-2, 886,
+	-1, 1807,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1438,65 +1469,65 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 739,
-	-1, 1797,
+	-2, 743,
+	-1, 1812,
 	154, 0,
-	-2, 755,
-	-1, 1807,
-	206, 879,
-	-2, 882,
-	-1, 1849,
+	-2, 759,
+	-1, 1822,
+	206, 885,
+	-2, 888,
+	-1, 1864,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 782,
-	-1, 1850,
+	-2, 786,
+	-1, 1865,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 783,
-	-1, 1851,
+	-2, 787,
+	-1, 1866,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 784,
-	-1, 1855,
+	-2, 788,
+	-1, 1870,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 788,
-	-1, 1856,
+	-2, 792,
+	-1, 1871,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 789,
-	-1, 1857,
+	-2, 793,
+	-1, 1872,
 	13, 0,
 	14, 0,
 	15, 0,
 	334, 0,
 	335, 0,
 	336, 0,
-	-2, 790,
-	-1, 1962,
+	-2, 794,
+	-1, 1978,
 	154, 0,
-	-2, 756,
-	-1, 1965,
+	-2, 760,
+	-1, 1981,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1507,8 +1538,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 759,
-	-1, 1966,
+	-2, 763,
+	-1, 1982,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1519,8 +1550,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 761,
-	-1, 2073,
+	-2, 765,
+	-1, 2090,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1531,8 +1562,8 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 760,
-	-1, 2074,
+	-2, 764,
+	-1, 2091,
 	16, 0,
 	17, 0,
 	18, 0,
@@ -1543,452 +1574,529 @@ var sqlExca = [...]int{
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 762,
-	-1, 2081,
+	-2, 766,
+	-1, 2098,
 	154, 0,
-	-2, 791,
-	-1, 2147,
+	-2, 795,
+	-1, 2164,
 	154, 0,
-	-2, 792,
-	-1, 2219,
+	-2, 796,
+	-1, 2236,
 	36, 0,
 	136, 0,
 	169, 0,
 	264, 0,
 	331, 0,
 	337, 0,
-	-2, 1186,
+	-2, 1192,
 }
 
 const sqlPrivate = 57344
 
-const sqlLast = 31238
+const sqlLast = 31590
 
-var sqlAct = [...]int{
-
-	696, 2226, 1884, 2218, 2195, 2263, 2103, 2227, 1117, 2228,
-	2217, 1242, 1250, 1826, 543, 2088, 2030, 1907, 392, 2135,
-	686, 2016, 1124, 2001, 1473, 1030, 1229, 65, 1397, 1713,
-	672, 639, 694, 617, 2055, 1891, 1537, 1454, 139, 693,
-	1431, 139, 1080, 1436, 1659, 897, 1890, 1938, 139, 1487,
-	1518, 689, 1444, 1120, 1435, 1551, 139, 1604, 1262, 394,
-	1658, 139, 139, 1112, 985, 139, 558, 1251, 139, 1715,
-	1329, 877, 884, 1756, 1517, 139, 1524, 1236, 1354, 368,
-	1686, 1184, 901, 1182, 1155, 1469, 1432, 1106, 774, 1230,
-	1394, 1339, 1317, 915, 1478, 666, 1314, 621, 878, 1081,
-	773, 809, 807, 561, 1020, 1439, 1016, 1348, 366, 1365,
-	976, 562, 665, 802, 888, 1244, 567, 1031, 653, 1217,
-	550, 110, 1204, 609, 139, 139, 816, 1104, 817, 131,
-	139, 499, 517, 815, 139, 139, 2017, 553, 688, 112,
-	521, 384, 548, 861, 919, 519, 135, 647, 624, 607,
-	860, 503, 515, 1592, 1770, 1243, 113, 1771, 1114, 122,
-	547, 898, 547, 1114, 821, 1114, 1351, 2249, 1725, 1563,
-	910, 542, 1247, 2240, 1237, 2239, 1258, 110, 1258, 2237,
-	619, 2235, 2015, 1258, 910, 2189, 140, 1562, 1412, 2163,
-	2152, 496, 2015, 2151, 2149, 2142, 1759, 1412, 910, 502,
-	1206, 2118, 1237, 551, 2015, 109, 2115, 2114, 2113, 910,
-	2015, 910, 2101, 1352, 2075, 2015, 2063, 1412, 2180, 910,
-	2060, 631, 2052, 910, 1418, 1258, 2051, 752, 1138, 1258,
-	1418, 931, 932, 111, 951, 952, 953, 961, 962, 963,
-	55, 122, 56, 2014, 1596, 395, 2015, 954, 125, 1597,
-	535, 110, 37, 117, 934, 1759, 1205, 965, 1353, 1350,
-	1989, 1967, 1485, 1258, 1258, 108, 58, 534, 570, 1964,
-	1951, 1726, 1412, 910, 611, 1862, 129, 1801, 933, 119,
-	1258, 1804, 910, 1697, 948, 40, 396, 109, 1790, 1785,
-	1766, 1241, 1241, 1767, 1696, 1676, 1674, 910, 1677, 1258,
-	1673, 1672, 568, 1258, 1258, 1595, 1593, 47, 1472, 1258,
-	1258, 49, 128, 1428, 1258, 111, 1603, 1088, 120, 1333,
-	123, 873, 55, 1540, 56, 1514, 1258, 124, 910, 1420,
-	629, 1411, 1258, 1355, 1412, 657, 1257, 125, 939, 1258,
-	616, 1240, 57, 569, 1241, 2018, 114, 130, 58, 1115,
-	1211, 902, 564, 1210, 1115, 139, 1115, 958, 966, 927,
-	139, 635, 928, 1532, 1110, 129, 376, 24, 567, 788,
-	2241, 828, 2233, 2216, 2202, 964, 125, 2144, 2116, 1994,
-	1990, 1982, 931, 932, 1981, 1980, 1976, 1975, 1974, 125,
-	956, 1973, 1956, 1480, 1937, 548, 949, 1882, 1877, 116,
-	1872, 128, 1562, 122, 129, 934, 1871, 1870, 1812, 123,
-	1349, 1700, 1695, 1681, 1678, 1666, 124, 129, 671, 931,
-	932, 955, 1657, 1628, 1625, 1624, 1622, 1609, 1608, 933,
-	59, 1544, 1416, 1205, 1362, 1246, 60, 1361, 1360, 828,
-	128, 1234, 934, 986, 993, 114, 1325, 827, 636, 109,
-	1123, 1828, 2192, 128, 67, 68, 61, 648, 62, 350,
-	63, 123, 121, 2179, 2178, 1136, 933, 64, 124, 950,
-	1131, 125, 1113, 900, 114, 2171, 2165, 111, 74, 654,
-	899, 116, 2161, 2139, 55, 959, 56, 114, 638, 939,
-	118, 895, 2098, 2083, 2072, 2021, 2013, 24, 1997, 129,
-	1987, 139, 1905, 1903, 1902, 1901, 1898, 122, 1888, 1796,
-	58, 1880, 1774, 1762, 1749, 139, 1747, 1701, 96, 1704,
-	1656, 1618, 1617, 567, 1614, 139, 939, 115, 1589, 139,
-	139, 139, 1584, 139, 1319, 128, 931, 932, 139, 139,
-	139, 139, 139, 123, 1629, 1630, 1955, 949, 622, 801,
-	124, 1326, 957, 109, 568, 945, 946, 947, 960, 934,
-	944, 942, 943, 935, 936, 937, 938, 940, 941, 114,
-	1542, 1513, 2138, 567, 1021, 1024, 1991, 1404, 1359, 1222,
-	1116, 111, 1028, 933, 1014, 1013, 1012, 1011, 55, 1010,
-	56, 1009, 800, 1008, 1007, 569, 1006, 1005, 1004, 1003,
-	1002, 1001, 1000, 139, 139, 139, 139, 139, 999, 139,
-	784, 998, 997, 990, 58, 979, 114, 618, 876, 768,
-	950, 759, 790, 1701, 651, 1996, 139, 139, 813, 567,
-	139, 1995, 1969, 125, 1769, 1765, 394, 1171, 139, 834,
-	1223, 635, 986, 116, 1684, 139, 139, 139, 1683, 139,
-	882, 1635, 805, 548, 911, 977, 764, 139, 900, 2154,
-	772, 129, 1098, 1097, 1716, 564, 559, 769, 1959, 793,
-	917, 796, 833, 1772, 1629, 1630, 905, 637, 804, 804,
-	925, 781, 1564, 659, 783, 881, 1237, 565, 1468, 1026,
-	535, 902, 995, 1680, 1027, 834, 1467, 128, 548, 982,
-	1679, 865, 1192, 1568, 871, 123, 868, 534, 1530, 568,
-	824, 825, 124, 943, 935, 936, 937, 938, 940, 941,
-	780, 765, 1190, 832, 782, 1121, 1687, 2056, 908, 896,
-	1243, 114, 532, 1829, 864, 1094, 1340, 125, 1017, 1191,
-	856, 110, 648, 1600, 1629, 1630, 1101, 116, 862, 605,
-	569, 935, 936, 937, 938, 940, 941, 1558, 874, 568,
-	1185, 567, 1186, 139, 1921, 129, 2208, 2141, 139, 2256,
-	2255, 2045, 1421, 1185, 904, 1186, 909, 546, 649, 857,
-	851, 602, 567, 567, 601, 1692, 929, 596, 597, 394,
-	992, 921, 918, 2133, 2132, 1351, 900, 1085, 139, 930,
-	569, 128, 2131, 2130, 1936, 1122, 604, 1111, 1935, 123,
-	1074, 1037, 1918, 1917, 1613, 568, 124, 1612, 989, 1489,
-	1611, 1610, 395, 1571, 1489, 1932, 545, 834, 1511, 1102,
-	1488, 1510, 1103, 1508, 1301, 114, 1187, 139, 1018, 1019,
-	1022, 139, 1352, 139, 139, 139, 139, 139, 139, 1187,
-	1025, 1635, 1127, 139, 1879, 781, 569, 139, 139, 931,
-	932, 1261, 863, 396, 139, 1095, 1130, 540, 628, 1133,
-	1953, 1135, 1408, 941, 139, 1407, 547, 139, 1633, 1634,
-	1636, 1637, 934, 1272, 356, 1196, 2140, 1353, 1350, 1455,
-	139, 360, 1082, 1180, 525, 1779, 1179, 2105, 782, 394,
-	1083, 1195, 139, 1780, 1316, 1548, 933, 913, 139, 1380,
-	1107, 139, 2183, 1084, 837, 1087, 2266, 1225, 1100, 1099,
-	767, 1193, 1161, 139, 539, 139, 1316, 1923, 1228, 567,
-	1185, 528, 1186, 922, 394, 1129, 357, 848, 1194, 1355,
-	1214, 1323, 853, 649, 1142, 1141, 1321, 568, 603, 1470,
-	1471, 838, 1271, 1445, 836, 533, 1552, 2246, 810, 1172,
-	529, 535, 1355, 1203, 535, 535, 939, 1340, 568, 568,
-	530, 110, 1165, 1745, 849, 395, 1754, 1159, 1151, 544,
-	1224, 1152, 1153, 1037, 1037, 2255, 1162, 606, 569, 1166,
-	1167, 1168, 1169, 1170, 2186, 1181, 1822, 1201, 1751, 1693,
-	565, 560, 1176, 1177, 1209, 1215, 1187, 854, 1330, 569,
-	569, 1637, 1219, 1220, 1819, 1334, 396, 1199, 2187, 1342,
-	1447, 917, 867, 810, 949, 649, 1691, 2168, 1556, 2261,
-	1198, 1367, 1367, 1378, 754, 1389, 1259, 1249, 1178, 1349,
-	654, 1401, 1402, 1403, 622, 906, 526, 1260, 110, 1263,
-	1270, 1337, 2264, 1930, 1188, 1015, 916, 2245, 1486, 1820,
-	855, 382, 777, 649, 920, 920, 538, 1188, 1450, 931,
-	932, 1355, 1552, 2079, 2090, 505, 1631, 1632, 1633, 1634,
-	1636, 1637, 974, 361, 505, 395, 1327, 1324, 2230, 1114,
-	1924, 1616, 934, 506, 547, 541, 1218, 950, 1233, 1883,
-	359, 358, 506, 1912, 1858, 537, 2106, 2265, 385, 1410,
-	2229, 394, 527, 1375, 139, 568, 933, 139, 1446, 2254,
-	395, 2252, 948, 362, 139, 2029, 396, 778, 2267, 1429,
-	390, 779, 139, 139, 1355, 139, 386, 139, 139, 394,
-	139, 139, 1452, 1183, 385, 1415, 842, 819, 762, 656,
-	110, 1573, 363, 507, 364, 2244, 569, 1462, 1417, 139,
-	1312, 396, 507, 387, 820, 139, 390, 1022, 1547, 1025,
-	2274, 1985, 386, 1315, 2231, 1310, 939, 2125, 2124, 139,
-	139, 139, 389, 1019, 1018, 2009, 139, 2096, 1322, 1422,
-	139, 935, 936, 937, 938, 940, 941, 1859, 139, 387,
-	2158, 139, 2041, 1860, 1495, 1920, 818, 139, 394, 1298,
-	1736, 1430, 1732, 139, 139, 1453, 1515, 139, 389, 2010,
-	2262, 1520, 139, 1818, 1188, 139, 1519, 1464, 681, 1490,
-	2232, 820, 139, 1424, 949, 1128, 1496, 1498, 1460, 1118,
-	1461, 2196, 139, 1147, 1426, 1306, 1458, 139, 1459, 139,
-	1466, 1534, 508, 1307, 1538, 1308, 139, 1175, 1089, 1313,
-	1908, 508, 139, 1522, 1523, 1427, 136, 1528, 819, 351,
-	1986, 2273, 1457, 804, 548, 804, 353, 1425, 2044, 887,
-	1115, 1483, 1481, 1521, 365, 2043, 1245, 1501, 1245, 495,
-	136, 1493, 388, 501, 110, 2031, 501, 395, 1512, 1509,
-	1554, 2040, 2097, 523, 1516, 1560, 1533, 950, 622, 1296,
-	1299, 1529, 1706, 1148, 1705, 1629, 1630, 1543, 2005, 1539,
-	2006, 1476, 1527, 1213, 1212, 395, 1235, 818, 388, 777,
-	509, 497, 891, 494, 1093, 646, 645, 504, 396, 509,
-	1557, 1254, 1295, 545, 797, 1885, 391, 548, 1587, 1565,
-	2008, 894, 610, 610, 887, 1590, 1567, 1602, 351, 2011,
-	1581, 510, 136, 632, 1998, 1309, 396, 889, 641, 640,
-	510, 1546, 1311, 1606, 1607, 1579, 892, 1091, 1939, 132,
-	1757, 1358, 391, 2082, 2042, 2094, 380, 32, 3, 1092,
-	1984, 890, 1574, 1660, 395, 1572, 1795, 1661, 944, 942,
-	943, 935, 936, 937, 938, 940, 941, 891, 1778, 2095,
-	379, 31, 1623, 548, 1655, 1583, 1588, 375, 28, 567,
-	139, 1549, 1635, 378, 17, 1668, 894, 891, 1507, 567,
-	1599, 1504, 1419, 1239, 859, 396, 2007, 1297, 858, 753,
-	852, 139, 889, 139, 139, 1576, 894, 847, 372, 13,
-	139, 892, 893, 139, 846, 1577, 845, 139, 844, 1582,
-	374, 16, 1216, 1699, 1037, 1702, 890, 843, 840, 760,
-	644, 892, 373, 14, 1173, 371, 12, 139, 377, 10,
-	1645, 1526, 1663, 1664, 1665, 834, 1037, 139, 139, 139,
-	1164, 996, 850, 139, 1357, 2225, 2193, 139, 139, 139,
-	139, 139, 2039, 1928, 1150, 633, 634, 595, 1926, 139,
-	1690, 139, 139, 630, 1689, 1919, 1694, 32, 1688, 627,
-	1712, 1456, 1718, 1719, 1449, 1720, 1200, 893, 139, 1197,
-	1682, 1189, 649, 1140, 139, 1139, 1733, 370, 8, 369,
-	4, 31, 1137, 139, 139, 1134, 1037, 893, 28, 1132,
-	1809, 1721, 1698, 1646, 17, 810, 810, 1723, 1503, 1714,
-	1768, 1506, 1727, 139, 139, 1578, 2067, 1728, 822, 614,
-	2256, 1109, 1580, 1786, 1489, 2069, 1489, 1477, 1764, 13,
-	1499, 1474, 1497, 642, 1500, 1744, 1784, 2175, 351, 381,
-	1708, 16, 2018, 1755, 1760, 1758, 1525, 1482, 810, 1484,
-	1761, 2146, 1940, 14, 701, 568, 12, 839, 649, 10,
-	1798, 1783, 1698, 826, 1505, 568, 810, 139, 1802, 649,
-	1814, 1815, 1816, 1773, 1781, 622, 1777, 598, 599, 1775,
-	1787, 622, 622, 1776, 110, 622, 1037, 1789, 1788, 1502,
-	823, 615, 1475, 2181, 2027, 1451, 569, 1631, 1632, 1633,
-	1634, 1636, 1637, 498, 1448, 1248, 569, 931, 932, 1202,
-	1550, 756, 1808, 1805, 1086, 916, 1029, 1414, 8, 2259,
-	4, 2272, 1037, 1037, 916, 2062, 1830, 1945, 755, 1832,
-	934, 1037, 1037, 1881, 698, 1863, 1878, 1835, 1837, 1381,
-	1821, 1823, 1824, 931, 932, 1825, 1873, 830, 139, 1629,
-	1630, 139, 1971, 1865, 933, 931, 932, 830, 829, 1685,
-	1675, 1535, 1409, 139, 1037, 1406, 567, 1904, 1405, 1347,
-	1866, 983, 1897, 1889, 831, 2191, 394, 139, 2089, 763,
-	1817, 1709, 993, 991, 1896, 766, 524, 2104, 1263, 1833,
-	933, 1895, 383, 523, 1915, 1163, 841, 1263, 1838, 1914,
-	1531, 1221, 1886, 351, 2185, 1977, 1899, 523, 795, 523,
-	139, 798, 1615, 139, 2134, 2078, 523, 523, 351, 811,
-	632, 1911, 1356, 394, 139, 139, 1933, 994, 1934, 567,
-	1869, 48, 1910, 1893, 1909, 675, 1999, 1887, 1438, 1437,
-	397, 1947, 1096, 697, 1068, 1931, 552, 1366, 1264, 757,
-	1949, 699, 1034, 700, 1927, 1035, 1929, 1946, 1942, 1023,
-	1941, 1944, 687, 1032, 652, 1707, 1252, 1320, 1710, 1338,
-	1598, 1952, 1963, 987, 667, 679, 678, 1335, 758, 139,
-	1536, 501, 351, 351, 870, 351, 1555, 610, 1954, 1146,
-	1465, 1143, 1958, 1925, 531, 834, 1626, 650, 1037, 1943,
-	1387, 1379, 1376, 789, 351, 351, 883, 975, 136, 1253,
-	787, 1950, 1561, 1413, 875, 1154, 351, 613, 612, 1746,
-	1433, 1711, 1748, 351, 351, 351, 785, 923, 1090, 1423,
-	1983, 968, 967, 600, 2160, 136, 776, 775, 1119, 1735,
-	2260, 2174, 1922, 2207, 127, 1724, 126, 2153, 2087, 139,
-	1545, 73, 568, 139, 139, 1729, 30, 29, 92, 1897,
-	567, 91, 395, 90, 89, 2019, 88, 87, 139, 139,
-	139, 1896, 1897, 86, 85, 84, 1897, 139, 1895, 139,
-	1753, 139, 139, 139, 1896, 2012, 139, 139, 1896, 83,
-	2035, 1895, 82, 569, 2026, 1895, 622, 81, 2024, 2034,
-	80, 79, 2028, 396, 78, 77, 139, 76, 75, 395,
-	926, 520, 72, 2038, 71, 568, 1068, 1068, 70, 2058,
-	69, 27, 23, 95, 2065, 22, 2033, 2059, 2032, 20,
-	21, 1254, 622, 26, 2064, 2022, 25, 2077, 18, 2025,
-	15, 136, 9, 2070, 19, 53, 523, 54, 1381, 1381,
-	396, 139, 52, 394, 139, 51, 569, 50, 11, 46,
-	45, 44, 139, 2084, 43, 42, 41, 7, 94, 394,
-	39, 2092, 38, 6, 93, 5, 1126, 2061, 106, 103,
-	139, 105, 102, 567, 1897, 104, 1897, 2109, 107, 1037,
-	139, 2108, 2068, 99, 2110, 100, 1896, 101, 1896, 917,
-	2112, 2107, 98, 1895, 97, 1895, 36, 139, 35, 34,
-	2102, 33, 139, 2, 2122, 523, 1381, 1381, 1381, 523,
-	139, 136, 523, 523, 523, 523, 523, 1, 0, 0,
-	0, 1174, 0, 2123, 0, 523, 523, 0, 2137, 0,
-	0, 139, 501, 2145, 0, 139, 568, 1037, 0, 2121,
-	548, 2120, 610, 0, 0, 632, 2164, 0, 2162, 2148,
-	2128, 2129, 0, 0, 0, 2156, 0, 2157, 351, 2169,
-	0, 567, 0, 0, 0, 2170, 0, 2167, 0, 0,
-	1232, 139, 139, 2166, 0, 1037, 351, 569, 0, 1238,
-	2173, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 351, 1957, 1256, 2184, 0, 676, 66, 0, 0,
-	0, 0, 139, 2127, 0, 2198, 139, 2190, 2182, 139,
-	2199, 0, 0, 2204, 0, 2188, 394, 0, 622, 0,
-	0, 139, 1629, 1630, 139, 0, 0, 2172, 0, 395,
-	2206, 2201, 1897, 139, 2209, 2213, 2215, 2214, 2223, 1067,
-	2212, 0, 2234, 0, 1896, 395, 0, 2210, 2211, 0,
-	0, 1895, 2236, 66, 0, 0, 0, 0, 2205, 568,
-	0, 0, 0, 2243, 1585, 1586, 139, 2242, 0, 0,
-	396, 0, 0, 0, 0, 1644, 2253, 2251, 0, 0,
-	0, 2257, 0, 0, 2258, 0, 396, 0, 0, 0,
-	0, 0, 2200, 0, 0, 0, 1381, 1381, 0, 2269,
-	569, 2270, 2268, 2271, 0, 0, 0, 536, 0, 0,
-	0, 549, 1629, 1630, 0, 0, 0, 0, 0, 2224,
-	0, 0, 0, 0, 0, 0, 623, 66, 0, 1635,
-	0, 0, 1652, 1653, 1654, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 568, 0, 0,
-	0, 0, 0, 0, 0, 1381, 1381, 1381, 1381, 1381,
-	1381, 1381, 1381, 1381, 1381, 1381, 1381, 1381, 1381, 1381,
-	1381, 1381, 1381, 1381, 0, 1381, 0, 0, 0, 0,
-	0, 0, 351, 0, 0, 1434, 0, 1645, 569, 2048,
-	0, 0, 632, 0, 0, 2054, 0, 0, 0, 0,
-	523, 523, 395, 523, 1036, 351, 351, 0, 1463, 632,
-	0, 1067, 1067, 0, 0, 0, 0, 0, 0, 1635,
-	0, 0, 0, 0, 0, 0, 0, 351, 0, 0,
-	0, 0, 0, 1479, 2117, 0, 931, 932, 0, 0,
-	0, 0, 0, 396, 0, 0, 0, 351, 351, 351,
-	0, 0, 0, 0, 1494, 0, 0, 0, 351, 934,
-	1646, 1070, 0, 0, 0, 0, 351, 0, 0, 351,
-	2100, 0, 0, 0, 0, 351, 0, 1645, 1069, 0,
-	0, 351, 351, 933, 1033, 351, 0, 1068, 2119, 0,
-	1232, 0, 0, 1232, 0, 658, 0, 0, 761, 0,
-	1541, 0, 0, 0, 0, 0, 0, 0, 0, 1068,
-	351, 0, 0, 0, 0, 351, 0, 1559, 0, 0,
-	0, 0, 1793, 1794, 351, 0, 0, 791, 792, 0,
-	1479, 0, 0, 0, 122, 0, 0, 0, 0, 0,
-	0, 0, 0, 939, 0, 1629, 1630, 0, 0, 2155,
-	1646, 1640, 1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637,
-	0, 0, 0, 0, 549, 0, 0, 0, 0, 1068,
-	1629, 1630, 0, 1647, 1648, 1649, 1036, 1036, 0, 0,
-	109, 1839, 1840, 1841, 1842, 1843, 1844, 1845, 1846, 1847,
-	1848, 1849, 1850, 1851, 1852, 1853, 1854, 1855, 1856, 1857,
-	0, 1861, 0, 0, 0, 0, 0, 0, 111, 0,
-	0, 0, 0, 879, 879, 55, 0, 56, 0, 885,
-	0, 0, 0, 1644, 0, 0, 0, 2203, 0, 0,
-	0, 0, 0, 1070, 1070, 0, 0, 0, 0, 0,
-	0, 58, 1254, 1639, 1631, 1632, 1633, 1634, 1636, 1637,
-	1069, 1069, 1635, 931, 932, 0, 1033, 1033, 0, 1068,
-	0, 969, 970, 971, 972, 973, 0, 0, 0, 0,
-	0, 981, 2009, 0, 0, 2002, 934, 1635, 0, 0,
-	0, 988, 0, 2000, 0, 0, 0, 2004, 1479, 0,
-	0, 0, 0, 0, 0, 1068, 1068, 1651, 0, 0,
-	933, 0, 0, 0, 1068, 1068, 2010, 0, 1381, 1126,
-	1645, 1126, 1703, 0, 1650, 0, 0, 0, 523, 0,
-	0, 351, 0, 0, 0, 1717, 0, 0, 2003, 0,
-	0, 0, 0, 0, 1381, 1645, 0, 1068, 0, 0,
-	0, 0, 122, 0, 0, 351, 0, 536, 0, 0,
-	0, 0, 0, 0, 0, 351, 1730, 1731, 0, 0,
-	939, 1494, 0, 0, 125, 1737, 1738, 1740, 1742, 1743,
-	937, 938, 940, 941, 116, 0, 0, 1750, 0, 1752,
-	351, 0, 0, 1646, 0, 0, 0, 0, 109, 0,
-	0, 0, 129, 0, 0, 0, 351, 0, 0, 0,
-	0, 0, 1232, 0, 0, 2005, 1381, 2006, 1646, 0,
-	0, 632, 1232, 0, 0, 0, 111, 66, 949, 1144,
-	0, 1149, 903, 55, 0, 56, 0, 1156, 128, 0,
-	0, 351, 351, 0, 0, 0, 123, 2008, 0, 0,
-	0, 0, 0, 124, 0, 0, 2011, 0, 0, 58,
+var sqlAct = [...]int16{
+	697, 2235, 2243, 2212, 2120, 2280, 2244, 1899, 2245, 1122,
+	1257, 1523, 2046, 1440, 2234, 543, 2152, 2032, 2017, 1841,
+	1922, 2105, 618, 392, 1905, 1724, 65, 673, 1085, 905,
+	1129, 1462, 687, 2071, 1906, 1236, 1670, 1548, 139, 901,
+	1405, 139, 1726, 1439, 1481, 1564, 140, 1452, 139, 1443,
+	1125, 1444, 695, 694, 1953, 1495, 139, 1036, 1615, 394,
+	640, 139, 139, 1035, 1669, 139, 989, 1336, 139, 888,
+	1361, 879, 1269, 1258, 1526, 139, 1237, 368, 1697, 1535,
+	1187, 1160, 1477, 1243, 776, 1189, 1767, 1527, 1111, 1086,
+	1486, 1402, 1346, 667, 1324, 1447, 1321, 919, 672, 1021,
+	561, 880, 558, 809, 775, 395, 366, 1355, 1373, 980,
+	562, 622, 666, 654, 1251, 804, 567, 1224, 892, 1209,
+	609, 550, 110, 131, 139, 139, 1025, 818, 811, 819,
+	139, 499, 817, 1109, 139, 139, 112, 376, 24, 350,
+	553, 548, 689, 517, 2033, 1117, 863, 384, 632, 923,
+	648, 521, 519, 625, 862, 607, 135, 515, 1784, 503,
+	1603, 1785, 568, 823, 2266, 1119, 1524, 914, 1119, 547,
+	2257, 547, 542, 1265, 1119, 902, 1736, 2256, 110, 1254,
+	1265, 2254, 1143, 2252, 2031, 496, 914, 2206, 2180, 2169,
+	1420, 2031, 2168, 1265, 1244, 1574, 122, 1358, 1573, 502,
+	2166, 1211, 113, 1420, 551, 2197, 2159, 37, 117, 914,
+	2135, 2132, 2131, 2031, 914, 2031, 1771, 620, 2130, 122,
+	108, 914, 2118, 2092, 2080, 2031, 1420, 914, 623, 1244,
+	2077, 935, 936, 914, 119, 1426, 1607, 965, 966, 967,
+	40, 1608, 109, 2068, 1359, 2067, 1265, 535, 1265, 1771,
+	2030, 1426, 110, 2031, 938, 1640, 1641, 969, 1658, 1659,
+	1660, 1210, 47, 935, 936, 109, 49, 1493, 24, 2005,
+	111, 1977, 1265, 120, 1737, 1877, 570, 55, 937, 56,
+	611, 935, 936, 1819, 952, 1983, 938, 914, 1265, 1360,
+	1357, 1980, 1967, 111, 1420, 914, 1708, 57, 1816, 125,
+	55, 1265, 56, 58, 938, 1480, 1805, 1436, 1655, 1248,
+	937, 1800, 1780, 534, 1248, 1781, 1774, 1707, 1687, 1265,
+	914, 1688, 1093, 1265, 1340, 1614, 58, 129, 937, 1685,
+	1684, 1683, 1265, 1265, 1265, 1606, 1604, 875, 943, 1265,
+	1265, 630, 1551, 1522, 125, 1265, 914, 658, 1428, 677,
+	66, 1265, 617, 2034, 130, 139, 1120, 962, 970, 1120,
+	139, 636, 1646, 128, 1362, 1120, 1419, 125, 567, 1420,
+	943, 123, 129, 1264, 1247, 1543, 1265, 1248, 124, 1218,
+	931, 906, 1217, 932, 1115, 59, 564, 790, 943, 2258,
+	960, 60, 2250, 2233, 548, 129, 953, 114, 1711, 1661,
+	2219, 830, 2161, 2133, 935, 936, 66, 2010, 128, 67,
+	68, 61, 2006, 62, 568, 63, 123, 121, 1998, 1997,
+	1656, 1996, 64, 124, 1992, 1128, 125, 938, 1573, 1991,
+	1990, 128, 1989, 74, 1210, 1952, 116, 1897, 1892, 1640,
+	1641, 1356, 1253, 1332, 1887, 118, 953, 1972, 1886, 125,
+	1885, 937, 1827, 1488, 129, 1706, 1692, 1689, 650, 116,
+	536, 1677, 1668, 1424, 549, 114, 997, 1639, 1636, 954,
+	1635, 649, 1141, 96, 1633, 1136, 1620, 129, 655, 624,
+	66, 1118, 115, 1619, 1555, 963, 1370, 1640, 1641, 1369,
+	128, 1368, 1367, 1657, 830, 1241, 639, 990, 123, 114,
+	904, 139, 829, 1843, 2209, 124, 637, 903, 2196, 2195,
+	2188, 943, 2182, 128, 2178, 139, 2156, 2115, 2100, 954,
+	2089, 123, 2037, 567, 114, 139, 2029, 2013, 124, 139,
+	139, 139, 2003, 139, 1920, 1918, 1917, 1916, 139, 139,
+	139, 139, 139, 1913, 1903, 1811, 1646, 114, 1333, 1788,
+	803, 1776, 961, 1895, 1760, 815, 1758, 1712, 964, 1715,
+	948, 946, 947, 939, 940, 941, 942, 944, 945, 568,
+	1667, 1629, 2155, 567, 1628, 1625, 1600, 1595, 1326, 1652,
+	1653, 1654, 1553, 1521, 1651, 1649, 1650, 1642, 1643, 1644,
+	1645, 1647, 1648, 802, 1646, 939, 940, 941, 942, 944,
+	945, 1971, 1026, 139, 139, 139, 139, 139, 1029, 139,
+	1712, 786, 947, 939, 940, 941, 942, 944, 945, 568,
+	935, 936, 2012, 650, 1412, 1366, 1229, 139, 139, 1216,
+	567, 139, 1121, 1033, 1019, 1018, 792, 394, 812, 139,
+	886, 1017, 1016, 938, 1015, 1014, 139, 139, 139, 636,
+	139, 1013, 1012, 548, 807, 1011, 1010, 1009, 139, 1008,
+	915, 1007, 835, 774, 766, 1006, 1005, 937, 1004, 1003,
+	690, 1002, 771, 1001, 994, 836, 568, 983, 114, 619,
+	795, 878, 798, 395, 770, 883, 884, 535, 761, 806,
+	806, 652, 2011, 929, 1985, 1783, 1779, 1230, 548, 990,
+	921, 1695, 869, 812, 1694, 650, 981, 1388, 904, 826,
+	827, 783, 986, 2171, 909, 867, 785, 549, 873, 912,
+	1541, 565, 1476, 1727, 1975, 623, 910, 1786, 900, 1575,
+	1475, 834, 836, 870, 866, 638, 1244, 920, 941, 942,
+	944, 945, 1197, 110, 650, 924, 924, 906, 1099, 1640,
+	1641, 1691, 864, 534, 784, 1640, 1641, 649, 1690, 999,
+	1579, 1031, 1195, 567, 876, 139, 1032, 782, 767, 1126,
+	139, 1642, 1643, 1644, 1645, 1647, 1648, 1698, 1358, 1196,
+	908, 933, 2072, 1524, 567, 567, 756, 913, 1844, 1103,
+	1102, 394, 564, 559, 1611, 1347, 922, 925, 1569, 1022,
+	139, 858, 1655, 1936, 2273, 891, 934, 385, 1127, 568,
+	2225, 1090, 996, 1250, 1116, 2158, 356, 1453, 2272, 2061,
+	1429, 1644, 1645, 1647, 1648, 1359, 1703, 993, 540, 390,
+	568, 568, 596, 1023, 1024, 386, 904, 395, 605, 139,
+	859, 602, 853, 139, 601, 139, 139, 139, 139, 139,
+	139, 1132, 597, 2150, 2149, 139, 1646, 2148, 895, 139,
+	139, 2147, 387, 1951, 1027, 836, 139, 546, 357, 660,
+	1360, 1357, 532, 1106, 1030, 1950, 139, 898, 1100, 139,
+	1933, 389, 1932, 1624, 1455, 539, 1623, 783, 1087, 1190,
+	1622, 1191, 1208, 893, 139, 604, 1894, 1621, 1582, 1519,
+	536, 1518, 896, 394, 1516, 755, 139, 1190, 1308, 1191,
+	1268, 865, 139, 1088, 1656, 139, 545, 894, 1112, 629,
+	1092, 1969, 1089, 1105, 1416, 1104, 1232, 139, 1415, 139,
+	784, 1231, 1537, 567, 2157, 1279, 1134, 1079, 394, 1463,
+	1190, 1185, 1191, 525, 1235, 1362, 1497, 1147, 1146, 395,
+	1135, 1184, 1947, 1138, 1793, 1140, 1107, 945, 1323, 1177,
+	535, 1278, 1323, 535, 535, 1192, 547, 1166, 1170, 2122,
+	702, 66, 1794, 1559, 110, 917, 907, 769, 897, 568,
+	2200, 1221, 1454, 1192, 395, 1362, 758, 1657, 2247, 926,
+	1164, 388, 623, 1270, 1277, 1167, 1206, 1186, 1171, 1172,
+	1173, 1174, 1175, 1214, 1215, 360, 2263, 1640, 1641, 1566,
+	1242, 1181, 1182, 1222, 1226, 1227, 1192, 2278, 1347, 982,
+	2272, 984, 1356, 505, 1337, 1261, 1156, 538, 988, 1157,
+	1158, 1341, 359, 358, 855, 1349, 1938, 603, 1266, 1765,
+	1256, 506, 1119, 655, 1762, 391, 1267, 1536, 1375, 1375,
+	1386, 2203, 1397, 110, 921, 1204, 541, 1344, 1409, 1410,
+	1411, 1252, 2185, 1252, 1497, 1203, 537, 1702, 1503, 544,
+	1496, 528, 1201, 754, 2248, 2204, 606, 1704, 1651, 1649,
+	1650, 1642, 1643, 1644, 1645, 1647, 1648, 850, 1200, 1334,
+	1834, 1331, 1648, 1183, 839, 533, 1478, 1479, 1020, 856,
+	529, 507, 1756, 1567, 1432, 526, 2262, 1458, 1198, 2096,
+	530, 2107, 1418, 1837, 1646, 1434, 394, 1383, 978, 139,
+	1188, 1330, 139, 1627, 851, 1199, 1328, 565, 560, 139,
+	2249, 840, 396, 1225, 838, 1835, 1435, 139, 139, 1437,
+	139, 547, 139, 139, 394, 139, 139, 1566, 1433, 1240,
+	1423, 1898, 857, 1927, 779, 1558, 110, 2246, 2271, 1472,
+	2269, 2045, 395, 2283, 139, 1460, 2175, 1470, 885, 1425,
+	139, 527, 1656, 536, 844, 382, 536, 536, 2123, 764,
+	1945, 1024, 1023, 1193, 139, 139, 139, 657, 1362, 569,
+	395, 139, 2142, 757, 1027, 139, 1030, 361, 1430, 1939,
+	508, 1193, 66, 139, 2261, 1584, 139, 822, 2279, 1322,
+	1362, 2141, 139, 394, 2113, 1305, 1935, 650, 1747, 780,
+	139, 139, 385, 781, 139, 2001, 1529, 1530, 2291, 139,
+	1438, 2025, 139, 1120, 1193, 1743, 1528, 362, 1461, 139,
+	812, 812, 1498, 624, 390, 1657, 1469, 1504, 1506, 139,
+	386, 139, 1474, 1466, 139, 1467, 139, 1531, 1133, 395,
+	1544, 1562, 1485, 139, 1123, 2026, 363, 1545, 364, 139,
+	1468, 1554, 1833, 66, 891, 66, 504, 387, 509, 505,
+	548, 66, 1490, 812, 1492, 1489, 1501, 1532, 1465, 806,
+	1873, 806, 2213, 650, 1180, 1094, 389, 506, 1494, 2281,
+	110, 812, 1571, 1923, 650, 1540, 1509, 1533, 1534, 510,
+	623, 1539, 1525, 1491, 821, 1303, 1306, 1520, 623, 623,
+	1325, 821, 623, 2057, 2002, 1563, 1549, 895, 2047, 2290,
+	1319, 1517, 2114, 1098, 1511, 1717, 1538, 1514, 1650, 1642,
+	1643, 1644, 1645, 1647, 1648, 1317, 898, 1561, 1302, 623,
+	895, 1568, 920, 548, 2282, 1220, 1152, 507, 1716, 1578,
+	1576, 920, 893, 2060, 2021, 1613, 2022, 1598, 1329, 898,
+	2059, 896, 1484, 820, 1601, 2284, 1096, 1219, 497, 494,
+	820, 779, 647, 1874, 66, 1223, 894, 646, 1097, 1875,
+	545, 1550, 1617, 1618, 896, 799, 2024, 1900, 822, 2014,
+	642, 1585, 1583, 641, 132, 2027, 388, 2111, 1954, 1557,
+	380, 32, 379, 31, 1768, 1313, 375, 28, 1365, 3,
+	548, 1599, 2056, 1314, 2099, 1315, 1153, 567, 139, 1320,
+	2000, 2112, 1042, 1671, 1666, 378, 17, 567, 1610, 1810,
+	1792, 569, 1634, 1304, 1594, 1679, 1560, 897, 1515, 139,
+	1512, 139, 139, 1108, 372, 13, 508, 1427, 139, 1246,
+	391, 139, 861, 860, 854, 139, 849, 848, 847, 2058,
+	897, 374, 16, 568, 846, 845, 842, 762, 1693, 1710,
+	645, 1713, 2023, 568, 1672, 139, 699, 1178, 373, 14,
+	1169, 1674, 1675, 1676, 1000, 139, 139, 139, 371, 12,
+	1709, 139, 595, 852, 1364, 139, 139, 139, 139, 139,
+	2242, 377, 10, 2210, 1701, 2055, 1699, 139, 1700, 139,
+	139, 370, 8, 1943, 1941, 1705, 369, 4, 66, 836,
+	682, 1934, 1723, 1464, 509, 1316, 1457, 634, 631, 139,
+	635, 32, 1318, 31, 1205, 139, 66, 28, 1073, 66,
+	628, 1202, 1744, 1194, 139, 139, 1732, 1145, 1144, 1722,
+	1709, 624, 1734, 1142, 1139, 510, 17, 1789, 136, 624,
+	624, 351, 1137, 624, 139, 139, 1738, 1824, 353, 1739,
+	1782, 1787, 1725, 1735, 1801, 13, 365, 2084, 2273, 824,
+	1755, 495, 136, 1740, 1718, 501, 569, 1721, 501, 1766,
+	624, 1114, 16, 549, 2086, 523, 1042, 1042, 1795, 1719,
+	1770, 1796, 1729, 1730, 1775, 1731, 1772, 1773, 1764, 14,
+	1482, 1497, 598, 599, 615, 1508, 1778, 1507, 139, 12,
+	1497, 1790, 1829, 1830, 1831, 1791, 1505, 623, 1797, 1799,
+	2192, 1817, 10, 110, 2034, 1804, 569, 1802, 1757, 1803,
+	381, 1759, 8, 841, 610, 610, 1325, 4, 2163, 1813,
+	351, 825, 1592, 1955, 136, 633, 828, 1072, 935, 936,
+	1513, 1820, 1261, 623, 1510, 1823, 984, 1590, 935, 936,
+	2198, 1483, 2043, 1459, 1456, 1255, 1207, 1091, 1845, 1270,
+	1848, 938, 1155, 1836, 1838, 1839, 616, 1034, 1270, 1853,
+	1422, 2276, 1850, 569, 1847, 2289, 1880, 2079, 1878, 139,
+	396, 1961, 139, 1852, 498, 937, 1640, 1641, 1896, 1888,
+	935, 936, 1073, 1073, 139, 937, 1893, 567, 1840, 1919,
+	832, 1884, 1041, 832, 831, 1881, 1696, 394, 139, 1686,
+	1546, 1417, 1904, 984, 1414, 1912, 1413, 1587, 1075, 1354,
+	987, 833, 1987, 2208, 2106, 1832, 1720, 1588, 1901, 997,
+	1930, 1593, 1914, 995, 768, 1911, 1910, 524, 1924, 2121,
+	383, 1168, 139, 568, 843, 1542, 1228, 139, 2202, 1926,
+	1929, 1993, 1626, 395, 2151, 2095, 1363, 394, 139, 139,
+	1959, 998, 48, 567, 1908, 676, 2015, 1902, 1446, 1948,
+	1445, 1949, 1966, 397, 1101, 698, 66, 552, 1374, 66,
+	1963, 1271, 1946, 759, 700, 1039, 701, 1040, 1028, 1960,
+	688, 1968, 1962, 1965, 1037, 653, 1259, 1957, 1958, 1925,
+	1327, 1345, 1609, 395, 991, 668, 569, 680, 679, 568,
+	1342, 1072, 1072, 760, 139, 1547, 1565, 1974, 1970, 1151,
+	1473, 1148, 1940, 531, 1637, 651, 1395, 569, 569, 1956,
+	66, 1387, 1384, 66, 396, 791, 887, 1589, 979, 1260,
+	623, 66, 789, 1249, 1591, 1979, 1572, 1421, 877, 1159,
+	1389, 614, 613, 612, 1441, 643, 787, 1999, 624, 1095,
+	351, 1431, 972, 971, 600, 836, 2177, 1942, 778, 1944,
+	777, 1124, 1746, 2277, 2191, 1937, 1041, 1041, 2224, 127,
+	126, 2170, 2104, 1556, 139, 73, 30, 29, 139, 139,
+	92, 91, 1075, 1075, 624, 567, 2035, 2038, 2028, 90,
+	89, 2041, 88, 139, 139, 139, 1912, 87, 1973, 86,
+	85, 2040, 139, 84, 139, 2044, 139, 139, 139, 1912,
+	83, 139, 139, 1912, 82, 1074, 1911, 1910, 2050, 81,
+	80, 79, 2042, 78, 77, 76, 2048, 75, 520, 1911,
+	1910, 568, 139, 1911, 1910, 2051, 396, 72, 71, 70,
+	69, 27, 1640, 1641, 23, 2085, 2054, 95, 22, 20,
+	2078, 2075, 2076, 21, 26, 25, 2074, 2081, 2087, 18,
+	15, 9, 2082, 19, 2094, 53, 569, 54, 52, 51,
+	50, 396, 11, 46, 45, 44, 43, 139, 42, 394,
+	139, 41, 7, 94, 39, 38, 6, 2049, 139, 1596,
+	1597, 765, 93, 5, 106, 394, 103, 105, 102, 104,
+	107, 99, 2109, 100, 2064, 523, 139, 101, 98, 567,
+	2070, 97, 36, 35, 2119, 351, 139, 34, 2126, 523,
+	797, 523, 1912, 800, 1912, 395, 2124, 2101, 523, 523,
+	351, 813, 633, 139, 33, 2, 2139, 2125, 2129, 139,
+	2127, 395, 1911, 1910, 1911, 1910, 1, 139, 0, 1646,
+	2137, 1042, 0, 0, 921, 568, 0, 0, 1663, 1664,
+	1665, 0, 0, 0, 0, 0, 0, 2140, 139, 0,
+	0, 2162, 139, 1042, 2154, 0, 548, 0, 0, 2144,
+	0, 624, 0, 2179, 0, 0, 2117, 2173, 2181, 1074,
+	1074, 0, 0, 501, 351, 351, 872, 351, 567, 610,
+	2187, 2186, 2174, 0, 2136, 2184, 2183, 1656, 139, 139,
+	66, 2138, 0, 0, 0, 0, 0, 351, 351, 0,
+	2190, 136, 1640, 1641, 2165, 0, 0, 0, 0, 351,
+	0, 2201, 0, 1042, 0, 0, 351, 351, 351, 139,
+	927, 2134, 0, 139, 568, 2216, 139, 2215, 136, 396,
+	2207, 1389, 1389, 394, 0, 2221, 2217, 2199, 139, 0,
+	0, 139, 0, 2223, 2205, 0, 2172, 1073, 2145, 2146,
+	139, 2232, 0, 2222, 2229, 2231, 2226, 396, 2230, 2240,
+	1657, 1912, 0, 2241, 2251, 0, 0, 0, 2218, 1073,
+	2253, 0, 0, 0, 0, 0, 2227, 2228, 2260, 395,
+	2189, 1911, 1910, 139, 2259, 0, 0, 0, 1038, 0,
+	0, 0, 2270, 2268, 0, 0, 0, 2274, 0, 2275,
+	1389, 1389, 1389, 0, 0, 0, 1042, 0, 0, 1646,
+	0, 0, 0, 0, 0, 0, 0, 2286, 2288, 2285,
+	0, 0, 0, 2287, 2220, 0, 396, 0, 0, 1073,
+	0, 1808, 1809, 0, 0, 136, 0, 0, 0, 1261,
+	523, 0, 1042, 1042, 0, 0, 0, 0, 0, 0,
+	0, 1042, 1042, 0, 1642, 1643, 1644, 1645, 1647, 1648,
+	0, 0, 0, 0, 0, 2088, 1072, 1656, 0, 0,
+	1131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1042, 0, 0, 0, 1072, 0,
+	1854, 1855, 1856, 1857, 1858, 1859, 1860, 1861, 1862, 1863,
+	1864, 1865, 1866, 1867, 1868, 1869, 1870, 1871, 1872, 523,
+	1876, 0, 0, 523, 0, 136, 523, 523, 523, 523,
+	523, 0, 1073, 0, 0, 1179, 0, 0, 0, 523,
+	523, 1041, 0, 66, 930, 122, 501, 0, 0, 0,
+	1657, 0, 0, 0, 0, 0, 610, 1075, 1072, 633,
+	0, 0, 0, 1041, 0, 0, 0, 0, 1073, 1073,
+	0, 0, 1038, 1038, 351, 0, 0, 1073, 1073, 1075,
+	0, 0, 2025, 0, 0, 2018, 1239, 0, 0, 984,
+	0, 109, 351, 2016, 0, 1245, 0, 2020, 0, 0,
+	0, 0, 0, 1389, 1389, 0, 0, 351, 0, 1263,
+	1073, 0, 0, 0, 0, 0, 2026, 0, 0, 111,
+	0, 0, 0, 1041, 0, 0, 55, 0, 56, 0,
+	0, 0, 1042, 0, 0, 0, 935, 936, 2019, 1075,
+	0, 1651, 1649, 1650, 1642, 1643, 1644, 1645, 1647, 1648,
+	569, 1072, 58, 0, 0, 0, 0, 0, 0, 938,
+	569, 0, 1389, 1389, 1389, 1389, 1389, 1389, 1389, 1389,
+	1389, 1389, 1389, 1389, 1389, 1389, 1389, 1389, 1389, 1389,
+	1389, 0, 1389, 937, 122, 0, 0, 1072, 1072, 952,
+	0, 0, 0, 0, 0, 0, 1072, 1072, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 899, 0,
+	0, 0, 0, 0, 0, 2021, 1041, 2022, 0, 0,
+	0, 0, 0, 0, 122, 0, 0, 0, 0, 1072,
+	109, 0, 1075, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 943, 0, 0, 0, 2024, 1073, 0,
+	0, 0, 1041, 1041, 0, 0, 2027, 0, 111, 0,
+	0, 1041, 1041, 0, 1074, 55, 0, 56, 1075, 1075,
+	109, 0, 0, 0, 0, 125, 0, 1075, 1075, 1176,
+	0, 0, 0, 0, 0, 116, 1074, 0, 0, 0,
+	0, 58, 0, 0, 1041, 0, 0, 0, 111, 351,
+	0, 953, 1442, 129, 0, 55, 0, 56, 0, 633,
+	1075, 0, 0, 0, 0, 0, 0, 523, 523, 0,
+	523, 0, 351, 351, 1042, 1471, 633, 0, 0, 0,
+	0, 58, 0, 2023, 0, 0, 0, 1640, 1641, 128,
+	1658, 1659, 1660, 0, 351, 0, 1074, 123, 0, 0,
+	1487, 0, 0, 1976, 124, 0, 0, 0, 2098, 0,
+	0, 0, 0, 0, 351, 351, 351, 1072, 0, 0,
+	0, 1502, 0, 114, 954, 351, 0, 0, 0, 0,
+	0, 0, 1042, 351, 2116, 0, 351, 0, 0, 0,
+	1655, 0, 351, 0, 0, 0, 0, 0, 0, 0,
+	351, 351, 0, 0, 351, 0, 0, 0, 0, 1239,
+	0, 0, 1239, 0, 125, 0, 0, 0, 0, 1552,
+	0, 1042, 0, 0, 116, 0, 0, 0, 0, 351,
+	0, 351, 1041, 0, 351, 0, 1570, 0, 0, 1074,
+	1073, 0, 129, 351, 1646, 0, 0, 0, 1075, 1487,
+	569, 0, 0, 0, 125, 0, 0, 2164, 1640, 1641,
+	396, 1658, 1659, 1660, 116, 948, 946, 947, 939, 940,
+	941, 942, 944, 945, 1815, 1074, 1074, 0, 128, 0,
+	0, 1661, 129, 0, 1074, 1074, 123, 0, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 0, 1073, 0,
+	0, 0, 1656, 0, 0, 0, 0, 0, 0, 122,
+	396, 1655, 114, 0, 0, 0, 569, 1074, 128, 0,
+	1389, 0, 0, 0, 0, 0, 123, 0, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 1073, 0, 659,
+	0, 0, 763, 0, 0, 0, 1389, 935, 936, 0,
+	0, 0, 114, 0, 0, 109, 0, 1038, 0, 1072,
+	0, 0, 0, 0, 0, 1646, 0, 0, 0, 0,
+	938, 793, 794, 0, 0, 1657, 0, 0, 0, 1038,
+	0, 0, 0, 111, 0, 0, 0, 0, 0, 0,
+	55, 0, 56, 0, 937, 0, 1640, 1641, 0, 1658,
+	1659, 1660, 1661, 0, 0, 0, 1640, 1641, 1487, 0,
+	0, 0, 1814, 0, 0, 0, 58, 1072, 0, 1389,
+	0, 0, 0, 1656, 1041, 0, 0, 0, 0, 1131,
+	0, 1131, 1714, 0, 0, 0, 0, 0, 523, 1038,
+	1075, 351, 0, 0, 0, 1728, 0, 0, 569, 1655,
+	0, 0, 0, 0, 943, 1074, 1072, 881, 881, 881,
+	0, 1652, 1653, 1654, 889, 351, 1651, 1649, 1650, 1642,
+	1643, 1644, 1645, 1647, 1648, 351, 1741, 1742, 0, 0,
+	0, 1502, 1041, 0, 0, 1748, 1749, 1751, 1753, 1754,
+	0, 0, 0, 0, 0, 0, 1657, 1761, 1075, 1763,
+	351, 0, 0, 1646, 0, 0, 973, 974, 975, 976,
+	977, 0, 953, 1646, 0, 0, 985, 0, 0, 351,
+	0, 1041, 0, 0, 0, 1239, 992, 0, 0, 0,
+	0, 0, 1038, 0, 633, 1239, 0, 1075, 0, 125,
+	1661, 0, 396, 1640, 1641, 0, 1658, 1659, 1660, 116,
+	0, 0, 0, 0, 351, 351, 0, 0, 396, 0,
+	0, 1656, 0, 0, 0, 0, 0, 129, 1038, 1038,
+	0, 1656, 569, 0, 0, 0, 0, 1038, 1038, 0,
+	0, 0, 1652, 1653, 1654, 954, 0, 1651, 1649, 1650,
+	1642, 1643, 1644, 1645, 1647, 1648, 1655, 0, 0, 0,
+	0, 0, 0, 128, 0, 0, 0, 0, 1842, 0,
+	1038, 123, 0, 0, 0, 0, 0, 0, 124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1657, 0, 0, 367, 0, 0,
+	0, 0, 0, 0, 1657, 0, 0, 1074, 0, 0,
+	1646, 935, 936, 0, 0, 0, 0, 0, 0, 0,
+	0, 569, 0, 0, 0, 1149, 0, 1154, 0, 0,
+	1662, 0, 0, 1161, 938, 0, 948, 946, 947, 939,
+	940, 941, 942, 944, 945, 0, 0, 1661, 0, 1502,
+	0, 0, 1131, 1907, 0, 0, 0, 0, 937, 0,
+	0, 0, 0, 0, 1921, 1074, 0, 0, 1656, 0,
+	0, 0, 0, 0, 0, 0, 396, 0, 1931, 0,
+	1652, 1653, 1654, 0, 0, 1651, 1649, 1650, 1642, 1643,
+	1644, 1645, 1647, 1648, 0, 0, 1649, 1650, 1642, 1643,
+	1644, 1645, 1647, 1648, 1074, 0, 0, 0, 1038, 0,
+	0, 0, 351, 0, 0, 0, 0, 633, 943, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1239, 633,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1657, 0, 0, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 0, 1307, 0, 1309,
+	1310, 1311, 1312, 0, 0, 0, 953, 0, 0, 0,
+	0, 0, 0, 0, 1994, 0, 1335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1371, 1372, 0, 0, 1385, 0, 1396, 1398, 1403, 1406,
+	1407, 1408, 0, 0, 0, 0, 0, 1652, 1653, 1654,
+	0, 0, 1651, 1649, 1650, 1642, 1643, 1644, 1645, 1647,
+	1648, 0, 935, 936, 1907, 0, 0, 0, 0, 954,
+	0, 0, 0, 0, 633, 0, 0, 1907, 633, 1442,
+	0, 1907, 0, 0, 0, 938, 0, 0, 0, 0,
+	0, 0, 0, 2052, 2053, 1502, 0, 0, 0, 0,
+	0, 0, 2062, 0, 2063, 0, 351, 2065, 2066, 937,
+	0, 2069, 351, 0, 0, 0, 0, 0, 0, 0,
+	1038, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2083, 0, 633, 0, 0, 0, 935, 936, 0, 955,
+	956, 957, 965, 966, 967, 0, 0, 0, 0, 0,
+	0, 0, 958, 0, 0, 0, 0, 0, 0, 938,
+	0, 0, 969, 939, 940, 941, 942, 944, 945, 943,
+	0, 0, 0, 0, 0, 0, 0, 2108, 1038, 0,
+	2110, 0, 0, 937, 0, 0, 0, 0, 351, 952,
+	1907, 0, 1907, 0, 0, 0, 0, 935, 936, 0,
+	955, 956, 957, 965, 966, 967, 351, 0, 0, 0,
+	0, 0, 0, 958, 0, 0, 1502, 1038, 0, 0,
+	938, 0, 0, 969, 0, 0, 0, 953, 0, 0,
+	0, 0, 0, 2153, 0, 881, 0, 0, 0, 1239,
+	0, 0, 889, 943, 937, 0, 0, 2160, 0, 0,
+	952, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 962, 970, 0, 0, 0, 0, 351, 0,
+	0, 0, 1131, 0, 0, 0, 0, 0, 0, 0,
+	968, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1577, 0, 0, 0, 0, 960, 0, 0, 0, 0,
+	954, 953, 0, 0, 943, 0, 0, 0, 2193, 2194,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 962, 970, 0, 959, 1580, 1581, 0,
+	0, 0, 0, 1586, 0, 0, 0, 0, 0, 2214,
+	0, 968, 0, 633, 0, 0, 351, 0, 0, 1907,
+	0, 0, 0, 0, 0, 0, 960, 0, 2153, 0,
+	0, 351, 953, 0, 0, 1605, 0, 0, 0, 0,
+	633, 0, 1612, 0, 954, 1616, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 959, 0, 0,
+	963, 1630, 946, 947, 939, 940, 941, 942, 944, 945,
+	0, 0, 0, 2267, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 985, 0,
+	0, 0, 0, 0, 1403, 1403, 1403, 0, 0, 0,
+	0, 0, 0, 935, 936, 954, 955, 956, 957, 965,
+	966, 967, 0, 0, 0, 0, 0, 0, 0, 958,
+	0, 963, 0, 0, 0, 0, 938, 961, 0, 969,
+	949, 950, 951, 964, 0, 948, 946, 947, 939, 940,
+	941, 942, 944, 945, 0, 0, 0, 0, 0, 0,
+	937, 2007, 0, 0, 0, 0, 952, 0, 935, 936,
+	0, 955, 956, 957, 965, 966, 967, 0, 0, 0,
+	1733, 0, 0, 0, 958, 1161, 0, 0, 0, 0,
+	0, 938, 0, 0, 969, 0, 0, 0, 961, 0,
+	0, 949, 950, 951, 964, 0, 948, 946, 947, 939,
+	940, 941, 942, 944, 945, 937, 0, 0, 0, 0,
+	943, 952, 1682, 0, 0, 0, 0, 0, 0, 1769,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 962,
+	970, 0, 1777, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 968, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 114, 0, 0, 978, 0, 980, 0, 0,
-	0, 1068, 0, 0, 984, 1638, 1639, 1631, 1632, 1633,
-	1634, 1636, 1637, 0, 0, 1827, 0, 0, 0, 0,
-	0, 950, 1067, 0, 1641, 1642, 1643, 0, 0, 1640,
-	1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637, 0, 0,
-	0, 0, 0, 0, 1067, 0, 0, 0, 0, 0,
-	0, 0, 0, 2007, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2081, 0, 1273, 1274, 1275, 1276,
-	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 0, 1300,
-	2099, 1302, 1303, 1304, 1305, 0, 1494, 0, 0, 1126,
-	1892, 0, 125, 0, 1067, 0, 0, 0, 1328, 0,
-	0, 1906, 116, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 0, 0, 0, 1916, 0, 0, 0, 0,
-	129, 1363, 1364, 0, 0, 1377, 0, 1388, 1390, 1395,
-	1398, 1399, 1400, 0, 0, 0, 0, 0, 536, 0,
-	0, 536, 536, 0, 0, 0, 0, 0, 351, 0,
-	0, 632, 2147, 0, 0, 0, 128, 0, 0, 0,
-	0, 0, 1232, 632, 123, 0, 0, 66, 0, 0,
-	0, 124, 0, 0, 0, 0, 0, 1036, 0, 0,
-	0, 0, 1068, 0, 1067, 0, 0, 0, 0, 0,
-	367, 0, 0, 0, 0, 0, 0, 0, 0, 1036,
-	0, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1978, 0, 0,
-	1067, 1067, 0, 0, 0, 0, 0, 0, 0, 1067,
-	1067, 0, 0, 0, 1070, 0, 66, 0, 66, 0,
-	1068, 0, 0, 0, 66, 0, 0, 0, 0, 0,
-	0, 1069, 0, 0, 0, 0, 1070, 1033, 0, 1036,
-	0, 0, 1067, 0, 0, 1629, 1630, 0, 1647, 1648,
-	1649, 0, 0, 1069, 0, 0, 0, 1892, 1068, 1033,
-	0, 1961, 0, 1318, 0, 0, 0, 632, 0, 0,
-	1892, 632, 1434, 0, 1892, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2036, 2037, 1494, 0,
-	0, 0, 0, 0, 0, 2046, 1070, 2047, 1644, 351,
-	2049, 2050, 0, 0, 2053, 351, 879, 0, 0, 931,
-	932, 885, 0, 1069, 0, 961, 962, 963, 0, 1033,
-	0, 0, 2066, 0, 632, 0, 66, 0, 0, 1036,
-	0, 0, 934, 0, 0, 965, 0, 0, 0, 0,
-	0, 0, 0, 0, 1553, 0, 0, 0, 0, 0,
-	0, 0, 1635, 0, 0, 0, 933, 0, 0, 1566,
-	0, 0, 948, 0, 0, 1036, 1036, 0, 0, 2091,
-	0, 0, 2093, 0, 1036, 1036, 1067, 0, 0, 0,
-	351, 0, 1892, 0, 1892, 0, 1070, 0, 0, 1650,
-	0, 0, 0, 0, 0, 0, 1569, 1570, 351, 0,
-	0, 0, 1575, 1069, 0, 0, 0, 1036, 1494, 1033,
-	1645, 0, 0, 0, 0, 0, 939, 0, 0, 0,
-	931, 932, 1070, 1070, 0, 2136, 0, 0, 0, 0,
-	1232, 1070, 1070, 0, 1594, 958, 966, 0, 2143, 1069,
-	1069, 1601, 0, 934, 1605, 1033, 1033, 0, 1069, 1069,
-	0, 0, 0, 0, 1033, 1033, 0, 0, 0, 351,
-	1619, 0, 0, 1126, 1070, 0, 0, 933, 956, 0,
-	66, 0, 0, 0, 949, 0, 0, 0, 0, 0,
-	0, 1069, 0, 1646, 0, 0, 981, 1033, 66, 0,
-	0, 66, 1395, 1395, 1395, 0, 0, 0, 0, 2176,
-	2177, 0, 0, 623, 0, 0, 0, 0, 0, 623,
-	623, 0, 0, 623, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 939, 0, 0,
-	2197, 0, 0, 0, 632, 0, 0, 351, 0, 0,
-	1892, 1036, 0, 549, 0, 0, 0, 950, 0, 2136,
-	0, 0, 351, 0, 0, 0, 0, 0, 0, 0,
-	0, 632, 0, 959, 0, 0, 0, 1067, 1722, 1641,
-	1642, 1643, 0, 1156, 1640, 1638, 1639, 1631, 1632, 1633,
-	1634, 1636, 1637, 0, 0, 949, 0, 0, 0, 0,
-	0, 0, 0, 0, 2250, 0, 1318, 0, 1070, 0,
+	0, 881, 960, 0, 0, 943, 0, 0, 953, 0,
+	889, 0, 0, 0, 1806, 0, 0, 1807, 0, 0,
+	0, 0, 0, 0, 962, 970, 0, 0, 0, 1812,
+	0, 0, 0, 959, 0, 0, 0, 0, 1821, 0,
+	0, 0, 968, 0, 0, 0, 1825, 0, 0, 1577,
+	0, 0, 0, 0, 0, 0, 0, 960, 0, 0,
+	0, 0, 0, 953, 0, 0, 0, 0, 0, 1849,
+	0, 0, 0, 1851, 0, 0, 0, 0, 0, 0,
+	0, 954, 0, 0, 0, 0, 0, 0, 959, 0,
+	0, 0, 0, 0, 0, 0, 0, 963, 0, 0,
+	0, 0, 0, 0, 0, 0, 1882, 1883, 0, 0,
+	0, 0, 0, 0, 0, 1889, 1890, 1891, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1069, 980, 0, 0, 0,
-	0, 1033, 0, 0, 0, 1067, 0, 1763, 0, 0,
-	957, 0, 0, 0, 0, 0, 960, 0, 944, 942,
-	943, 935, 936, 937, 938, 940, 941, 0, 0, 0,
-	0, 1629, 1630, 879, 0, 0, 0, 0, 950, 0,
-	0, 0, 0, 1067, 0, 885, 0, 0, 0, 1791,
-	0, 0, 1792, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 980, 0, 1797, 0, 0, 0, 0, 0,
-	0, 931, 932, 1806, 951, 952, 953, 961, 962, 963,
-	0, 1810, 0, 0, 1566, 0, 0, 954, 0, 0,
-	0, 0, 0, 0, 934, 0, 0, 965, 0, 0,
-	0, 0, 0, 0, 1834, 0, 0, 0, 1836, 0,
-	0, 0, 1036, 0, 0, 0, 0, 0, 933, 0,
-	0, 0, 0, 0, 948, 66, 0, 0, 66, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 1635, 0,
-	0, 1867, 1868, 0, 0, 0, 0, 0, 0, 0,
-	1874, 1875, 1876, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1070,
-	1036, 0, 0, 0, 0, 0, 0, 0, 939, 66,
-	0, 0, 66, 0, 0, 1900, 1069, 0, 0, 0,
-	66, 0, 1033, 0, 0, 0, 1645, 958, 966, 0,
-	0, 0, 0, 0, 623, 0, 1629, 1630, 1036, 1647,
-	1648, 1649, 0, 0, 0, 964, 0, 0, 0, 0,
-	0, 0, 1960, 0, 0, 0, 0, 1070, 0, 0,
-	956, 0, 0, 0, 0, 0, 949, 0, 0, 0,
-	623, 0, 0, 0, 1069, 0, 0, 0, 0, 0,
-	1033, 0, 0, 0, 0, 0, 0, 0, 0, 1644,
-	0, 955, 0, 0, 0, 1070, 0, 931, 932, 1646,
-	951, 952, 953, 961, 962, 963, 1962, 0, 0, 0,
-	1965, 1966, 1069, 954, 0, 1968, 0, 0, 1033, 0,
-	934, 0, 1970, 965, 1972, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 950,
-	1979, 0, 0, 1635, 933, 0, 0, 0, 0, 0,
-	948, 0, 0, 0, 0, 959, 931, 932, 0, 951,
-	952, 953, 961, 962, 963, 0, 0, 0, 0, 0,
-	0, 0, 954, 1988, 0, 0, 0, 0, 0, 934,
-	1650, 0, 965, 0, 0, 0, 0, 0, 0, 0,
-	1640, 1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637, 0,
-	0, 1645, 0, 933, 939, 2020, 0, 0, 0, 948,
-	0, 1629, 1630, 0, 1647, 1648, 1649, 0, 0, 0,
-	0, 0, 957, 958, 966, 945, 946, 947, 960, 0,
-	944, 942, 943, 935, 936, 937, 938, 940, 941, 0,
-	0, 964, 0, 0, 0, 0, 1671, 0, 0, 0,
-	2057, 0, 0, 0, 0, 0, 956, 0, 0, 0,
-	0, 0, 949, 939, 1644, 0, 623, 0, 0, 0,
-	0, 2073, 2074, 0, 1646, 0, 0, 0, 0, 0,
-	0, 0, 958, 966, 0, 0, 0, 955, 0, 0,
-	0, 0, 66, 0, 0, 0, 0, 0, 0, 0,
-	964, 0, 2086, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 956, 0, 0, 1635, 0,
-	0, 949, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 950, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 955, 0, 0, 0,
-	0, 959, 0, 0, 2126, 1650, 0, 0, 0, 0,
-	1641, 1642, 1643, 0, 0, 1640, 1638, 1639, 1631, 1632,
-	1633, 1634, 1636, 1637, 0, 0, 1645, 0, 0, 0,
-	0, 0, 0, 885, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 950, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2159,
-	959, 0, 0, 0, 0, 0, 0, 0, 957, 0,
-	0, 945, 946, 947, 960, 0, 944, 942, 943, 935,
-	936, 937, 938, 940, 941, 0, 0, 0, 0, 0,
-	0, 0, 1670, 0, 0, 0, 0, 0, 0, 1646,
+	0, 0, 0, 0, 0, 0, 954, 0, 0, 935,
+	936, 0, 955, 956, 957, 965, 966, 967, 0, 0,
+	1915, 0, 963, 0, 0, 958, 0, 0, 0, 0,
+	0, 0, 938, 0, 961, 969, 0, 949, 950, 951,
+	964, 0, 948, 946, 947, 939, 940, 941, 942, 944,
+	945, 0, 0, 0, 0, 0, 937, 0, 1681, 0,
+	0, 0, 952, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1640, 1641, 0, 1658, 1659, 1660, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 961,
+	0, 0, 949, 950, 951, 964, 0, 948, 946, 947,
+	939, 940, 941, 942, 944, 945, 0, 0, 0, 0,
+	0, 0, 0, 1680, 0, 1978, 943, 0, 0, 1981,
+	1982, 0, 0, 0, 1984, 0, 1655, 0, 0, 0,
+	0, 1986, 0, 1988, 0, 962, 970, 0, 0, 935,
+	936, 0, 955, 956, 957, 965, 966, 967, 0, 1995,
+	0, 0, 0, 968, 0, 958, 0, 0, 0, 0,
+	0, 0, 938, 0, 0, 969, 0, 0, 960, 0,
+	0, 0, 0, 0, 953, 0, 0, 0, 0, 0,
+	1646, 0, 2004, 0, 0, 0, 937, 0, 0, 0,
+	935, 936, 952, 955, 956, 957, 965, 966, 967, 959,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2071, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 957, 0, 0,
-	945, 946, 947, 960, 0, 944, 942, 943, 935, 936,
-	937, 938, 940, 941, 0, 0, 0, 2222, 2222, 0,
-	0, 1669, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2238,
+	0, 0, 0, 938, 2036, 0, 969, 1661, 1640, 1641,
+	0, 1658, 1659, 1660, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 937, 1656, 0,
+	0, 0, 0, 952, 0, 0, 943, 954, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2073,
+	0, 0, 0, 963, 0, 962, 970, 0, 0, 0,
+	0, 1655, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2090, 2091, 968, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 943, 960, 0,
+	0, 0, 0, 0, 953, 0, 0, 0, 0, 0,
+	0, 1657, 2103, 0, 0, 0, 962, 970, 0, 0,
+	0, 0, 0, 0, 0, 1646, 0, 0, 0, 959,
+	961, 0, 0, 949, 950, 951, 964, 0, 948, 946,
+	947, 939, 940, 941, 942, 944, 945, 0, 0, 960,
+	0, 0, 0, 0, 1632, 953, 0, 0, 0, 0,
+	0, 0, 0, 0, 2143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 954, 0, 0,
+	0, 0, 0, 1656, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 963, 889, 0, 0, 1652, 1653, 1654,
+	0, 0, 1651, 1649, 1650, 1642, 1643, 1644, 1645, 1647,
+	1648, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2176, 0, 0, 0, 0, 0, 0, 0, 954, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 963, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1657, 0, 0, 0,
+	961, 0, 0, 949, 950, 951, 964, 0, 948, 946,
+	947, 939, 940, 941, 942, 944, 945, 0, 0, 0,
+	0, 2211, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2222, 0, 0, 0, 66, 1641, 1642, 1643, 0, 0,
-	1640, 1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2239, 2239,
+	0, 961, 0, 0, 949, 950, 951, 964, 0, 948,
+	946, 947, 939, 940, 941, 942, 944, 945, 0, 0,
+	2255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2239, 1652, 1653, 1654, 0, 0, 1651, 1649, 1650,
+	1642, 1643, 1644, 1645, 1647, 1648, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2239, 141, 142, 412, 143, 413, 414, 415, 416,
+	294, 417, 418, 419, 420, 144, 145, 146, 295, 296,
+	297, 298, 147, 299, 300, 421, 148, 301, 302, 149,
+	150, 422, 423, 303, 304, 305, 424, 151, 306, 425,
+	398, 426, 152, 153, 154, 0, 155, 427, 156, 157,
+	158, 428, 399, 159, 160, 429, 430, 432, 431, 433,
+	434, 435, 161, 162, 352, 163, 307, 164, 308, 309,
+	436, 165, 437, 166, 438, 167, 439, 440, 168, 169,
+	441, 170, 442, 0, 443, 310, 171, 172, 173, 311,
+	312, 444, 445, 446, 174, 175, 313, 314, 315, 0,
+	176, 447, 177, 448, 449, 400, 450, 178, 316, 451,
+	317, 452, 179, 180, 181, 182, 318, 319, 402, 453,
+	186, 454, 183, 455, 401, 184, 320, 185, 321, 322,
+	323, 324, 325, 456, 326, 457, 403, 187, 188, 189,
+	404, 190, 191, 192, 458, 194, 193, 459, 327, 405,
+	195, 406, 460, 196, 461, 462, 197, 0, 198, 199,
+	200, 202, 328, 201, 407, 203, 204, 206, 205, 463,
+	464, 465, 329, 207, 330, 208, 209, 466, 210, 467,
+	468, 211, 469, 470, 212, 331, 408, 213, 409, 332,
+	214, 215, 216, 217, 218, 471, 219, 333, 220, 334,
+	221, 472, 222, 223, 224, 225, 226, 335, 227, 228,
+	473, 229, 230, 231, 232, 233, 235, 236, 234, 237,
+	238, 239, 240, 474, 241, 410, 242, 243, 336, 244,
+	0, 248, 249, 250, 251, 475, 253, 337, 252, 254,
+	255, 476, 256, 245, 246, 257, 411, 258, 338, 339,
+	259, 477, 265, 260, 261, 247, 262, 264, 340, 263,
+	341, 478, 266, 479, 267, 268, 269, 270, 271, 272,
+	273, 480, 342, 343, 344, 481, 482, 274, 275, 345,
+	346, 483, 276, 277, 278, 279, 484, 485, 280, 281,
+	282, 283, 486, 284, 487, 347, 285, 286, 287, 348,
+	349, 488, 489, 288, 490, 491, 492, 493, 289, 290,
+	291, 292, 293, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	2222, 0, 0, 0, 0, 0, 0, 0, 0, 980,
+	0, 1233, 0, 0, 0, 0, 0, 0, 0, 1234,
 	141, 142, 412, 143, 413, 414, 415, 416, 294, 417,
 	418, 419, 420, 144, 145, 146, 295, 296, 297, 298,
 	147, 299, 300, 421, 148, 301, 302, 149, 150, 422,
@@ -2020,1051 +2128,1081 @@ var sqlAct = [...]int{
 	276, 277, 278, 279, 484, 485, 280, 281, 282, 283,
 	486, 284, 487, 347, 285, 286, 287, 348, 349, 488,
 	489, 288, 490, 491, 492, 493, 289, 290, 291, 292,
-	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 1226,
-	0, 0, 0, 0, 0, 0, 0, 1227, 141, 142,
-	412, 143, 413, 414, 415, 416, 294, 417, 418, 419,
-	420, 144, 145, 146, 295, 296, 297, 298, 147, 299,
-	300, 421, 148, 301, 302, 149, 150, 422, 423, 303,
-	304, 305, 424, 151, 306, 425, 398, 426, 152, 153,
-	154, 0, 155, 427, 156, 157, 158, 428, 399, 159,
-	160, 429, 430, 432, 431, 433, 434, 435, 161, 162,
-	352, 163, 307, 164, 308, 309, 436, 165, 437, 166,
-	438, 167, 439, 440, 168, 169, 441, 170, 442, 0,
-	443, 310, 171, 172, 173, 311, 312, 444, 445, 446,
-	174, 175, 313, 314, 315, 0, 176, 447, 177, 448,
-	449, 400, 450, 178, 316, 451, 317, 452, 179, 180,
-	181, 182, 318, 319, 402, 453, 186, 454, 183, 455,
-	401, 184, 320, 185, 321, 322, 323, 324, 325, 456,
-	326, 457, 403, 187, 188, 189, 404, 190, 191, 192,
-	458, 194, 193, 459, 327, 405, 195, 406, 460, 196,
-	461, 462, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 463, 464, 465, 329, 207,
-	330, 208, 209, 466, 210, 467, 468, 211, 469, 470,
-	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
-	218, 471, 219, 333, 220, 334, 221, 472, 222, 223,
-	224, 225, 226, 335, 227, 228, 473, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 474,
-	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
-	251, 475, 253, 337, 252, 254, 255, 476, 256, 245,
-	246, 257, 411, 258, 338, 339, 259, 477, 265, 260,
-	261, 247, 262, 264, 340, 263, 341, 478, 266, 479,
-	267, 268, 269, 270, 271, 272, 273, 480, 342, 343,
-	344, 481, 482, 274, 275, 345, 346, 483, 276, 277,
-	278, 279, 484, 485, 280, 281, 282, 283, 486, 284,
-	487, 347, 285, 286, 287, 348, 349, 488, 489, 288,
-	490, 491, 492, 493, 289, 290, 291, 292, 293, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1948, 141, 142,
-	412, 143, 413, 414, 415, 416, 294, 417, 418, 419,
-	420, 144, 145, 146, 295, 296, 297, 298, 147, 299,
-	300, 421, 148, 301, 302, 149, 150, 422, 423, 303,
-	304, 305, 424, 151, 306, 425, 398, 426, 152, 153,
-	154, 0, 155, 427, 156, 157, 158, 428, 399, 159,
-	160, 429, 430, 432, 431, 433, 434, 435, 161, 162,
-	352, 163, 307, 164, 308, 309, 436, 165, 437, 166,
-	438, 167, 439, 440, 168, 169, 441, 170, 442, 0,
-	443, 310, 171, 172, 173, 311, 312, 444, 445, 446,
-	174, 175, 313, 314, 315, 0, 176, 447, 177, 448,
-	449, 400, 450, 178, 316, 451, 317, 452, 179, 180,
-	181, 182, 318, 319, 402, 453, 186, 454, 183, 455,
-	401, 184, 320, 185, 321, 322, 323, 324, 325, 456,
-	326, 457, 403, 187, 188, 189, 404, 190, 191, 192,
-	458, 194, 193, 459, 327, 405, 195, 406, 460, 196,
-	461, 462, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 463, 464, 465, 329, 207,
-	330, 208, 209, 466, 210, 467, 468, 211, 469, 470,
-	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
-	218, 471, 219, 333, 220, 334, 221, 472, 222, 223,
-	224, 225, 226, 335, 227, 228, 473, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 474,
-	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
-	251, 475, 253, 337, 252, 254, 255, 476, 256, 245,
-	246, 257, 411, 258, 338, 339, 259, 477, 265, 260,
-	261, 247, 262, 264, 340, 263, 341, 478, 266, 479,
-	267, 268, 269, 270, 271, 272, 273, 480, 342, 343,
-	344, 481, 482, 274, 275, 345, 346, 483, 276, 277,
-	278, 279, 484, 485, 280, 281, 282, 283, 486, 284,
-	487, 347, 285, 286, 287, 348, 349, 488, 489, 288,
-	490, 491, 492, 493, 289, 290, 291, 292, 293, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 912, 0, 0, 141, 142, 412, 143, 413,
-	414, 415, 416, 294, 417, 418, 419, 420, 144, 145,
-	146, 295, 296, 297, 298, 147, 299, 300, 421, 148,
-	301, 302, 149, 150, 422, 423, 303, 304, 305, 424,
-	151, 306, 425, 398, 426, 152, 153, 154, 0, 155,
-	427, 156, 157, 158, 428, 399, 159, 160, 429, 430,
-	432, 431, 433, 434, 435, 161, 162, 352, 163, 307,
-	164, 308, 309, 436, 165, 437, 166, 438, 167, 439,
-	440, 168, 169, 441, 170, 442, 0, 443, 310, 171,
-	172, 173, 311, 312, 444, 445, 446, 174, 175, 313,
-	314, 315, 0, 176, 447, 177, 448, 449, 400, 450,
-	178, 316, 451, 317, 452, 179, 180, 181, 182, 318,
-	319, 402, 453, 186, 454, 183, 455, 401, 184, 320,
-	185, 321, 322, 323, 324, 325, 456, 326, 457, 403,
-	187, 188, 189, 404, 190, 191, 192, 458, 194, 193,
-	459, 327, 405, 195, 406, 460, 196, 461, 462, 197,
-	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
-	206, 205, 463, 464, 465, 329, 207, 330, 208, 209,
-	466, 210, 467, 468, 211, 469, 470, 212, 331, 408,
-	213, 409, 332, 214, 215, 216, 217, 218, 471, 219,
-	333, 220, 334, 221, 472, 222, 223, 224, 225, 226,
-	335, 227, 228, 473, 229, 230, 231, 232, 233, 235,
-	236, 234, 237, 238, 239, 240, 474, 241, 410, 242,
-	243, 336, 244, 0, 248, 249, 250, 251, 475, 253,
-	337, 252, 254, 255, 476, 256, 245, 246, 257, 411,
-	258, 338, 339, 259, 477, 265, 260, 261, 247, 262,
-	264, 340, 263, 341, 478, 266, 479, 267, 268, 269,
-	270, 271, 272, 273, 480, 342, 343, 344, 481, 482,
-	274, 275, 345, 346, 483, 276, 277, 278, 279, 484,
-	485, 280, 281, 282, 283, 486, 284, 487, 347, 285,
-	286, 287, 348, 349, 488, 489, 288, 490, 491, 492,
-	493, 289, 290, 291, 292, 293, 695, 684, 685, 682,
-	683, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 141, 142, 0, 143, 0, 0, 0, 0,
-	712, 677, 0, 0, 0, 144, 145, 146, 295, 727,
-	297, 728, 147, 729, 730, 0, 148, 301, 302, 149,
-	150, 680, 711, 731, 732, 305, 0, 151, 723, 0,
-	703, 0, 152, 153, 154, 0, 155, 0, 156, 157,
-	158, 0, 399, 159, 160, 0, 704, 705, 707, 0,
-	706, 708, 161, 162, 352, 163, 733, 164, 734, 735,
-	886, 165, 0, 166, 0, 167, 0, 0, 726, 169,
-	0, 170, 0, 0, 0, 668, 171, 172, 173, 713,
-	714, 691, 0, 0, 174, 175, 736, 737, 738, 0,
-	176, 0, 177, 0, 0, 400, 0, 178, 724, 0,
-	317, 0, 179, 180, 181, 182, 720, 722, 402, 0,
-	186, 0, 183, 0, 401, 184, 739, 185, 740, 741,
-	742, 743, 744, 0, 702, 0, 403, 187, 188, 189,
-	404, 190, 191, 192, 0, 194, 193, 0, 725, 405,
-	195, 406, 0, 196, 0, 0, 197, 0, 198, 199,
-	200, 202, 328, 201, 407, 203, 204, 206, 205, 663,
-	0, 692, 721, 207, 745, 208, 209, 0, 210, 0,
-	0, 211, 0, 0, 212, 331, 408, 213, 409, 715,
-	214, 215, 216, 217, 218, 0, 219, 716, 220, 334,
-	221, 0, 222, 223, 224, 225, 226, 746, 227, 228,
-	0, 229, 230, 231, 232, 233, 235, 236, 234, 237,
-	238, 239, 240, 0, 241, 410, 242, 243, 669, 244,
-	0, 248, 249, 250, 251, 125, 253, 337, 252, 254,
-	255, 709, 256, 245, 246, 257, 411, 258, 747, 339,
-	259, 0, 265, 260, 261, 247, 262, 264, 748, 263,
-	717, 0, 266, 129, 267, 268, 269, 270, 271, 272,
-	273, 0, 342, 749, 750, 0, 0, 274, 275, 718,
-	719, 690, 276, 277, 278, 279, 0, 0, 280, 281,
-	282, 283, 710, 284, 0, 347, 285, 286, 287, 655,
-	751, 0, 0, 288, 0, 0, 0, 123, 289, 290,
-	291, 292, 293, 664, 124, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 660, 661, 695, 684, 685, 682,
-	683, 674, 0, 670, 0, 0, 0, 0, 673, 0,
-	0, 0, 141, 142, 1344, 143, 0, 0, 0, 0,
-	712, 677, 0, 0, 0, 144, 145, 146, 295, 727,
-	297, 728, 147, 729, 730, 0, 148, 301, 302, 149,
-	150, 680, 711, 731, 732, 305, 0, 151, 723, 0,
-	703, 0, 152, 153, 154, 0, 155, 0, 156, 157,
-	158, 0, 399, 159, 160, 0, 704, 705, 707, 0,
-	706, 708, 161, 162, 352, 163, 733, 164, 734, 735,
-	0, 165, 0, 166, 0, 167, 1345, 0, 726, 169,
-	0, 170, 0, 0, 0, 668, 171, 172, 173, 713,
-	714, 691, 0, 0, 174, 175, 736, 737, 738, 0,
-	176, 0, 177, 0, 0, 400, 0, 178, 724, 0,
-	317, 0, 179, 180, 181, 182, 720, 722, 402, 0,
-	186, 0, 183, 0, 401, 184, 739, 185, 740, 741,
-	742, 743, 744, 0, 702, 0, 403, 187, 188, 189,
-	404, 190, 191, 192, 0, 194, 193, 0, 725, 405,
-	195, 406, 0, 196, 0, 0, 197, 0, 198, 199,
-	200, 202, 328, 201, 407, 203, 204, 206, 205, 663,
-	0, 692, 721, 207, 745, 208, 209, 0, 210, 0,
-	0, 211, 0, 0, 212, 331, 408, 213, 409, 715,
-	214, 215, 216, 217, 218, 0, 219, 716, 220, 334,
-	221, 0, 222, 223, 224, 225, 226, 746, 227, 228,
-	0, 229, 230, 231, 232, 233, 235, 236, 234, 237,
-	238, 239, 240, 0, 241, 410, 242, 243, 669, 244,
-	0, 248, 249, 250, 251, 0, 253, 337, 252, 254,
-	255, 709, 256, 245, 246, 257, 411, 258, 747, 339,
-	259, 0, 265, 260, 261, 247, 262, 264, 748, 263,
-	717, 0, 266, 0, 267, 268, 269, 270, 271, 272,
-	273, 0, 342, 749, 750, 0, 0, 274, 275, 718,
-	719, 690, 276, 277, 278, 279, 0, 0, 280, 281,
-	282, 283, 710, 284, 0, 347, 285, 286, 287, 348,
-	751, 1343, 0, 288, 0, 0, 0, 0, 289, 290,
-	291, 292, 293, 664, 0, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 660, 661, 1346, 695, 684, 685,
-	682, 683, 674, 670, 1341, 0, 0, 0, 673, 0,
-	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
-	0, 712, 677, 0, 0, 0, 144, 145, 146, 295,
-	727, 297, 728, 147, 729, 730, 0, 148, 301, 302,
-	149, 150, 680, 711, 731, 732, 305, 0, 151, 723,
-	0, 703, 0, 152, 153, 154, 0, 155, 0, 156,
-	157, 158, 0, 399, 159, 160, 0, 704, 705, 707,
-	0, 706, 708, 161, 162, 352, 163, 733, 164, 734,
-	735, 0, 165, 0, 166, 0, 167, 0, 0, 726,
-	169, 0, 170, 0, 0, 0, 668, 171, 172, 173,
-	713, 714, 691, 0, 0, 174, 175, 736, 737, 738,
-	0, 176, 0, 177, 0, 0, 400, 0, 178, 724,
-	0, 317, 0, 179, 180, 181, 182, 720, 722, 402,
-	0, 186, 0, 183, 0, 401, 184, 739, 185, 740,
-	741, 742, 743, 744, 0, 702, 0, 403, 187, 188,
-	189, 404, 190, 191, 192, 0, 194, 193, 0, 725,
-	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
-	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
-	663, 0, 692, 721, 207, 745, 208, 209, 0, 210,
-	0, 0, 211, 0, 0, 212, 331, 408, 213, 409,
-	715, 214, 215, 216, 217, 218, 0, 219, 716, 220,
-	334, 221, 0, 222, 223, 224, 225, 226, 746, 227,
-	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
-	237, 238, 239, 240, 0, 241, 410, 242, 243, 669,
-	244, 0, 248, 249, 250, 251, 125, 253, 337, 252,
-	254, 255, 709, 256, 245, 246, 257, 411, 258, 747,
-	339, 259, 0, 265, 260, 261, 247, 262, 264, 748,
-	263, 717, 0, 266, 129, 267, 268, 269, 270, 271,
-	272, 273, 0, 342, 749, 750, 0, 0, 274, 275,
-	718, 719, 690, 276, 277, 278, 279, 0, 0, 280,
-	281, 282, 283, 710, 284, 0, 347, 285, 286, 287,
-	655, 751, 0, 0, 288, 0, 0, 0, 123, 289,
-	290, 291, 292, 293, 664, 124, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 660, 661, 695, 684, 685,
-	682, 683, 674, 0, 670, 0, 0, 0, 0, 673,
-	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
-	0, 712, 677, 0, 0, 0, 144, 145, 146, 295,
-	727, 297, 728, 147, 729, 730, 1391, 148, 301, 302,
-	149, 150, 680, 711, 731, 732, 305, 0, 151, 723,
-	0, 703, 0, 152, 153, 154, 0, 155, 0, 156,
-	157, 158, 0, 399, 159, 160, 0, 704, 705, 707,
-	0, 706, 708, 161, 162, 352, 163, 733, 164, 734,
-	735, 0, 165, 0, 166, 0, 167, 0, 0, 726,
-	169, 0, 170, 0, 0, 0, 668, 171, 172, 173,
-	713, 714, 691, 0, 0, 174, 175, 736, 737, 738,
-	0, 176, 0, 177, 0, 1396, 400, 0, 178, 724,
-	0, 317, 0, 179, 180, 181, 182, 720, 722, 402,
-	0, 186, 0, 183, 0, 401, 184, 739, 185, 740,
-	741, 742, 743, 744, 0, 702, 0, 403, 187, 188,
-	189, 404, 190, 191, 192, 0, 194, 193, 1392, 725,
-	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
-	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
-	663, 0, 692, 721, 207, 745, 208, 209, 0, 210,
-	0, 0, 211, 0, 0, 212, 331, 408, 213, 409,
-	715, 214, 215, 216, 217, 218, 0, 219, 716, 220,
-	334, 221, 0, 222, 223, 224, 225, 226, 746, 227,
-	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
-	237, 238, 239, 240, 0, 241, 410, 242, 243, 669,
-	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
-	254, 255, 709, 256, 245, 246, 257, 411, 258, 747,
-	339, 259, 0, 265, 260, 261, 247, 262, 264, 748,
-	263, 717, 0, 266, 0, 267, 268, 269, 270, 271,
-	272, 273, 0, 342, 749, 750, 0, 1393, 274, 275,
-	718, 719, 690, 276, 277, 278, 279, 0, 0, 280,
-	281, 282, 283, 710, 284, 0, 347, 285, 286, 287,
-	348, 751, 0, 0, 288, 0, 0, 0, 0, 289,
-	290, 291, 292, 293, 664, 0, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 660, 661, 695, 684, 685,
-	682, 683, 674, 0, 670, 0, 0, 0, 0, 673,
-	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
-	0, 712, 677, 0, 0, 0, 144, 145, 146, 295,
-	727, 297, 728, 147, 729, 730, 0, 148, 301, 302,
-	149, 150, 680, 711, 731, 732, 305, 0, 151, 723,
-	0, 703, 0, 152, 153, 154, 0, 155, 0, 156,
-	157, 158, 0, 399, 159, 160, 0, 704, 705, 707,
-	0, 706, 708, 161, 162, 352, 163, 733, 164, 734,
-	735, 0, 165, 0, 166, 0, 167, 0, 0, 726,
-	169, 0, 170, 0, 0, 0, 668, 171, 172, 173,
-	713, 714, 691, 0, 0, 174, 175, 736, 737, 738,
-	0, 176, 0, 177, 0, 0, 400, 0, 178, 724,
-	0, 317, 0, 179, 180, 181, 182, 720, 722, 402,
-	0, 186, 0, 183, 0, 401, 184, 739, 185, 740,
-	741, 742, 743, 744, 0, 702, 0, 403, 187, 188,
-	189, 404, 190, 191, 192, 0, 194, 193, 0, 725,
-	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
-	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
-	663, 1782, 692, 721, 207, 745, 208, 209, 0, 210,
-	0, 0, 211, 0, 0, 212, 331, 408, 213, 409,
-	715, 214, 215, 216, 217, 218, 0, 219, 716, 220,
-	334, 221, 0, 222, 223, 224, 225, 226, 746, 227,
-	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
-	237, 238, 239, 240, 0, 241, 410, 242, 243, 669,
-	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
-	254, 255, 709, 256, 245, 246, 257, 411, 258, 747,
-	339, 259, 0, 265, 260, 261, 247, 262, 264, 748,
-	263, 717, 0, 266, 0, 267, 268, 269, 270, 271,
-	272, 273, 0, 342, 749, 750, 0, 0, 274, 275,
-	718, 719, 690, 276, 277, 278, 279, 0, 0, 280,
-	281, 282, 283, 710, 284, 0, 347, 285, 286, 287,
-	348, 751, 0, 0, 288, 0, 0, 0, 0, 289,
-	290, 291, 292, 293, 664, 0, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 660, 661, 880, 695, 684,
-	685, 682, 683, 674, 670, 0, 0, 0, 0, 673,
+	293, 0, 0, 0, 393, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1964,
+	141, 142, 412, 143, 413, 414, 415, 416, 294, 417,
+	418, 419, 420, 144, 145, 146, 295, 296, 297, 298,
+	147, 299, 300, 421, 148, 301, 302, 149, 150, 422,
+	423, 303, 304, 305, 424, 151, 306, 425, 398, 426,
+	152, 153, 154, 0, 155, 427, 156, 157, 158, 428,
+	399, 159, 160, 429, 430, 432, 431, 433, 434, 435,
+	161, 162, 352, 163, 307, 164, 308, 309, 436, 165,
+	437, 166, 438, 167, 439, 440, 168, 169, 441, 170,
+	442, 0, 443, 310, 171, 172, 173, 311, 312, 444,
+	445, 446, 174, 175, 313, 314, 315, 0, 176, 447,
+	177, 448, 449, 400, 450, 178, 316, 451, 317, 452,
+	179, 180, 181, 182, 318, 319, 402, 453, 186, 454,
+	183, 455, 401, 184, 320, 185, 321, 322, 323, 324,
+	325, 456, 326, 457, 403, 187, 188, 189, 404, 190,
+	191, 192, 458, 194, 193, 459, 327, 405, 195, 406,
+	460, 196, 461, 462, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 463, 464, 465,
+	329, 207, 330, 208, 209, 466, 210, 467, 468, 211,
+	469, 470, 212, 331, 408, 213, 409, 332, 214, 215,
+	216, 217, 218, 471, 219, 333, 220, 334, 221, 472,
+	222, 223, 224, 225, 226, 335, 227, 228, 473, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 474, 241, 410, 242, 243, 336, 244, 0, 248,
+	249, 250, 251, 475, 253, 337, 252, 254, 255, 476,
+	256, 245, 246, 257, 411, 258, 338, 339, 259, 477,
+	265, 260, 261, 247, 262, 264, 340, 263, 341, 478,
+	266, 479, 267, 268, 269, 270, 271, 272, 273, 480,
+	342, 343, 344, 481, 482, 274, 275, 345, 346, 483,
+	276, 277, 278, 279, 484, 485, 280, 281, 282, 283,
+	486, 284, 487, 347, 285, 286, 287, 348, 349, 488,
+	489, 288, 490, 491, 492, 493, 289, 290, 291, 292,
+	293, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 916, 0, 0, 141, 142, 412,
+	143, 413, 414, 415, 416, 294, 417, 418, 419, 420,
+	144, 145, 146, 295, 296, 297, 298, 147, 299, 300,
+	421, 148, 301, 302, 149, 150, 422, 423, 303, 304,
+	305, 424, 151, 306, 425, 398, 426, 152, 153, 154,
+	0, 155, 427, 156, 157, 158, 428, 399, 159, 160,
+	429, 430, 432, 431, 433, 434, 435, 161, 162, 352,
+	163, 307, 164, 308, 309, 436, 165, 437, 166, 438,
+	167, 439, 440, 168, 169, 441, 170, 442, 0, 443,
+	310, 171, 172, 173, 311, 312, 444, 445, 446, 174,
+	175, 313, 314, 315, 0, 176, 447, 177, 448, 449,
+	400, 450, 178, 316, 451, 317, 452, 179, 180, 181,
+	182, 318, 319, 402, 453, 186, 454, 183, 455, 401,
+	184, 320, 185, 321, 322, 323, 324, 325, 456, 326,
+	457, 403, 187, 188, 189, 404, 190, 191, 192, 458,
+	194, 193, 459, 327, 405, 195, 406, 460, 196, 461,
+	462, 197, 0, 198, 199, 200, 202, 328, 201, 407,
+	203, 204, 206, 205, 463, 464, 465, 329, 207, 330,
+	208, 209, 466, 210, 467, 468, 211, 469, 470, 212,
+	331, 408, 213, 409, 332, 214, 215, 216, 217, 218,
+	471, 219, 333, 220, 334, 221, 472, 222, 223, 224,
+	225, 226, 335, 227, 228, 473, 229, 230, 231, 232,
+	233, 235, 236, 234, 237, 238, 239, 240, 474, 241,
+	410, 242, 243, 336, 244, 0, 248, 249, 250, 251,
+	475, 253, 337, 252, 254, 255, 476, 256, 245, 246,
+	257, 411, 258, 338, 339, 259, 477, 265, 260, 261,
+	247, 262, 264, 340, 263, 341, 478, 266, 479, 267,
+	268, 269, 270, 271, 272, 273, 480, 342, 343, 344,
+	481, 482, 274, 275, 345, 346, 483, 276, 277, 278,
+	279, 484, 485, 280, 281, 282, 283, 486, 284, 487,
+	347, 285, 286, 287, 348, 349, 488, 489, 288, 490,
+	491, 492, 493, 289, 290, 291, 292, 293, 696, 685,
+	686, 683, 684, 675, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 141, 142, 0, 143, 0, 0,
-	0, 0, 712, 677, 0, 0, 0, 144, 145, 146,
-	295, 727, 297, 728, 147, 729, 730, 0, 148, 301,
-	302, 149, 150, 680, 711, 731, 732, 305, 0, 151,
-	723, 0, 703, 0, 152, 153, 154, 0, 155, 0,
-	156, 157, 158, 0, 399, 159, 160, 0, 704, 705,
-	707, 0, 706, 708, 161, 162, 352, 163, 733, 164,
-	734, 735, 0, 165, 0, 166, 0, 167, 0, 0,
-	726, 169, 0, 170, 0, 0, 0, 668, 171, 172,
-	173, 713, 714, 691, 0, 0, 174, 175, 736, 737,
-	738, 0, 176, 0, 177, 0, 0, 400, 0, 178,
-	724, 0, 317, 0, 179, 180, 181, 182, 720, 722,
-	402, 0, 186, 1158, 183, 0, 401, 184, 739, 185,
-	740, 741, 742, 743, 744, 0, 702, 0, 403, 187,
-	188, 189, 404, 190, 191, 192, 0, 194, 193, 0,
-	725, 405, 195, 406, 0, 196, 0, 0, 197, 0,
-	198, 199, 200, 202, 328, 201, 407, 203, 204, 206,
-	205, 663, 0, 692, 721, 207, 745, 208, 209, 0,
-	210, 0, 0, 211, 0, 0, 212, 331, 408, 213,
-	409, 715, 214, 215, 216, 217, 218, 0, 219, 716,
-	220, 334, 221, 1157, 222, 223, 224, 225, 226, 746,
-	227, 228, 0, 229, 230, 231, 232, 233, 235, 236,
-	234, 237, 238, 239, 240, 0, 241, 410, 242, 243,
-	669, 244, 0, 248, 249, 250, 251, 0, 253, 337,
-	252, 254, 255, 709, 256, 245, 246, 257, 411, 258,
-	747, 339, 259, 0, 265, 260, 261, 247, 262, 264,
-	748, 263, 717, 0, 266, 0, 267, 268, 269, 270,
-	271, 272, 273, 0, 342, 749, 750, 0, 0, 274,
-	275, 718, 719, 690, 276, 277, 278, 279, 0, 0,
-	280, 281, 282, 283, 710, 284, 0, 347, 285, 286,
-	287, 348, 751, 0, 0, 288, 0, 0, 0, 0,
-	289, 290, 291, 292, 293, 664, 0, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 660, 661, 695, 684,
-	685, 682, 683, 674, 0, 670, 0, 0, 0, 0,
-	673, 0, 0, 0, 141, 142, 0, 143, 0, 0,
-	0, 0, 712, 677, 0, 0, 0, 144, 145, 146,
-	295, 727, 297, 728, 147, 729, 730, 0, 148, 301,
-	302, 149, 150, 680, 711, 731, 732, 305, 0, 151,
-	723, 0, 703, 0, 152, 153, 154, 0, 155, 0,
-	156, 157, 158, 0, 399, 159, 160, 0, 704, 705,
-	707, 0, 706, 708, 161, 162, 352, 163, 733, 164,
-	734, 735, 0, 165, 0, 166, 0, 167, 0, 0,
-	726, 169, 0, 170, 0, 0, 0, 668, 171, 172,
-	173, 713, 714, 691, 0, 0, 174, 175, 736, 737,
-	738, 0, 176, 0, 177, 0, 0, 400, 0, 178,
-	724, 0, 317, 0, 179, 180, 181, 182, 720, 722,
-	402, 0, 186, 0, 183, 0, 401, 184, 739, 185,
-	740, 741, 742, 743, 744, 0, 702, 0, 403, 187,
+	0, 0, 714, 678, 0, 0, 0, 144, 145, 146,
+	295, 729, 297, 730, 147, 731, 732, 0, 148, 301,
+	302, 149, 150, 681, 713, 733, 734, 305, 0, 151,
+	725, 0, 704, 0, 152, 153, 154, 0, 155, 0,
+	156, 157, 158, 0, 399, 159, 160, 0, 705, 706,
+	709, 0, 707, 710, 161, 162, 352, 163, 735, 164,
+	736, 737, 890, 165, 0, 166, 0, 167, 0, 0,
+	728, 169, 0, 170, 0, 0, 0, 669, 171, 172,
+	173, 715, 716, 692, 0, 0, 174, 175, 738, 739,
+	740, 0, 176, 0, 177, 0, 0, 400, 0, 178,
+	726, 0, 317, 0, 179, 180, 181, 182, 722, 724,
+	402, 0, 186, 0, 183, 0, 401, 184, 741, 185,
+	742, 743, 744, 745, 746, 0, 703, 0, 403, 187,
 	188, 189, 404, 190, 191, 192, 0, 194, 193, 0,
-	725, 405, 195, 406, 0, 196, 0, 0, 197, 0,
+	727, 405, 195, 406, 0, 196, 0, 708, 197, 0,
 	198, 199, 200, 202, 328, 201, 407, 203, 204, 206,
-	205, 663, 0, 692, 721, 207, 745, 208, 209, 0,
+	205, 664, 0, 693, 723, 207, 747, 208, 209, 0,
 	210, 0, 0, 211, 0, 0, 212, 331, 408, 213,
-	409, 715, 214, 215, 216, 217, 218, 0, 219, 716,
-	220, 334, 221, 0, 222, 223, 224, 225, 226, 746,
+	409, 717, 214, 215, 216, 217, 218, 0, 219, 718,
+	220, 334, 221, 0, 222, 223, 224, 225, 226, 748,
 	227, 228, 0, 229, 230, 231, 232, 233, 235, 236,
 	234, 237, 238, 239, 240, 0, 241, 410, 242, 243,
-	669, 244, 0, 248, 249, 250, 251, 0, 253, 337,
-	252, 254, 255, 709, 256, 245, 246, 257, 411, 258,
-	747, 339, 259, 0, 265, 260, 261, 247, 262, 264,
-	748, 263, 717, 0, 266, 0, 267, 268, 269, 270,
-	271, 272, 273, 0, 342, 749, 750, 0, 0, 274,
-	275, 718, 719, 690, 276, 277, 278, 279, 0, 0,
-	280, 281, 282, 283, 710, 284, 0, 347, 285, 286,
-	287, 348, 751, 0, 0, 288, 0, 0, 0, 0,
-	289, 290, 291, 292, 293, 664, 0, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 660, 661, 0, 0,
-	0, 0, 0, 986, 1336, 670, 0, 0, 0, 0,
-	673, 695, 684, 685, 682, 683, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 695, 684, 685, 682, 683, 674, 0, 670, 1864,
-	0, 0, 0, 673, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 1813, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 695, 684, 685, 682, 683, 674, 0, 670, 0,
-	0, 0, 0, 673, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 695, 684, 685, 682, 683, 674, 0, 670, 1803,
-	0, 0, 0, 673, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 886, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 695, 684, 685, 682, 683, 674, 0, 670, 0,
-	0, 0, 0, 673, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 1396,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 695, 684, 685, 682, 683, 674, 0, 670, 0,
-	0, 0, 0, 673, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 712, 677, 0, 0, 0,
-	144, 145, 146, 295, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 160,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 402, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 403, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 406, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 411, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 278,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 664, 0,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 660,
-	661, 880, 695, 684, 685, 682, 683, 674, 670, 0,
-	0, 0, 0, 673, 0, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 695, 684, 685, 682, 683, 674, 0, 670,
-	1331, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	1145, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 695, 684, 685, 682, 683, 674, 0, 670,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	2221, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	2220, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 695, 684, 685, 682, 683, 674, 0, 670,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 695, 684, 685, 682, 683, 674, 0, 670,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 2219, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	2221, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	2220, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 1368, 684, 685, 682, 683, 674, 0, 670,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 1371, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	1372, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 1373, 1370, 202, 328, 201,
-	407, 203, 204, 206, 205, 663, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 669, 244, 0, 248, 249, 250,
-	1374, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 1369, 293, 664,
-	0, 0, 0, 0, 0, 662, 0, 0, 0, 0,
-	660, 661, 695, 684, 685, 682, 683, 674, 0, 670,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 680, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 668, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 0, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 1386, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
-	0, 0, 0, 0, 0, 1384, 0, 0, 0, 0,
-	1382, 1383, 695, 684, 685, 682, 683, 674, 0, 1385,
-	0, 0, 0, 0, 673, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 712, 677, 0, 0,
-	0, 144, 145, 146, 295, 727, 297, 728, 147, 729,
-	730, 0, 148, 301, 302, 149, 150, 0, 711, 731,
-	732, 305, 0, 151, 723, 0, 703, 0, 152, 153,
-	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
-	160, 0, 704, 705, 707, 0, 706, 708, 161, 162,
-	352, 163, 733, 164, 734, 735, 0, 165, 0, 166,
-	0, 167, 0, 0, 726, 169, 0, 170, 0, 0,
-	0, 310, 171, 172, 173, 713, 714, 691, 0, 0,
-	174, 175, 736, 737, 738, 0, 176, 0, 177, 0,
-	0, 400, 0, 178, 724, 0, 317, 0, 179, 180,
-	181, 182, 720, 722, 402, 0, 186, 0, 183, 0,
-	401, 184, 739, 185, 740, 741, 742, 743, 744, 0,
-	702, 0, 403, 187, 188, 189, 404, 190, 191, 192,
-	0, 194, 193, 0, 725, 405, 195, 406, 0, 196,
-	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
-	407, 203, 204, 206, 205, 0, 0, 692, 721, 207,
-	745, 208, 209, 0, 210, 0, 0, 211, 0, 0,
-	212, 331, 408, 213, 409, 715, 214, 215, 216, 217,
-	218, 0, 219, 716, 220, 334, 221, 0, 222, 223,
-	224, 225, 226, 746, 227, 228, 0, 229, 230, 231,
-	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
-	241, 410, 242, 243, 1386, 244, 0, 248, 249, 250,
-	251, 0, 253, 337, 252, 254, 255, 709, 256, 245,
-	246, 257, 411, 258, 747, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 748, 263, 717, 0, 266, 0,
-	267, 268, 269, 270, 271, 272, 273, 0, 342, 749,
-	750, 0, 0, 274, 275, 718, 719, 690, 276, 277,
-	278, 279, 0, 0, 280, 281, 282, 283, 710, 284,
-	0, 347, 285, 286, 287, 348, 751, 0, 0, 288,
-	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
-	0, 695, 684, 685, 682, 683, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 141, 142, 1385,
-	143, 0, 0, 0, 673, 712, 677, 0, 0, 0,
-	144, 145, 146, 0, 727, 297, 728, 147, 729, 730,
-	0, 148, 301, 302, 149, 150, 680, 711, 731, 732,
-	305, 0, 151, 723, 0, 703, 0, 152, 153, 154,
-	0, 155, 0, 156, 157, 158, 0, 399, 159, 2221,
-	0, 704, 705, 707, 0, 706, 708, 161, 162, 352,
-	163, 733, 164, 734, 735, 0, 165, 0, 166, 0,
-	167, 0, 0, 726, 169, 0, 170, 0, 0, 0,
-	668, 171, 172, 173, 713, 714, 691, 0, 0, 174,
-	175, 736, 737, 738, 0, 176, 0, 177, 0, 0,
-	400, 0, 178, 724, 0, 317, 0, 179, 180, 181,
-	182, 720, 722, 0, 0, 186, 0, 183, 0, 401,
-	184, 739, 185, 740, 741, 742, 743, 744, 0, 702,
-	0, 0, 187, 188, 189, 404, 190, 191, 192, 0,
-	194, 193, 0, 725, 405, 195, 0, 0, 196, 0,
-	0, 197, 0, 198, 199, 200, 202, 328, 201, 407,
-	203, 204, 206, 205, 663, 0, 692, 721, 207, 745,
-	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
-	331, 408, 213, 409, 715, 214, 215, 216, 217, 218,
-	0, 219, 716, 220, 334, 221, 0, 222, 223, 224,
-	225, 226, 746, 227, 228, 0, 229, 230, 231, 232,
-	233, 235, 236, 234, 237, 238, 239, 240, 0, 241,
-	410, 242, 243, 669, 244, 0, 248, 249, 250, 251,
-	0, 253, 337, 252, 254, 255, 709, 256, 245, 246,
-	257, 0, 258, 747, 339, 259, 0, 265, 260, 261,
-	247, 262, 264, 748, 263, 717, 0, 266, 0, 267,
-	268, 269, 270, 271, 272, 273, 0, 342, 749, 750,
-	0, 0, 274, 275, 718, 719, 690, 276, 277, 2220,
-	279, 0, 0, 280, 281, 282, 283, 710, 284, 0,
-	347, 285, 286, 287, 348, 751, 0, 0, 288, 0,
-	0, 0, 0, 289, 290, 291, 292, 293, 695, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 660,
-	661, 0, 0, 0, 141, 142, 0, 143, 670, 0,
-	0, 0, 712, 673, 0, 0, 0, 144, 145, 146,
-	295, 296, 297, 298, 147, 299, 300, 0, 148, 301,
-	302, 149, 150, 0, 711, 303, 304, 305, 0, 151,
-	723, 0, 703, 0, 152, 153, 154, 0, 155, 0,
-	156, 157, 158, 0, 399, 159, 160, 0, 704, 705,
-	707, 0, 706, 708, 161, 162, 352, 163, 307, 164,
-	308, 309, 0, 165, 0, 166, 0, 167, 0, 0,
-	168, 169, 0, 170, 0, 0, 0, 310, 171, 172,
-	173, 713, 714, 0, 0, 0, 174, 175, 313, 314,
-	315, 0, 176, 0, 177, 0, 0, 400, 0, 178,
-	724, 0, 317, 0, 179, 180, 181, 182, 720, 722,
-	402, 0, 186, 0, 183, 0, 401, 184, 320, 185,
-	321, 322, 323, 324, 325, 0, 326, 0, 403, 187,
+	670, 244, 0, 248, 249, 250, 251, 125, 253, 337,
+	252, 254, 255, 711, 256, 245, 246, 257, 411, 258,
+	749, 339, 259, 0, 265, 260, 261, 247, 262, 264,
+	750, 263, 719, 0, 266, 129, 267, 268, 269, 270,
+	271, 272, 273, 0, 342, 751, 752, 0, 0, 274,
+	275, 720, 721, 691, 276, 277, 278, 279, 0, 0,
+	280, 281, 282, 283, 712, 284, 0, 347, 285, 286,
+	287, 656, 753, 0, 0, 288, 0, 0, 0, 123,
+	289, 290, 291, 292, 293, 665, 124, 0, 0, 0,
+	0, 663, 0, 0, 0, 0, 661, 662, 696, 685,
+	686, 683, 684, 675, 0, 671, 0, 0, 0, 0,
+	674, 0, 0, 0, 141, 142, 1351, 143, 0, 0,
+	0, 0, 714, 678, 0, 0, 0, 144, 145, 146,
+	295, 729, 297, 730, 147, 731, 732, 0, 148, 301,
+	302, 149, 150, 681, 713, 733, 734, 305, 0, 151,
+	725, 0, 704, 0, 152, 153, 154, 0, 155, 0,
+	156, 157, 158, 0, 399, 159, 160, 0, 705, 706,
+	709, 0, 707, 710, 161, 162, 352, 163, 735, 164,
+	736, 737, 0, 165, 0, 166, 0, 167, 1352, 0,
+	728, 169, 0, 170, 0, 0, 0, 669, 171, 172,
+	173, 715, 716, 692, 0, 0, 174, 175, 738, 739,
+	740, 0, 176, 0, 177, 0, 0, 400, 0, 178,
+	726, 0, 317, 0, 179, 180, 181, 182, 722, 724,
+	402, 0, 186, 0, 183, 0, 401, 184, 741, 185,
+	742, 743, 744, 745, 746, 0, 703, 0, 403, 187,
 	188, 189, 404, 190, 191, 192, 0, 194, 193, 0,
-	725, 405, 195, 406, 0, 196, 0, 0, 197, 0,
+	727, 405, 195, 406, 0, 196, 0, 708, 197, 0,
 	198, 199, 200, 202, 328, 201, 407, 203, 204, 206,
-	205, 0, 0, 0, 721, 207, 330, 208, 209, 0,
+	205, 664, 0, 693, 723, 207, 747, 208, 209, 0,
 	210, 0, 0, 211, 0, 0, 212, 331, 408, 213,
-	409, 715, 214, 215, 216, 217, 218, 0, 219, 716,
-	220, 334, 221, 0, 222, 223, 224, 225, 226, 335,
+	409, 717, 214, 215, 216, 217, 218, 0, 219, 718,
+	220, 334, 221, 0, 222, 223, 224, 225, 226, 748,
 	227, 228, 0, 229, 230, 231, 232, 233, 235, 236,
 	234, 237, 238, 239, 240, 0, 241, 410, 242, 243,
-	336, 244, 0, 248, 249, 250, 251, 0, 253, 337,
-	252, 254, 255, 709, 256, 245, 246, 257, 411, 258,
-	338, 339, 259, 0, 265, 260, 261, 247, 262, 264,
-	340, 263, 717, 0, 266, 0, 267, 268, 269, 270,
-	271, 272, 273, 0, 342, 343, 344, 0, 0, 274,
-	275, 718, 719, 0, 276, 277, 278, 279, 0, 0,
-	280, 281, 282, 283, 710, 284, 0, 347, 285, 286,
-	287, 348, 349, 0, 0, 288, 0, 566, 0, 0,
-	289, 290, 291, 292, 293, 0, 0, 0, 0, 0,
-	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
-	0, 294, 0, 0, 0, 1894, 144, 145, 146, 295,
-	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
-	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
-	0, 398, 0, 152, 153, 154, 0, 155, 0, 156,
-	157, 158, 0, 399, 159, 160, 0, 0, 0, 0,
-	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
-	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
-	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
-	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
-	0, 176, 0, 177, 0, 0, 400, 0, 178, 316,
-	0, 317, 0, 179, 180, 181, 182, 318, 319, 402,
-	0, 186, 0, 183, 0, 401, 184, 320, 185, 321,
-	322, 323, 324, 325, 0, 326, 0, 403, 187, 188,
-	189, 404, 190, 191, 192, 0, 194, 193, 0, 327,
-	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
-	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
-	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
-	0, 0, 211, 0, 0, 212, 331, 408, 213, 409,
-	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
-	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
-	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
-	237, 238, 239, 240, 0, 241, 410, 242, 243, 336,
-	244, 0, 248, 249, 250, 251, 125, 253, 337, 252,
-	254, 255, 0, 256, 245, 246, 257, 411, 258, 338,
-	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
-	263, 341, 0, 266, 129, 267, 268, 269, 270, 271,
-	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
-	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
-	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
-	655, 349, 0, 0, 288, 0, 0, 0, 123, 289,
-	290, 291, 292, 293, 0, 124, 566, 563, 0, 564,
-	559, 554, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 141, 142, 114, 143, 0, 0, 0, 0,
-	294, 0, 0, 0, 0, 144, 145, 146, 295, 296,
-	297, 298, 147, 299, 300, 0, 148, 301, 302, 149,
-	150, 0, 0, 303, 304, 305, 0, 151, 306, 0,
-	398, 0, 152, 153, 154, 0, 155, 0, 156, 157,
-	158, 0, 399, 159, 160, 0, 0, 0, 0, 0,
-	0, 0, 161, 162, 352, 163, 307, 164, 308, 309,
-	1108, 165, 0, 166, 0, 167, 0, 0, 168, 169,
-	0, 170, 0, 0, 0, 310, 171, 172, 173, 311,
-	312, 556, 0, 0, 174, 175, 313, 314, 315, 0,
-	176, 0, 177, 0, 0, 400, 0, 178, 316, 0,
-	317, 0, 179, 180, 181, 182, 318, 319, 402, 0,
-	186, 0, 183, 0, 401, 184, 320, 185, 321, 322,
-	323, 324, 325, 0, 326, 0, 403, 187, 188, 189,
-	404, 190, 191, 192, 0, 194, 193, 0, 327, 405,
-	195, 406, 0, 196, 0, 0, 197, 0, 198, 199,
-	200, 202, 328, 201, 407, 203, 204, 206, 205, 0,
-	0, 0, 329, 207, 330, 208, 209, 0, 210, 557,
-	0, 211, 0, 0, 212, 331, 408, 213, 409, 332,
-	214, 215, 216, 217, 218, 0, 219, 333, 220, 334,
-	221, 0, 222, 223, 224, 225, 226, 335, 227, 228,
-	0, 229, 230, 231, 232, 233, 235, 236, 234, 237,
-	238, 239, 240, 0, 241, 410, 242, 243, 336, 244,
-	0, 248, 249, 250, 251, 0, 253, 337, 252, 254,
-	255, 0, 256, 245, 246, 257, 411, 258, 338, 339,
-	259, 0, 265, 260, 261, 247, 262, 264, 340, 263,
-	341, 0, 266, 0, 267, 268, 269, 270, 271, 272,
-	273, 0, 342, 343, 344, 0, 0, 274, 275, 345,
-	346, 555, 276, 277, 278, 279, 0, 0, 280, 281,
-	282, 283, 0, 284, 0, 347, 285, 286, 287, 348,
-	349, 0, 0, 288, 0, 0, 0, 0, 289, 290,
-	291, 292, 293, 566, 563, 0, 564, 559, 554, 0,
-	0, 0, 0, 0, 565, 560, 0, 0, 0, 141,
-	142, 0, 143, 0, 0, 0, 0, 294, 0, 0,
-	0, 0, 144, 145, 146, 295, 296, 297, 298, 147,
-	299, 300, 0, 148, 301, 302, 149, 150, 0, 0,
-	303, 304, 305, 0, 151, 306, 0, 398, 0, 152,
+	670, 244, 0, 248, 249, 250, 251, 0, 253, 337,
+	252, 254, 255, 711, 256, 245, 246, 257, 411, 258,
+	749, 339, 259, 0, 265, 260, 261, 247, 262, 264,
+	750, 263, 719, 0, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 0, 342, 751, 752, 0, 0, 274,
+	275, 720, 721, 691, 276, 277, 278, 279, 0, 0,
+	280, 281, 282, 283, 712, 284, 0, 347, 285, 286,
+	287, 348, 753, 1350, 0, 288, 0, 0, 0, 0,
+	289, 290, 291, 292, 293, 665, 0, 0, 0, 0,
+	0, 663, 0, 0, 0, 0, 661, 662, 1353, 696,
+	685, 686, 683, 684, 675, 671, 1348, 0, 0, 0,
+	674, 0, 0, 0, 0, 141, 142, 0, 143, 0,
+	0, 0, 0, 714, 678, 0, 0, 0, 144, 145,
+	146, 295, 729, 297, 730, 147, 731, 732, 0, 148,
+	301, 302, 149, 150, 681, 713, 733, 734, 305, 0,
+	151, 725, 0, 704, 0, 152, 153, 154, 0, 155,
+	0, 156, 157, 158, 0, 399, 159, 160, 0, 705,
+	706, 709, 0, 707, 710, 161, 162, 352, 163, 735,
+	164, 736, 737, 0, 165, 0, 166, 0, 167, 0,
+	0, 728, 169, 0, 170, 0, 0, 0, 669, 171,
+	172, 173, 715, 716, 692, 0, 0, 174, 175, 738,
+	739, 740, 0, 176, 0, 177, 0, 0, 400, 0,
+	178, 726, 0, 317, 0, 179, 180, 181, 182, 722,
+	724, 402, 0, 186, 0, 183, 0, 401, 184, 741,
+	185, 742, 743, 744, 745, 746, 0, 703, 0, 403,
+	187, 188, 189, 404, 190, 191, 192, 0, 194, 193,
+	0, 727, 405, 195, 406, 0, 196, 0, 708, 197,
+	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
+	206, 205, 664, 0, 693, 723, 207, 747, 208, 209,
+	0, 210, 0, 0, 211, 0, 0, 212, 331, 408,
+	213, 409, 717, 214, 215, 216, 217, 218, 0, 219,
+	718, 220, 334, 221, 0, 222, 223, 224, 225, 226,
+	748, 227, 228, 0, 229, 230, 231, 232, 233, 235,
+	236, 234, 237, 238, 239, 240, 0, 241, 410, 242,
+	243, 670, 244, 0, 248, 249, 250, 251, 125, 253,
+	337, 252, 254, 255, 711, 256, 245, 246, 257, 411,
+	258, 749, 339, 259, 0, 265, 260, 261, 247, 262,
+	264, 750, 263, 719, 0, 266, 129, 267, 268, 269,
+	270, 271, 272, 273, 0, 342, 751, 752, 0, 0,
+	274, 275, 720, 721, 691, 276, 277, 278, 279, 0,
+	0, 280, 281, 282, 283, 712, 284, 0, 347, 285,
+	286, 287, 656, 753, 0, 0, 288, 0, 0, 0,
+	123, 289, 290, 291, 292, 293, 665, 124, 0, 0,
+	0, 0, 663, 0, 0, 0, 0, 661, 662, 696,
+	685, 686, 683, 684, 675, 0, 671, 0, 0, 0,
+	0, 674, 0, 0, 0, 141, 142, 0, 143, 0,
+	0, 0, 0, 714, 678, 0, 0, 0, 144, 145,
+	146, 295, 729, 297, 730, 147, 731, 732, 1399, 148,
+	301, 302, 149, 150, 681, 713, 733, 734, 305, 0,
+	151, 725, 0, 704, 0, 152, 153, 154, 0, 155,
+	0, 156, 157, 158, 0, 399, 159, 160, 0, 705,
+	706, 709, 0, 707, 710, 161, 162, 352, 163, 735,
+	164, 736, 737, 0, 165, 0, 166, 0, 167, 0,
+	0, 728, 169, 0, 170, 0, 0, 0, 669, 171,
+	172, 173, 715, 716, 692, 0, 0, 174, 175, 738,
+	739, 740, 0, 176, 0, 177, 0, 1404, 400, 0,
+	178, 726, 0, 317, 0, 179, 180, 181, 182, 722,
+	724, 402, 0, 186, 0, 183, 0, 401, 184, 741,
+	185, 742, 743, 744, 745, 746, 0, 703, 0, 403,
+	187, 188, 189, 404, 190, 191, 192, 0, 194, 193,
+	1400, 727, 405, 195, 406, 0, 196, 0, 708, 197,
+	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
+	206, 205, 664, 0, 693, 723, 207, 747, 208, 209,
+	0, 210, 0, 0, 211, 0, 0, 212, 331, 408,
+	213, 409, 717, 214, 215, 216, 217, 218, 0, 219,
+	718, 220, 334, 221, 0, 222, 223, 224, 225, 226,
+	748, 227, 228, 0, 229, 230, 231, 232, 233, 235,
+	236, 234, 237, 238, 239, 240, 0, 241, 410, 242,
+	243, 670, 244, 0, 248, 249, 250, 251, 0, 253,
+	337, 252, 254, 255, 711, 256, 245, 246, 257, 411,
+	258, 749, 339, 259, 0, 265, 260, 261, 247, 262,
+	264, 750, 263, 719, 0, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 0, 342, 751, 752, 0, 1401,
+	274, 275, 720, 721, 691, 276, 277, 278, 279, 0,
+	0, 280, 281, 282, 283, 712, 284, 0, 347, 285,
+	286, 287, 348, 753, 0, 0, 288, 0, 0, 0,
+	0, 289, 290, 291, 292, 293, 665, 0, 0, 0,
+	0, 0, 663, 0, 0, 0, 0, 661, 662, 696,
+	685, 686, 683, 684, 675, 0, 671, 0, 0, 0,
+	0, 674, 0, 0, 0, 141, 142, 0, 143, 0,
+	0, 0, 0, 714, 678, 0, 0, 0, 144, 145,
+	146, 295, 729, 297, 730, 147, 731, 732, 0, 148,
+	301, 302, 149, 150, 681, 713, 733, 734, 305, 0,
+	151, 725, 0, 704, 0, 152, 153, 154, 0, 155,
+	0, 156, 157, 158, 0, 399, 159, 160, 0, 705,
+	706, 709, 0, 707, 710, 161, 162, 352, 163, 735,
+	164, 736, 737, 0, 165, 0, 166, 0, 167, 0,
+	0, 728, 169, 0, 170, 0, 0, 0, 669, 171,
+	172, 173, 715, 716, 692, 0, 0, 174, 175, 738,
+	739, 740, 0, 176, 0, 177, 0, 0, 400, 0,
+	178, 726, 0, 317, 0, 179, 180, 181, 182, 722,
+	724, 402, 0, 186, 0, 183, 0, 401, 184, 741,
+	185, 742, 743, 744, 745, 746, 0, 703, 0, 403,
+	187, 188, 189, 404, 190, 191, 192, 0, 194, 193,
+	0, 727, 405, 195, 406, 0, 196, 0, 708, 197,
+	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
+	206, 205, 664, 1798, 693, 723, 207, 747, 208, 209,
+	0, 210, 0, 0, 211, 0, 0, 212, 331, 408,
+	213, 409, 717, 214, 215, 216, 217, 218, 0, 219,
+	718, 220, 334, 221, 0, 222, 223, 224, 225, 226,
+	748, 227, 228, 0, 229, 230, 231, 232, 233, 235,
+	236, 234, 237, 238, 239, 240, 0, 241, 410, 242,
+	243, 670, 244, 0, 248, 249, 250, 251, 0, 253,
+	337, 252, 254, 255, 711, 256, 245, 246, 257, 411,
+	258, 749, 339, 259, 0, 265, 260, 261, 247, 262,
+	264, 750, 263, 719, 0, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 0, 342, 751, 752, 0, 0,
+	274, 275, 720, 721, 691, 276, 277, 278, 279, 0,
+	0, 280, 281, 282, 283, 712, 284, 0, 347, 285,
+	286, 287, 348, 753, 0, 0, 288, 0, 0, 0,
+	0, 289, 290, 291, 292, 293, 665, 0, 0, 0,
+	0, 0, 663, 0, 0, 0, 0, 661, 662, 882,
+	696, 685, 686, 683, 684, 675, 671, 0, 0, 0,
+	0, 674, 0, 0, 0, 0, 141, 142, 0, 143,
+	0, 0, 0, 0, 714, 678, 0, 0, 0, 144,
+	145, 146, 295, 729, 297, 730, 147, 731, 732, 0,
+	148, 301, 302, 149, 150, 681, 713, 733, 734, 305,
+	0, 151, 725, 0, 704, 0, 152, 153, 154, 0,
+	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
+	705, 706, 709, 0, 707, 710, 161, 162, 352, 163,
+	735, 164, 736, 737, 0, 165, 0, 166, 0, 167,
+	0, 0, 728, 169, 0, 170, 0, 0, 0, 669,
+	171, 172, 173, 715, 716, 692, 0, 0, 174, 175,
+	738, 739, 740, 0, 176, 0, 177, 0, 0, 400,
+	0, 178, 726, 0, 317, 0, 179, 180, 181, 182,
+	722, 724, 402, 0, 186, 1163, 183, 0, 401, 184,
+	741, 185, 742, 743, 744, 745, 746, 0, 703, 0,
+	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
+	193, 0, 727, 405, 195, 406, 0, 196, 0, 708,
+	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
+	204, 206, 205, 664, 0, 693, 723, 207, 747, 208,
+	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
+	408, 213, 409, 717, 214, 215, 216, 217, 218, 0,
+	219, 718, 220, 334, 221, 1162, 222, 223, 224, 225,
+	226, 748, 227, 228, 0, 229, 230, 231, 232, 233,
+	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
+	242, 243, 670, 244, 0, 248, 249, 250, 251, 0,
+	253, 337, 252, 254, 255, 711, 256, 245, 246, 257,
+	411, 258, 749, 339, 259, 0, 265, 260, 261, 247,
+	262, 264, 750, 263, 719, 0, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 0, 342, 751, 752, 0,
+	0, 274, 275, 720, 721, 691, 276, 277, 278, 279,
+	0, 0, 280, 281, 282, 283, 712, 284, 0, 347,
+	285, 286, 287, 348, 753, 0, 0, 288, 0, 0,
+	0, 0, 289, 290, 291, 292, 293, 665, 0, 0,
+	0, 0, 0, 663, 0, 0, 0, 0, 661, 662,
+	696, 685, 686, 683, 684, 675, 0, 671, 0, 0,
+	0, 0, 674, 0, 0, 0, 141, 142, 0, 143,
+	0, 0, 0, 0, 714, 678, 0, 0, 0, 144,
+	145, 146, 295, 729, 297, 730, 147, 731, 732, 0,
+	148, 301, 302, 149, 150, 681, 713, 733, 734, 305,
+	0, 151, 725, 0, 704, 0, 152, 153, 154, 0,
+	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
+	705, 706, 709, 0, 707, 710, 161, 162, 352, 163,
+	735, 164, 736, 737, 0, 165, 0, 166, 0, 167,
+	0, 0, 728, 169, 0, 170, 0, 0, 0, 669,
+	171, 172, 173, 715, 716, 692, 0, 0, 174, 175,
+	738, 739, 740, 0, 176, 0, 177, 0, 0, 400,
+	0, 178, 726, 0, 317, 0, 179, 180, 181, 182,
+	722, 724, 402, 0, 186, 0, 183, 0, 401, 184,
+	741, 185, 742, 743, 744, 745, 746, 0, 703, 0,
+	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
+	193, 0, 727, 405, 195, 406, 0, 196, 0, 708,
+	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
+	204, 206, 205, 664, 0, 693, 723, 207, 747, 208,
+	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
+	408, 213, 409, 717, 214, 215, 216, 217, 218, 0,
+	219, 718, 220, 334, 221, 0, 222, 223, 224, 225,
+	226, 748, 227, 228, 0, 229, 230, 231, 232, 233,
+	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
+	242, 243, 670, 244, 0, 248, 249, 250, 251, 0,
+	253, 337, 252, 254, 255, 711, 256, 245, 246, 257,
+	411, 258, 749, 339, 259, 0, 265, 260, 261, 247,
+	262, 264, 750, 263, 719, 0, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 0, 342, 751, 752, 0,
+	0, 274, 275, 720, 721, 691, 276, 277, 278, 279,
+	0, 0, 280, 281, 282, 283, 712, 284, 0, 347,
+	285, 286, 287, 348, 753, 0, 0, 288, 0, 0,
+	0, 0, 289, 290, 291, 292, 293, 665, 0, 0,
+	0, 0, 0, 663, 0, 0, 0, 0, 661, 662,
+	0, 0, 0, 0, 0, 990, 1343, 671, 0, 0,
+	0, 0, 674, 696, 685, 686, 683, 684, 675, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
 	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
-	159, 160, 0, 0, 0, 0, 0, 0, 0, 161,
-	162, 352, 163, 307, 164, 308, 309, 1105, 165, 0,
-	166, 0, 167, 0, 0, 168, 169, 0, 170, 0,
-	0, 0, 310, 171, 172, 173, 311, 312, 556, 0,
-	0, 174, 175, 313, 314, 315, 0, 176, 0, 177,
-	0, 0, 400, 0, 178, 316, 0, 317, 0, 179,
-	180, 181, 182, 318, 319, 402, 0, 186, 0, 183,
-	0, 401, 184, 320, 185, 321, 322, 323, 324, 325,
-	0, 326, 0, 403, 187, 188, 189, 404, 190, 191,
-	192, 0, 194, 193, 0, 327, 405, 195, 406, 0,
-	196, 0, 0, 197, 0, 198, 199, 200, 202, 328,
-	201, 407, 203, 204, 206, 205, 0, 0, 0, 329,
-	207, 330, 208, 209, 0, 210, 557, 0, 211, 0,
-	0, 212, 331, 408, 213, 409, 332, 214, 215, 216,
-	217, 218, 0, 219, 333, 220, 334, 221, 0, 222,
-	223, 224, 225, 226, 335, 227, 228, 0, 229, 230,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
 	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
-	0, 241, 410, 242, 243, 336, 244, 0, 248, 249,
-	250, 251, 0, 253, 337, 252, 254, 255, 0, 256,
-	245, 246, 257, 411, 258, 338, 339, 259, 0, 265,
-	260, 261, 247, 262, 264, 340, 263, 341, 0, 266,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
 	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
-	343, 344, 0, 0, 274, 275, 345, 346, 555, 276,
-	277, 278, 279, 0, 0, 280, 281, 282, 283, 0,
-	284, 0, 347, 285, 286, 287, 348, 349, 0, 0,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 696, 685, 686, 683, 684, 675, 0,
+	671, 1879, 0, 0, 0, 674, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 1828, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 696, 685, 686, 683, 684, 675, 0,
+	671, 0, 0, 0, 0, 674, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 696, 685, 686, 683, 684, 675, 0,
+	671, 1818, 0, 0, 0, 674, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 890, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 696, 685, 686, 683, 684, 675, 0,
+	671, 0, 0, 0, 0, 674, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 1404, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 696, 685, 686, 683, 684, 675, 0,
+	671, 0, 0, 0, 0, 674, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 714, 678, 0,
+	0, 0, 144, 145, 146, 295, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 160, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 402, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 403, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 406, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 411, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 278, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
+	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
+	665, 0, 0, 0, 0, 0, 663, 0, 0, 0,
+	0, 661, 662, 882, 696, 685, 686, 683, 684, 675,
+	671, 0, 0, 0, 0, 674, 0, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 696, 685, 686, 683, 684, 675,
+	0, 671, 1338, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 1150, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 696, 685, 686, 683, 684, 675,
+	0, 671, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 2238, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 2237, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 696, 685, 686, 683, 684, 675,
+	0, 671, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 696, 685, 686, 683, 684, 675,
+	0, 671, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 2236, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 2238, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 2237, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 1376, 685, 686, 683, 684, 675,
+	0, 671, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 1379, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 1380, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 1381, 1378, 202,
+	328, 201, 407, 203, 204, 206, 205, 664, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 670, 244, 0, 248,
+	249, 250, 1382, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 1377,
+	293, 665, 0, 0, 0, 0, 0, 663, 0, 0,
+	0, 0, 661, 662, 696, 685, 686, 683, 684, 675,
+	0, 671, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 681,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 669, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 0, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 1394, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 0, 0, 0, 0, 0, 0, 1392, 0, 0,
+	0, 0, 1390, 1391, 696, 685, 686, 683, 684, 675,
+	0, 1393, 0, 0, 0, 0, 674, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 714, 678,
+	0, 0, 0, 144, 145, 146, 295, 729, 297, 730,
+	147, 731, 732, 0, 148, 301, 302, 149, 150, 0,
+	713, 733, 734, 305, 0, 151, 725, 0, 704, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	399, 159, 160, 0, 705, 706, 709, 0, 707, 710,
+	161, 162, 352, 163, 735, 164, 736, 737, 0, 165,
+	0, 166, 0, 167, 0, 0, 728, 169, 0, 170,
+	0, 0, 0, 310, 171, 172, 173, 715, 716, 692,
+	0, 0, 174, 175, 738, 739, 740, 0, 176, 0,
+	177, 0, 0, 400, 0, 178, 726, 0, 317, 0,
+	179, 180, 181, 182, 722, 724, 402, 0, 186, 0,
+	183, 0, 401, 184, 741, 185, 742, 743, 744, 745,
+	746, 0, 703, 0, 403, 187, 188, 189, 404, 190,
+	191, 192, 0, 194, 193, 0, 727, 405, 195, 406,
+	0, 196, 0, 708, 197, 0, 198, 199, 200, 202,
+	328, 201, 407, 203, 204, 206, 205, 0, 0, 693,
+	723, 207, 747, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 408, 213, 409, 717, 214, 215,
+	216, 217, 218, 0, 219, 718, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 748, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 410, 242, 243, 1394, 244, 0, 248,
+	249, 250, 251, 0, 253, 337, 252, 254, 255, 711,
+	256, 245, 246, 257, 411, 258, 749, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 750, 263, 719, 0,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 751, 752, 0, 0, 274, 275, 720, 721, 691,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	712, 284, 0, 347, 285, 286, 287, 348, 753, 0,
+	0, 288, 0, 0, 0, 0, 289, 290, 291, 292,
+	293, 0, 0, 696, 685, 686, 683, 684, 675, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 141,
+	142, 1393, 143, 0, 0, 0, 674, 714, 678, 0,
+	0, 0, 144, 145, 146, 0, 729, 297, 730, 147,
+	731, 732, 0, 148, 301, 302, 149, 150, 681, 713,
+	733, 734, 305, 0, 151, 725, 0, 704, 0, 152,
+	153, 154, 0, 155, 0, 156, 157, 158, 0, 399,
+	159, 2238, 0, 705, 706, 709, 0, 707, 710, 161,
+	162, 352, 163, 735, 164, 736, 737, 0, 165, 0,
+	166, 0, 167, 0, 0, 728, 169, 0, 170, 0,
+	0, 0, 669, 171, 172, 173, 715, 716, 692, 0,
+	0, 174, 175, 738, 739, 740, 0, 176, 0, 177,
+	0, 0, 400, 0, 178, 726, 0, 317, 0, 179,
+	180, 181, 182, 722, 724, 0, 0, 186, 0, 183,
+	0, 401, 184, 741, 185, 742, 743, 744, 745, 746,
+	0, 703, 0, 0, 187, 188, 189, 404, 190, 191,
+	192, 0, 194, 193, 0, 727, 405, 195, 0, 0,
+	196, 0, 708, 197, 0, 198, 199, 200, 202, 328,
+	201, 407, 203, 204, 206, 205, 664, 0, 693, 723,
+	207, 747, 208, 209, 0, 210, 0, 0, 211, 0,
+	0, 212, 331, 408, 213, 409, 717, 214, 215, 216,
+	217, 218, 0, 219, 718, 220, 334, 221, 0, 222,
+	223, 224, 225, 226, 748, 227, 228, 0, 229, 230,
+	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
+	0, 241, 410, 242, 243, 670, 244, 0, 248, 249,
+	250, 251, 0, 253, 337, 252, 254, 255, 711, 256,
+	245, 246, 257, 0, 258, 749, 339, 259, 0, 265,
+	260, 261, 247, 262, 264, 750, 263, 719, 0, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	751, 752, 0, 0, 274, 275, 720, 721, 691, 276,
+	277, 2237, 279, 0, 0, 280, 281, 282, 283, 712,
+	284, 0, 347, 285, 286, 287, 348, 753, 0, 0,
 	288, 0, 0, 0, 0, 289, 290, 291, 292, 293,
-	566, 563, 0, 564, 559, 554, 0, 0, 0, 0,
-	0, 565, 560, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
+	696, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 661, 662, 0, 0, 0, 141, 142, 0, 143,
+	671, 0, 0, 0, 714, 674, 0, 0, 0, 144,
 	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 398, 0, 152, 153, 154, 0,
+	148, 301, 302, 149, 150, 0, 713, 303, 304, 305,
+	0, 151, 725, 0, 704, 0, 152, 153, 154, 0,
 	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 786, 165, 0, 166, 0, 167,
+	705, 706, 709, 0, 707, 710, 161, 162, 352, 163,
+	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
 	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 556, 0, 0, 174, 175,
+	171, 172, 173, 715, 716, 0, 0, 0, 174, 175,
 	313, 314, 315, 0, 176, 0, 177, 0, 0, 400,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 402, 0, 186, 0, 183, 0, 401, 184,
+	0, 178, 726, 0, 317, 0, 179, 180, 181, 182,
+	722, 724, 402, 0, 186, 0, 183, 0, 401, 184,
 	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
 	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
-	193, 0, 327, 405, 195, 406, 0, 196, 0, 0,
+	193, 0, 727, 405, 195, 406, 0, 196, 0, 708,
 	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 557, 0, 211, 0, 0, 212, 331,
-	408, 213, 409, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
+	204, 206, 205, 0, 0, 0, 723, 207, 330, 208,
+	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
+	408, 213, 409, 717, 214, 215, 216, 217, 218, 0,
+	219, 718, 220, 334, 221, 0, 222, 223, 224, 225,
 	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
 	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
 	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
+	253, 337, 252, 254, 255, 711, 256, 245, 246, 257,
 	411, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
+	262, 264, 340, 263, 719, 0, 266, 0, 267, 268,
 	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 555, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 0, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 566, 563, 0,
-	564, 559, 554, 0, 0, 0, 0, 0, 565, 560,
-	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
-	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
-	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
-	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
-	0, 398, 0, 152, 153, 154, 0, 155, 0, 156,
-	157, 158, 0, 399, 159, 160, 0, 0, 0, 0,
-	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
-	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
-	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
-	311, 312, 556, 0, 0, 174, 175, 313, 314, 315,
-	0, 176, 0, 177, 0, 0, 400, 0, 178, 316,
-	0, 317, 0, 179, 180, 181, 182, 318, 319, 402,
-	0, 186, 0, 183, 0, 401, 184, 320, 185, 321,
-	322, 323, 324, 325, 0, 326, 0, 403, 187, 188,
-	189, 404, 190, 191, 192, 0, 194, 193, 0, 327,
-	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
-	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
-	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
-	557, 0, 211, 0, 0, 212, 331, 408, 213, 409,
-	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
-	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
-	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
-	237, 238, 239, 240, 0, 241, 410, 242, 243, 336,
-	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
-	254, 255, 0, 256, 245, 246, 257, 411, 258, 338,
-	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
-	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
-	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
-	345, 346, 555, 276, 277, 278, 279, 0, 0, 280,
-	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
-	348, 349, 0, 138, 288, 0, 0, 0, 0, 289,
-	290, 291, 292, 293, 0, 0, 0, 0, 0, 141,
-	142, 0, 143, 0, 0, 565, 560, 294, 0, 0,
-	0, 0, 144, 145, 146, 295, 296, 297, 298, 147,
+	0, 274, 275, 720, 721, 0, 276, 277, 278, 279,
+	0, 0, 280, 281, 282, 283, 712, 284, 0, 347,
+	285, 286, 287, 348, 349, 0, 0, 288, 0, 566,
+	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
+	0, 0, 0, 0, 0, 141, 142, 0, 143, 0,
+	0, 0, 0, 294, 0, 0, 0, 1909, 144, 145,
+	146, 295, 296, 297, 298, 147, 299, 300, 0, 148,
+	301, 302, 149, 150, 0, 0, 303, 304, 305, 0,
+	151, 306, 0, 398, 0, 152, 153, 154, 0, 155,
+	0, 156, 157, 158, 0, 399, 159, 160, 0, 0,
+	0, 0, 0, 0, 0, 161, 162, 352, 163, 307,
+	164, 308, 309, 0, 165, 0, 166, 0, 167, 0,
+	0, 168, 169, 0, 170, 0, 0, 0, 310, 171,
+	172, 173, 311, 312, 0, 0, 0, 174, 175, 313,
+	314, 315, 0, 176, 0, 177, 0, 0, 400, 0,
+	178, 316, 0, 317, 0, 179, 180, 181, 182, 318,
+	319, 402, 0, 186, 0, 183, 0, 401, 184, 320,
+	185, 321, 322, 323, 324, 325, 0, 326, 0, 403,
+	187, 188, 189, 404, 190, 191, 192, 0, 194, 193,
+	0, 327, 405, 195, 406, 0, 196, 0, 0, 197,
+	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
+	206, 205, 0, 0, 0, 329, 207, 330, 208, 209,
+	0, 210, 0, 0, 211, 0, 0, 212, 331, 408,
+	213, 409, 332, 214, 215, 216, 217, 218, 0, 219,
+	333, 220, 334, 221, 0, 222, 223, 224, 225, 226,
+	335, 227, 228, 0, 229, 230, 231, 232, 233, 235,
+	236, 234, 237, 238, 239, 240, 0, 241, 410, 242,
+	243, 336, 244, 0, 248, 249, 250, 251, 125, 253,
+	337, 252, 254, 255, 0, 256, 245, 246, 257, 411,
+	258, 338, 339, 259, 0, 265, 260, 261, 247, 262,
+	264, 340, 263, 341, 0, 266, 129, 267, 268, 269,
+	270, 271, 272, 273, 0, 342, 343, 344, 0, 0,
+	274, 275, 345, 346, 0, 276, 277, 278, 279, 0,
+	0, 280, 281, 282, 283, 0, 284, 0, 347, 285,
+	286, 287, 656, 349, 0, 0, 288, 0, 0, 0,
+	123, 289, 290, 291, 292, 293, 0, 124, 566, 563,
+	0, 564, 559, 554, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 141, 142, 114, 143, 0, 0,
+	0, 0, 294, 0, 0, 0, 0, 144, 145, 146,
+	295, 296, 297, 298, 147, 299, 300, 0, 148, 301,
+	302, 149, 150, 0, 0, 303, 304, 305, 0, 151,
+	306, 0, 398, 0, 152, 153, 154, 0, 155, 0,
+	156, 157, 158, 0, 399, 159, 160, 0, 0, 0,
+	0, 0, 0, 0, 161, 162, 352, 163, 307, 164,
+	308, 309, 1113, 165, 0, 166, 0, 167, 0, 0,
+	168, 169, 0, 170, 0, 0, 0, 310, 171, 172,
+	173, 311, 312, 556, 0, 0, 174, 175, 313, 314,
+	315, 0, 176, 0, 177, 0, 0, 400, 0, 178,
+	316, 0, 317, 0, 179, 180, 181, 182, 318, 319,
+	402, 0, 186, 0, 183, 0, 401, 184, 320, 185,
+	321, 322, 323, 324, 325, 0, 326, 0, 403, 187,
+	188, 189, 404, 190, 191, 192, 0, 194, 193, 0,
+	327, 405, 195, 406, 0, 196, 0, 0, 197, 0,
+	198, 199, 200, 202, 328, 201, 407, 203, 204, 206,
+	205, 0, 0, 0, 329, 207, 330, 208, 209, 0,
+	210, 557, 0, 211, 0, 0, 212, 331, 408, 213,
+	409, 332, 214, 215, 216, 217, 218, 0, 219, 333,
+	220, 334, 221, 0, 222, 223, 224, 225, 226, 335,
+	227, 228, 0, 229, 230, 231, 232, 233, 235, 236,
+	234, 237, 238, 239, 240, 0, 241, 410, 242, 243,
+	336, 244, 0, 248, 249, 250, 251, 0, 253, 337,
+	252, 254, 255, 0, 256, 245, 246, 257, 411, 258,
+	338, 339, 259, 0, 265, 260, 261, 247, 262, 264,
+	340, 263, 341, 0, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 0, 342, 343, 344, 0, 0, 274,
+	275, 345, 346, 555, 276, 277, 278, 279, 0, 0,
+	280, 281, 282, 283, 0, 284, 0, 347, 285, 286,
+	287, 348, 349, 0, 0, 288, 0, 0, 0, 0,
+	289, 290, 291, 292, 293, 566, 563, 0, 564, 559,
+	554, 0, 0, 0, 0, 0, 565, 560, 0, 0,
+	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
+	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
+	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
+	0, 0, 303, 304, 305, 0, 151, 306, 0, 398,
+	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
+	0, 399, 159, 160, 0, 0, 0, 0, 0, 0,
+	0, 161, 162, 352, 163, 307, 164, 308, 309, 1110,
+	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
+	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
+	556, 0, 0, 174, 175, 313, 314, 315, 0, 176,
+	0, 177, 0, 0, 400, 0, 178, 316, 0, 317,
+	0, 179, 180, 181, 182, 318, 319, 402, 0, 186,
+	0, 183, 0, 401, 184, 320, 185, 321, 322, 323,
+	324, 325, 0, 326, 0, 403, 187, 188, 189, 404,
+	190, 191, 192, 0, 194, 193, 0, 327, 405, 195,
+	406, 0, 196, 0, 0, 197, 0, 198, 199, 200,
+	202, 328, 201, 407, 203, 204, 206, 205, 0, 0,
+	0, 329, 207, 330, 208, 209, 0, 210, 557, 0,
+	211, 0, 0, 212, 331, 408, 213, 409, 332, 214,
+	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
+	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
+	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
+	239, 240, 0, 241, 410, 242, 243, 336, 244, 0,
+	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
+	0, 256, 245, 246, 257, 411, 258, 338, 339, 259,
+	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
+	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
+	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
+	555, 276, 277, 278, 279, 0, 0, 280, 281, 282,
+	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
+	0, 0, 288, 0, 0, 0, 0, 289, 290, 291,
+	292, 293, 566, 563, 0, 564, 559, 554, 0, 0,
+	0, 0, 0, 565, 560, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 398, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 788, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 556, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 400, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 402, 0, 186, 0, 183, 0,
+	401, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 403, 187, 188, 189, 404, 190, 191, 192,
+	0, 194, 193, 0, 327, 405, 195, 406, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	407, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 557, 0, 211, 0, 0,
+	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 411, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 555, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 0, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 566,
+	563, 0, 564, 559, 554, 0, 0, 0, 0, 0,
+	565, 560, 0, 0, 0, 141, 142, 0, 143, 0,
+	0, 0, 0, 294, 0, 0, 0, 0, 144, 145,
+	146, 295, 296, 297, 298, 147, 299, 300, 0, 148,
+	301, 302, 149, 150, 0, 0, 303, 304, 305, 0,
+	151, 306, 0, 398, 0, 152, 153, 154, 0, 155,
+	0, 156, 157, 158, 0, 399, 159, 160, 0, 0,
+	0, 0, 0, 0, 0, 161, 162, 352, 163, 307,
+	164, 308, 309, 0, 165, 0, 166, 0, 167, 0,
+	0, 168, 169, 0, 170, 0, 0, 0, 310, 171,
+	172, 173, 311, 312, 556, 0, 0, 174, 175, 313,
+	314, 315, 0, 176, 0, 177, 0, 0, 400, 0,
+	178, 316, 0, 317, 0, 179, 180, 181, 182, 318,
+	319, 402, 0, 186, 0, 183, 0, 401, 184, 320,
+	185, 321, 322, 323, 324, 325, 0, 326, 0, 403,
+	187, 188, 189, 404, 190, 191, 192, 0, 194, 193,
+	0, 327, 405, 195, 406, 0, 196, 0, 0, 197,
+	0, 198, 199, 200, 202, 328, 201, 407, 203, 204,
+	206, 205, 0, 0, 0, 329, 207, 330, 208, 209,
+	0, 210, 557, 0, 211, 0, 0, 212, 331, 408,
+	213, 409, 332, 214, 215, 216, 217, 218, 0, 219,
+	333, 220, 334, 221, 0, 222, 223, 224, 225, 226,
+	335, 227, 228, 0, 229, 230, 231, 232, 233, 235,
+	236, 234, 237, 238, 239, 240, 0, 241, 410, 242,
+	243, 336, 244, 0, 248, 249, 250, 251, 0, 253,
+	337, 252, 254, 255, 0, 256, 245, 246, 257, 411,
+	258, 338, 339, 259, 0, 265, 260, 261, 247, 262,
+	264, 340, 263, 341, 0, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 0, 342, 343, 344, 0, 0,
+	274, 275, 345, 346, 555, 276, 277, 278, 279, 0,
+	0, 280, 281, 282, 283, 0, 284, 0, 347, 285,
+	286, 287, 348, 349, 0, 138, 288, 0, 0, 0,
+	0, 289, 290, 291, 292, 293, 0, 0, 0, 0,
+	0, 141, 142, 0, 143, 0, 0, 565, 560, 294,
+	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
+	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
+	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
+	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
+	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
+	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
+	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
+	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
+	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
+	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
+	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
+	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
+	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
+	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
+	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
+	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
+	0, 329, 207, 330, 208, 209, 0, 210, 0, 627,
+	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
+	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
+	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
+	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
+	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
+	248, 249, 250, 251, 125, 253, 337, 252, 254, 255,
+	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
+	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
+	0, 266, 129, 267, 268, 269, 270, 271, 272, 273,
+	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
+	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
+	283, 0, 284, 0, 347, 285, 286, 287, 656, 349,
+	0, 0, 288, 0, 138, 0, 123, 289, 290, 291,
+	292, 293, 0, 124, 0, 0, 0, 0, 0, 0,
+	141, 142, 0, 143, 0, 0, 0, 0, 294, 0,
+	621, 0, 626, 144, 145, 146, 295, 296, 297, 298,
+	147, 299, 300, 0, 148, 301, 302, 149, 150, 0,
+	0, 303, 304, 305, 0, 151, 306, 0, 0, 0,
+	152, 153, 154, 0, 155, 0, 156, 157, 158, 0,
+	0, 159, 160, 0, 0, 0, 0, 0, 0, 0,
+	161, 162, 352, 163, 307, 164, 308, 309, 0, 165,
+	0, 166, 0, 167, 0, 0, 168, 169, 0, 170,
+	0, 0, 0, 310, 171, 172, 173, 311, 312, 0,
+	0, 0, 174, 175, 313, 314, 315, 0, 176, 0,
+	177, 0, 0, 0, 0, 178, 316, 0, 317, 0,
+	179, 180, 181, 182, 318, 319, 0, 0, 186, 0,
+	183, 0, 0, 184, 320, 185, 321, 322, 323, 324,
+	325, 0, 326, 0, 0, 187, 188, 189, 0, 190,
+	191, 192, 0, 194, 193, 0, 327, 0, 195, 0,
+	0, 196, 0, 0, 197, 0, 198, 199, 200, 202,
+	328, 201, 0, 203, 204, 206, 205, 0, 0, 0,
+	329, 207, 330, 208, 209, 0, 210, 0, 0, 211,
+	0, 0, 212, 331, 0, 213, 0, 332, 214, 215,
+	216, 217, 218, 0, 219, 333, 220, 334, 221, 0,
+	222, 223, 224, 225, 226, 335, 227, 228, 0, 229,
+	230, 231, 232, 233, 235, 236, 234, 237, 238, 239,
+	240, 0, 241, 0, 242, 243, 336, 244, 0, 248,
+	249, 250, 251, 125, 253, 337, 252, 254, 255, 0,
+	256, 245, 246, 257, 0, 258, 338, 339, 259, 0,
+	265, 260, 261, 247, 262, 264, 340, 263, 341, 0,
+	266, 129, 267, 268, 269, 270, 271, 272, 273, 0,
+	342, 343, 344, 0, 0, 274, 275, 345, 346, 0,
+	276, 277, 278, 279, 0, 0, 280, 281, 282, 283,
+	0, 284, 0, 347, 285, 286, 287, 656, 349, 0,
+	0, 288, 0, 138, 0, 123, 289, 290, 291, 292,
+	293, 0, 124, 0, 0, 0, 0, 0, 0, 141,
+	142, 0, 143, 0, 0, 0, 0, 294, 0, 0,
+	0, 114, 144, 145, 146, 295, 296, 297, 298, 147,
 	299, 300, 0, 148, 301, 302, 149, 150, 0, 0,
 	303, 304, 305, 0, 151, 306, 0, 0, 0, 152,
 	153, 154, 0, 155, 0, 156, 157, 158, 0, 0,
@@ -3080,23 +3218,55 @@ var sqlAct = [...]int{
 	192, 0, 194, 193, 0, 327, 0, 195, 0, 0,
 	196, 0, 0, 197, 0, 198, 199, 200, 202, 328,
 	201, 0, 203, 204, 206, 205, 0, 0, 0, 329,
-	207, 330, 208, 209, 0, 210, 0, 626, 211, 0,
+	207, 330, 208, 209, 0, 210, 0, 627, 211, 0,
 	0, 212, 331, 0, 213, 0, 332, 214, 215, 216,
 	217, 218, 0, 219, 333, 220, 334, 221, 0, 222,
 	223, 224, 225, 226, 335, 227, 228, 0, 229, 230,
 	231, 232, 233, 235, 236, 234, 237, 238, 239, 240,
 	0, 241, 0, 242, 243, 336, 244, 0, 248, 249,
-	250, 251, 125, 253, 337, 252, 254, 255, 0, 256,
+	250, 251, 0, 253, 337, 252, 254, 255, 0, 256,
 	245, 246, 257, 0, 258, 338, 339, 259, 0, 265,
 	260, 261, 247, 262, 264, 340, 263, 341, 0, 266,
-	129, 267, 268, 269, 270, 271, 272, 273, 0, 342,
+	0, 267, 268, 269, 270, 271, 272, 273, 0, 342,
 	343, 344, 0, 0, 274, 275, 345, 346, 0, 276,
 	277, 278, 279, 0, 0, 280, 281, 282, 283, 0,
-	284, 0, 347, 285, 286, 287, 655, 349, 0, 0,
-	288, 0, 138, 0, 123, 289, 290, 291, 292, 293,
-	0, 124, 0, 0, 0, 0, 0, 0, 141, 142,
-	0, 143, 0, 0, 0, 0, 294, 0, 620, 0,
-	625, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	284, 0, 347, 285, 286, 287, 348, 349, 0, 0,
+	288, 0, 138, 0, 0, 289, 290, 291, 292, 293,
+	0, 0, 0, 0, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 621, 0,
+	626, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 0, 0, 288,
+	0, 0, 138, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	918, 144, 145, 146, 295, 296, 297, 298, 147, 299,
 	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
 	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
 	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
@@ -3118,16 +3288,16 @@ var sqlAct = [...]int{
 	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
 	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
 	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
-	251, 125, 253, 337, 252, 254, 255, 0, 256, 245,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
 	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
-	261, 247, 262, 264, 340, 263, 341, 0, 266, 129,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
 	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
 	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
 	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
-	0, 347, 285, 286, 287, 655, 349, 0, 0, 288,
-	0, 138, 0, 123, 289, 290, 291, 292, 293, 0,
-	124, 0, 0, 0, 0, 0, 0, 141, 142, 0,
-	143, 0, 0, 0, 0, 294, 0, 0, 0, 114,
+	0, 347, 285, 286, 287, 348, 349, 0, 0, 288,
+	0, 138, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 0, 0, 0, 0, 141, 142, 0,
+	143, 0, 0, 0, 0, 294, 0, 0, 0, 1262,
 	144, 145, 146, 295, 296, 297, 298, 147, 299, 300,
 	0, 148, 301, 302, 149, 150, 0, 0, 303, 304,
 	305, 0, 151, 306, 0, 0, 0, 152, 153, 154,
@@ -3144,7 +3314,7 @@ var sqlAct = [...]int{
 	194, 193, 0, 327, 0, 195, 0, 0, 196, 0,
 	0, 197, 0, 198, 199, 200, 202, 328, 201, 0,
 	203, 204, 206, 205, 0, 0, 0, 329, 207, 330,
-	208, 209, 0, 210, 0, 626, 211, 0, 0, 212,
+	208, 209, 0, 210, 0, 0, 211, 0, 0, 212,
 	331, 0, 213, 0, 332, 214, 215, 216, 217, 218,
 	0, 219, 333, 220, 334, 221, 0, 222, 223, 224,
 	225, 226, 335, 227, 228, 0, 229, 230, 231, 232,
@@ -3159,7 +3329,7 @@ var sqlAct = [...]int{
 	347, 285, 286, 287, 348, 349, 0, 0, 288, 0,
 	138, 0, 0, 289, 290, 291, 292, 293, 0, 0,
 	0, 0, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 620, 0, 625, 144,
+	0, 0, 0, 0, 294, 0, 0, 0, 1843, 144,
 	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
 	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
 	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
@@ -3189,1778 +3359,1675 @@ var sqlAct = [...]int{
 	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
 	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
 	285, 286, 287, 348, 349, 0, 0, 288, 0, 0,
-	138, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 914, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 0, 0, 288, 0, 138,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 0, 0, 0, 0, 141, 142, 0, 143, 0,
-	0, 0, 0, 294, 0, 0, 0, 1255, 144, 145,
-	146, 295, 296, 297, 298, 147, 299, 300, 0, 148,
-	301, 302, 149, 150, 0, 0, 303, 304, 305, 0,
-	151, 306, 0, 0, 0, 152, 153, 154, 0, 155,
-	0, 156, 157, 158, 0, 0, 159, 160, 0, 0,
-	0, 0, 0, 0, 0, 161, 162, 352, 163, 307,
-	164, 308, 309, 0, 165, 0, 166, 0, 167, 0,
-	0, 168, 169, 0, 170, 0, 0, 0, 310, 171,
-	172, 173, 311, 312, 0, 0, 0, 174, 175, 313,
-	314, 315, 0, 176, 0, 177, 0, 0, 0, 0,
-	178, 316, 0, 317, 0, 179, 180, 181, 182, 318,
-	319, 0, 0, 186, 0, 183, 0, 0, 184, 320,
-	185, 321, 322, 323, 324, 325, 0, 326, 0, 0,
-	187, 188, 189, 0, 190, 191, 192, 0, 194, 193,
-	0, 327, 0, 195, 0, 0, 196, 0, 0, 197,
-	0, 198, 199, 200, 202, 328, 201, 0, 203, 204,
-	206, 205, 0, 0, 0, 329, 207, 330, 208, 209,
-	0, 210, 0, 0, 211, 0, 0, 212, 331, 0,
-	213, 0, 332, 214, 215, 216, 217, 218, 0, 219,
-	333, 220, 334, 221, 0, 222, 223, 224, 225, 226,
-	335, 227, 228, 0, 229, 230, 231, 232, 233, 235,
-	236, 234, 237, 238, 239, 240, 0, 241, 0, 242,
-	243, 336, 244, 0, 248, 249, 250, 251, 0, 253,
-	337, 252, 254, 255, 0, 256, 245, 246, 257, 0,
-	258, 338, 339, 259, 0, 265, 260, 261, 247, 262,
-	264, 340, 263, 341, 0, 266, 0, 267, 268, 269,
-	270, 271, 272, 273, 0, 342, 343, 344, 0, 0,
-	274, 275, 345, 346, 0, 276, 277, 278, 279, 0,
-	0, 280, 281, 282, 283, 0, 284, 0, 347, 285,
-	286, 287, 348, 349, 0, 0, 288, 0, 138, 0,
-	0, 289, 290, 291, 292, 293, 0, 0, 0, 0,
-	0, 0, 0, 0, 141, 142, 0, 143, 0, 0,
-	0, 0, 294, 0, 0, 0, 1828, 144, 145, 146,
-	295, 296, 297, 298, 147, 299, 300, 0, 148, 301,
-	302, 149, 150, 0, 0, 303, 304, 305, 0, 151,
-	306, 0, 0, 0, 152, 153, 154, 0, 155, 0,
-	156, 157, 158, 0, 0, 159, 160, 0, 0, 0,
-	0, 0, 0, 0, 161, 162, 352, 163, 307, 164,
-	308, 309, 0, 165, 0, 166, 0, 167, 0, 0,
-	168, 169, 0, 170, 0, 0, 0, 310, 171, 172,
-	173, 311, 312, 0, 0, 0, 174, 175, 313, 314,
-	315, 0, 176, 0, 177, 0, 0, 0, 0, 178,
-	316, 0, 317, 0, 179, 180, 181, 182, 318, 319,
-	0, 0, 186, 0, 183, 0, 0, 184, 320, 185,
-	321, 322, 323, 324, 325, 0, 326, 0, 0, 187,
-	188, 189, 0, 190, 191, 192, 0, 194, 193, 0,
-	327, 0, 195, 0, 0, 196, 0, 0, 197, 0,
-	198, 199, 200, 202, 328, 201, 0, 203, 204, 206,
-	205, 0, 0, 0, 329, 207, 330, 208, 209, 0,
-	210, 0, 0, 211, 0, 0, 212, 331, 0, 213,
-	0, 332, 214, 215, 216, 217, 218, 0, 219, 333,
-	220, 334, 221, 0, 222, 223, 224, 225, 226, 335,
-	227, 228, 0, 229, 230, 231, 232, 233, 235, 236,
-	234, 237, 238, 239, 240, 0, 241, 0, 242, 243,
-	336, 244, 0, 248, 249, 250, 251, 0, 253, 337,
-	252, 254, 255, 0, 256, 245, 246, 257, 0, 258,
-	338, 339, 259, 0, 265, 260, 261, 247, 262, 264,
-	340, 263, 341, 0, 266, 0, 267, 268, 269, 270,
-	271, 272, 273, 0, 342, 343, 344, 0, 0, 274,
-	275, 345, 346, 0, 276, 277, 278, 279, 0, 0,
-	280, 281, 282, 283, 0, 284, 0, 347, 285, 286,
-	287, 348, 349, 0, 0, 288, 0, 0, 0, 0,
-	289, 290, 291, 292, 293, 695, 684, 685, 682, 683,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 141, 142, 0, 143, 907, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 727, 297,
-	728, 147, 729, 730, 0, 148, 301, 302, 149, 150,
-	0, 0, 731, 732, 305, 0, 151, 306, 0, 398,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 399, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 733, 164, 734, 735, 0,
-	165, 0, 166, 0, 167, 0, 0, 726, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	691, 0, 0, 174, 175, 736, 737, 738, 0, 176,
-	0, 177, 0, 0, 400, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 402, 0, 186,
-	0, 183, 0, 401, 184, 739, 185, 740, 741, 742,
-	743, 744, 0, 702, 0, 403, 187, 188, 189, 404,
-	190, 191, 192, 0, 194, 193, 0, 327, 405, 195,
-	406, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 407, 203, 204, 206, 205, 0, 0,
-	692, 329, 207, 745, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 408, 213, 409, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 746, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 410, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 411, 258, 747, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 748, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 749, 750, 0, 0, 274, 275, 345, 346,
-	690, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 751,
-	566, 563, 288, 0, 0, 835, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 398, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 400,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 402, 0, 186, 0, 183, 0, 401, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
-	193, 0, 327, 405, 195, 406, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	408, 213, 409, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	411, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 134, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 133, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	566, 563, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 137, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 398, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 400,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 402, 0, 186, 0, 183, 0, 401, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
-	193, 0, 327, 405, 195, 406, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	408, 213, 409, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	411, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	566, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 137, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 398, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 399, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 400,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 402, 0, 186, 0, 183, 0, 401, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	403, 187, 188, 189, 404, 190, 191, 192, 0, 194,
-	193, 0, 327, 405, 195, 406, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 407, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	408, 213, 409, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 410,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	411, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 1445, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 1443, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 1442, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 1447, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	1440, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	1446, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 1441, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	1445, 151, 306, 0, 0, 1492, 152, 153, 154, 0,
-	155, 1443, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 1447, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	1491, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 1446, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 1495, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 1231, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 626, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 1160, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	872, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 626, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 866, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 626,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 643, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 355, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 354,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 1741, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	1739, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 1734, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 500, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 1125, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 924, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 0, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 869, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	814, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 812, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	808, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 806, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	803, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 799, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 201, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 342, 343, 344, 0, 0, 274, 275, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	794, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 770, 0, 151, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 196, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 522, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 336, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 254, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 518, 343, 344, 0, 0, 274, 771, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 216, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 225,
-	226, 335, 608, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 244, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 138, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 0, 0, 0,
-	0, 141, 142, 0, 143, 0, 0, 0, 0, 294,
-	0, 0, 0, 0, 144, 145, 146, 295, 296, 297,
-	298, 147, 299, 300, 0, 148, 301, 302, 149, 150,
-	0, 0, 303, 304, 305, 0, 512, 306, 0, 0,
-	0, 152, 153, 154, 0, 155, 0, 156, 157, 158,
-	0, 0, 159, 160, 0, 0, 0, 0, 0, 0,
-	0, 161, 162, 352, 163, 307, 164, 308, 309, 0,
-	165, 0, 166, 0, 167, 0, 0, 168, 169, 0,
-	170, 0, 0, 0, 310, 171, 172, 173, 311, 312,
-	0, 0, 0, 174, 175, 313, 314, 315, 0, 176,
-	0, 177, 0, 0, 0, 0, 178, 316, 0, 317,
-	0, 179, 180, 181, 182, 318, 319, 0, 0, 186,
-	0, 183, 0, 0, 184, 320, 185, 321, 322, 323,
-	324, 325, 0, 326, 0, 0, 187, 188, 189, 0,
-	190, 191, 192, 0, 194, 193, 0, 327, 0, 195,
-	0, 0, 511, 0, 0, 197, 0, 198, 199, 200,
-	202, 328, 522, 0, 203, 204, 206, 205, 0, 0,
-	0, 329, 207, 330, 208, 209, 0, 210, 0, 0,
-	211, 0, 0, 212, 331, 0, 213, 0, 332, 214,
-	215, 216, 217, 218, 0, 219, 333, 220, 334, 221,
-	0, 222, 223, 224, 225, 226, 335, 227, 228, 0,
-	229, 230, 231, 232, 233, 235, 236, 234, 237, 238,
-	239, 240, 0, 241, 0, 242, 243, 513, 244, 0,
-	248, 249, 250, 251, 0, 253, 337, 252, 514, 255,
-	0, 256, 245, 246, 257, 0, 258, 338, 339, 259,
-	0, 265, 260, 261, 247, 262, 264, 340, 263, 341,
-	0, 266, 0, 267, 268, 269, 270, 271, 272, 273,
-	0, 518, 343, 344, 0, 0, 274, 516, 345, 346,
-	0, 276, 277, 278, 279, 0, 0, 280, 281, 282,
-	283, 0, 284, 0, 347, 285, 286, 287, 348, 349,
-	138, 0, 288, 0, 0, 0, 0, 289, 290, 291,
-	292, 293, 0, 0, 0, 0, 141, 142, 0, 143,
-	0, 0, 0, 0, 294, 0, 0, 0, 0, 144,
-	145, 146, 295, 296, 297, 298, 147, 299, 300, 0,
-	148, 301, 302, 149, 150, 0, 0, 303, 304, 305,
-	0, 151, 306, 0, 0, 0, 152, 153, 154, 0,
-	155, 0, 156, 157, 158, 0, 0, 159, 160, 0,
-	0, 0, 0, 0, 0, 0, 161, 162, 352, 163,
-	307, 164, 308, 309, 0, 165, 0, 166, 0, 167,
-	0, 0, 168, 169, 0, 170, 0, 0, 0, 310,
-	171, 172, 173, 311, 312, 0, 0, 0, 174, 175,
-	313, 314, 315, 0, 176, 0, 177, 0, 0, 0,
-	0, 178, 316, 0, 317, 0, 179, 180, 181, 182,
-	318, 319, 0, 0, 186, 0, 183, 0, 0, 184,
-	320, 185, 321, 322, 323, 324, 325, 0, 326, 0,
-	0, 187, 188, 189, 0, 190, 191, 192, 0, 194,
-	193, 0, 327, 0, 195, 0, 0, 196, 0, 0,
-	197, 0, 198, 199, 200, 202, 328, 201, 0, 203,
-	204, 206, 205, 0, 0, 0, 329, 207, 330, 208,
-	209, 0, 210, 0, 0, 211, 0, 0, 212, 331,
-	0, 213, 0, 332, 214, 215, 0, 217, 218, 0,
-	219, 333, 220, 334, 221, 0, 222, 223, 224, 0,
-	226, 335, 227, 228, 0, 229, 230, 231, 232, 233,
-	235, 236, 234, 237, 238, 239, 240, 0, 241, 0,
-	242, 243, 336, 0, 0, 248, 249, 250, 251, 0,
-	253, 337, 252, 254, 255, 0, 256, 245, 246, 257,
-	0, 258, 338, 339, 259, 0, 265, 260, 261, 247,
-	262, 264, 340, 263, 341, 0, 266, 0, 267, 268,
-	269, 270, 271, 272, 273, 0, 342, 343, 344, 0,
-	0, 274, 275, 345, 346, 0, 276, 277, 278, 279,
-	0, 0, 280, 281, 282, 283, 0, 284, 0, 347,
-	285, 286, 287, 348, 349, 0, 0, 288, 0, 0,
-	0, 0, 289, 290, 291, 292, 293, 931, 932, 0,
-	951, 952, 953, 961, 962, 963, 0, 0, 0, 0,
+	0, 0, 289, 290, 291, 292, 293, 696, 685, 686,
+	683, 684, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 141, 142, 0, 143, 911, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	729, 297, 730, 147, 731, 732, 0, 148, 301, 302,
+	149, 150, 0, 0, 733, 734, 305, 0, 151, 306,
+	0, 398, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 399, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 735, 164, 736,
+	737, 0, 165, 0, 166, 0, 167, 0, 0, 728,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 692, 0, 0, 174, 175, 738, 739, 740,
+	0, 176, 0, 177, 0, 0, 400, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 402,
+	0, 186, 0, 183, 0, 401, 184, 741, 185, 742,
+	743, 744, 745, 746, 0, 703, 0, 403, 187, 188,
+	189, 404, 190, 191, 192, 0, 194, 193, 0, 327,
+	405, 195, 406, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 407, 203, 204, 206, 205,
+	0, 0, 693, 329, 207, 747, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 408, 213, 409,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 748, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 410, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 411, 258, 749,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 750,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 751, 752, 0, 0, 274, 275,
+	345, 346, 691, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 753, 566, 563, 288, 0, 0, 837, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 398, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 400, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 402, 0, 186, 0, 183, 0,
+	401, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 403, 187, 188, 189, 404, 190, 191, 192,
+	0, 194, 193, 0, 327, 405, 195, 406, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	407, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 411, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 134, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 133, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 566, 563, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 137, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 398, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 400, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 402, 0, 186, 0, 183, 0,
+	401, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 403, 187, 188, 189, 404, 190, 191, 192,
+	0, 194, 193, 0, 327, 405, 195, 406, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	407, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 411, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 566, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 137, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 398, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 399, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 400, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 402, 0, 186, 0, 183, 0,
+	401, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 403, 187, 188, 189, 404, 190, 191, 192,
+	0, 194, 193, 0, 327, 405, 195, 406, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	407, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 408, 213, 409, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 410, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 411, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 1453, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 1451, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 1450, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 1455, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 1448, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 1454, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 1449, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 1453, 151, 306, 0, 0, 1500, 152, 153,
+	154, 0, 155, 1451, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 1455,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 1499, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 1454, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 1503, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 1238, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 627, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 1165, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 874, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 627, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 868, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 627, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	644, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 355, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 354, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 1752, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 1750, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 1745, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 500, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 1130, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 928, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 0, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 871, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 816, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 814, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 810, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 808, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 805, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 801, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 201, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 342, 343, 344, 0, 0, 274, 275,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 796, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 772, 0, 151, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 196, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 522, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 336,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	254, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 518, 343, 344, 0, 0, 274, 773,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 216, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 225, 226, 335, 608, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 244, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 138, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 0,
+	0, 0, 0, 141, 142, 0, 143, 0, 0, 0,
+	0, 294, 0, 0, 0, 0, 144, 145, 146, 295,
+	296, 297, 298, 147, 299, 300, 0, 148, 301, 302,
+	149, 150, 0, 0, 303, 304, 305, 0, 512, 306,
+	0, 0, 0, 152, 153, 154, 0, 155, 0, 156,
+	157, 158, 0, 0, 159, 160, 0, 0, 0, 0,
+	0, 0, 0, 161, 162, 352, 163, 307, 164, 308,
+	309, 0, 165, 0, 166, 0, 167, 0, 0, 168,
+	169, 0, 170, 0, 0, 0, 310, 171, 172, 173,
+	311, 312, 0, 0, 0, 174, 175, 313, 314, 315,
+	0, 176, 0, 177, 0, 0, 0, 0, 178, 316,
+	0, 317, 0, 179, 180, 181, 182, 318, 319, 0,
+	0, 186, 0, 183, 0, 0, 184, 320, 185, 321,
+	322, 323, 324, 325, 0, 326, 0, 0, 187, 188,
+	189, 0, 190, 191, 192, 0, 194, 193, 0, 327,
+	0, 195, 0, 0, 511, 0, 0, 197, 0, 198,
+	199, 200, 202, 328, 522, 0, 203, 204, 206, 205,
+	0, 0, 0, 329, 207, 330, 208, 209, 0, 210,
+	0, 0, 211, 0, 0, 212, 331, 0, 213, 0,
+	332, 214, 215, 216, 217, 218, 0, 219, 333, 220,
+	334, 221, 0, 222, 223, 224, 225, 226, 335, 227,
+	228, 0, 229, 230, 231, 232, 233, 235, 236, 234,
+	237, 238, 239, 240, 0, 241, 0, 242, 243, 513,
+	244, 0, 248, 249, 250, 251, 0, 253, 337, 252,
+	514, 255, 0, 256, 245, 246, 257, 0, 258, 338,
+	339, 259, 0, 265, 260, 261, 247, 262, 264, 340,
+	263, 341, 0, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 0, 518, 343, 344, 0, 0, 274, 516,
+	345, 346, 0, 276, 277, 278, 279, 0, 0, 280,
+	281, 282, 283, 0, 284, 0, 347, 285, 286, 287,
+	348, 349, 138, 0, 288, 0, 0, 0, 0, 289,
+	290, 291, 292, 293, 0, 0, 0, 0, 141, 142,
+	0, 143, 0, 0, 0, 0, 294, 0, 0, 0,
+	0, 144, 145, 146, 295, 296, 297, 298, 147, 299,
+	300, 0, 148, 301, 302, 149, 150, 0, 0, 303,
+	304, 305, 0, 151, 306, 0, 0, 0, 152, 153,
+	154, 0, 155, 0, 156, 157, 158, 0, 0, 159,
+	160, 0, 0, 0, 0, 0, 0, 0, 161, 162,
+	352, 163, 307, 164, 308, 309, 0, 165, 0, 166,
+	0, 167, 0, 0, 168, 169, 0, 170, 0, 0,
+	0, 310, 171, 172, 173, 311, 312, 0, 0, 0,
+	174, 175, 313, 314, 315, 0, 176, 0, 177, 0,
+	0, 0, 0, 178, 316, 0, 317, 0, 179, 180,
+	181, 182, 318, 319, 0, 0, 186, 0, 183, 0,
+	0, 184, 320, 185, 321, 322, 323, 324, 325, 0,
+	326, 0, 0, 187, 188, 189, 0, 190, 191, 192,
+	0, 194, 193, 0, 327, 0, 195, 0, 0, 196,
+	0, 0, 197, 0, 198, 199, 200, 202, 328, 201,
+	0, 203, 204, 206, 205, 0, 0, 0, 329, 207,
+	330, 208, 209, 0, 210, 0, 0, 211, 0, 0,
+	212, 331, 0, 213, 0, 332, 214, 215, 0, 217,
+	218, 0, 219, 333, 220, 334, 221, 0, 222, 223,
+	224, 0, 226, 335, 227, 228, 0, 229, 230, 231,
+	232, 233, 235, 236, 234, 237, 238, 239, 240, 0,
+	241, 0, 242, 243, 336, 0, 0, 248, 249, 250,
+	251, 0, 253, 337, 252, 254, 255, 0, 256, 245,
+	246, 257, 0, 258, 338, 339, 259, 0, 265, 260,
+	261, 247, 262, 264, 340, 263, 341, 0, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 0, 342, 343,
+	344, 0, 0, 274, 275, 345, 346, 0, 276, 277,
+	278, 279, 0, 0, 280, 281, 282, 283, 0, 284,
+	0, 347, 285, 286, 287, 348, 349, 0, 0, 288,
+	0, 0, 0, 0, 289, 290, 291, 292, 293, 935,
+	936, 0, 955, 956, 957, 965, 966, 967, 0, 0,
+	0, 0, 0, 0, 0, 958, 0, 0, 0, 0,
+	0, 0, 938, 0, 0, 969, 0, 935, 936, 0,
+	955, 956, 957, 965, 966, 967, 0, 0, 0, 0,
+	0, 0, 0, 958, 0, 0, 937, 0, 0, 0,
+	938, 0, 952, 969, 0, 935, 936, 0, 955, 956,
+	957, 965, 966, 967, 0, 0, 0, 0, 0, 0,
+	0, 958, 0, 0, 937, 0, 0, 0, 938, 0,
+	952, 969, 0, 0, 935, 936, 0, 955, 956, 957,
+	965, 966, 967, 0, 0, 0, 0, 0, 0, 0,
+	958, 0, 937, 0, 0, 0, 943, 938, 952, 0,
+	969, 0, 935, 936, 0, 955, 956, 957, 965, 966,
+	967, 0, 0, 0, 0, 962, 970, 0, 958, 0,
+	0, 937, 0, 0, 943, 938, 0, 952, 969, 0,
+	0, 0, 0, 968, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 962, 970, 0, 0, 0, 960, 937,
+	0, 0, 943, 0, 953, 952, 0, 0, 0, 0,
+	0, 968, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 962, 970, 0, 0, 0, 960, 0, 0, 959,
+	0, 943, 953, 0, 0, 0, 0, 0, 0, 968,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	962, 970, 0, 0, 960, 0, 0, 959, 0, 943,
+	953, 0, 0, 0, 0, 0, 0, 0, 968, 0,
+	0, 0, 0, 0, 0, 0, 0, 954, 962, 970,
+	0, 0, 0, 960, 0, 959, 0, 0, 0, 953,
+	0, 0, 0, 963, 0, 0, 968, 0, 0, 0,
+	0, 0, 0, 0, 0, 954, 0, 0, 0, 0,
+	0, 960, 0, 0, 959, 0, 0, 953, 0, 0,
+	0, 963, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 954, 0, 0, 0, 0, 0, 0,
-	934, 0, 0, 965, 0, 931, 932, 0, 951, 952,
-	953, 961, 962, 963, 0, 0, 0, 0, 0, 0,
-	0, 954, 0, 0, 933, 0, 0, 0, 934, 0,
-	948, 965, 0, 931, 932, 0, 951, 952, 953, 961,
-	962, 963, 0, 0, 0, 0, 0, 0, 0, 954,
-	0, 0, 933, 0, 0, 0, 934, 0, 948, 965,
-	0, 0, 931, 932, 0, 951, 952, 953, 961, 962,
-	963, 0, 0, 0, 0, 0, 0, 0, 954, 0,
-	933, 0, 0, 0, 939, 934, 948, 0, 965, 0,
-	931, 932, 0, 951, 952, 953, 961, 962, 963, 0,
-	0, 0, 0, 958, 966, 0, 954, 0, 0, 933,
-	0, 0, 939, 934, 0, 948, 965, 0, 0, 0,
-	0, 964, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 958, 966, 0, 0, 0, 956, 933, 0, 0,
-	939, 0, 949, 948, 0, 0, 0, 0, 0, 964,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 958,
-	966, 0, 0, 0, 956, 0, 0, 955, 0, 939,
-	949, 0, 0, 0, 0, 0, 0, 964, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 958, 966,
-	0, 0, 956, 0, 0, 955, 0, 939, 949, 0,
-	0, 0, 0, 0, 0, 0, 964, 0, 0, 0,
-	0, 0, 0, 0, 0, 950, 958, 966, 0, 0,
-	0, 956, 0, 955, 0, 0, 0, 949, 0, 0,
-	0, 959, 0, 0, 964, 0, 0, 0, 0, 0,
-	0, 0, 0, 950, 0, 0, 0, 0, 0, 956,
-	0, 0, 955, 0, 0, 949, 0, 0, 0, 959,
+	0, 0, 959, 0, 0, 0, 0, 0, 0, 963,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 950, 0, 0, 0, 0, 0, 0, 0, 0,
-	955, 0, 0, 0, 0, 0, 0, 959, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 957, 0,
-	950, 945, 946, 947, 960, 0, 944, 942, 943, 935,
-	936, 937, 938, 940, 941, 0, 959, 0, 0, 0,
-	0, 0, 1621, 0, 0, 0, 957, 0, 950, 945,
-	946, 947, 960, 0, 944, 942, 943, 935, 936, 937,
-	938, 940, 941, 0, 959, 0, 0, 2194, 0, 0,
-	0, 0, 0, 0, 957, 0, 0, 945, 946, 947,
-	960, 0, 944, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 0, 0, 0, 2150, 0, 0, 0, 0,
-	0, 0, 0, 957, 0, 0, 945, 946, 947, 960,
-	0, 944, 942, 943, 935, 936, 937, 938, 940, 941,
-	0, 0, 0, 0, 2111, 0, 0, 0, 0, 0,
-	0, 957, 0, 0, 945, 946, 947, 960, 0, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 0, 0,
-	931, 932, 2085, 951, 952, 953, 961, 962, 963, 0,
+	961, 0, 954, 949, 950, 951, 964, 0, 948, 946,
+	947, 939, 940, 941, 942, 944, 945, 0, 963, 0,
+	0, 2167, 0, 0, 0, 0, 0, 0, 961, 0,
+	954, 949, 950, 951, 964, 0, 948, 946, 947, 939,
+	940, 941, 942, 944, 945, 0, 963, 0, 0, 2128,
+	0, 0, 0, 0, 0, 0, 961, 0, 0, 949,
+	950, 951, 964, 0, 948, 946, 947, 939, 940, 941,
+	942, 944, 945, 0, 0, 0, 0, 2102, 0, 0,
+	0, 0, 0, 0, 0, 961, 0, 0, 949, 950,
+	951, 964, 0, 948, 946, 947, 939, 940, 941, 942,
+	944, 945, 0, 0, 0, 0, 2097, 0, 0, 0,
+	0, 0, 0, 961, 0, 0, 949, 950, 951, 964,
+	0, 948, 946, 947, 939, 940, 941, 942, 944, 945,
+	0, 0, 935, 936, 2093, 955, 956, 957, 965, 966,
+	967, 0, 0, 0, 0, 0, 0, 0, 958, 0,
+	0, 0, 0, 0, 0, 938, 0, 0, 969, 0,
+	935, 936, 0, 955, 956, 957, 965, 966, 967, 0,
+	0, 0, 0, 0, 0, 0, 958, 0, 0, 937,
+	0, 0, 0, 938, 0, 952, 969, 0, 935, 936,
+	0, 955, 956, 957, 965, 966, 967, 0, 0, 0,
+	0, 0, 0, 0, 958, 0, 0, 937, 0, 0,
+	0, 938, 0, 952, 969, 0, 0, 935, 936, 0,
+	955, 956, 957, 965, 966, 967, 0, 0, 0, 0,
+	0, 0, 0, 958, 0, 937, 0, 0, 0, 943,
+	938, 952, 0, 969, 0, 935, 936, 0, 955, 956,
+	957, 965, 966, 967, 0, 0, 0, 0, 962, 970,
+	0, 958, 0, 0, 937, 0, 0, 943, 938, 0,
+	952, 969, 0, 0, 0, 0, 968, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 962, 970, 0, 0,
+	0, 960, 937, 0, 0, 943, 0, 953, 952, 0,
+	0, 0, 0, 0, 968, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 962, 970, 0, 0, 0, 960,
+	0, 0, 959, 0, 943, 953, 0, 0, 0, 0,
+	0, 0, 968, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 962, 970, 0, 0, 960, 0, 0,
+	959, 0, 943, 953, 0, 0, 0, 0, 0, 0,
+	0, 968, 0, 0, 0, 0, 0, 0, 0, 0,
+	954, 962, 970, 0, 0, 0, 960, 0, 959, 0,
+	0, 0, 953, 0, 0, 0, 963, 0, 0, 968,
+	0, 0, 0, 0, 0, 0, 0, 0, 954, 0,
+	0, 0, 0, 0, 960, 0, 0, 959, 0, 0,
+	953, 0, 0, 0, 963, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 954, 0, 0, 0,
-	0, 0, 0, 934, 0, 0, 965, 0, 931, 932,
-	0, 951, 952, 953, 961, 962, 963, 0, 0, 0,
-	0, 0, 0, 0, 954, 0, 0, 933, 0, 0,
-	0, 934, 0, 948, 965, 0, 931, 932, 0, 951,
-	952, 953, 961, 962, 963, 0, 0, 0, 0, 0,
-	0, 0, 954, 0, 0, 933, 0, 0, 0, 934,
-	0, 948, 965, 0, 0, 931, 932, 0, 951, 952,
-	953, 961, 962, 963, 0, 0, 0, 0, 0, 0,
-	0, 954, 0, 933, 0, 0, 0, 939, 934, 948,
-	0, 965, 0, 931, 932, 0, 951, 952, 953, 961,
-	962, 963, 0, 0, 0, 0, 958, 966, 0, 954,
-	0, 0, 933, 0, 0, 939, 934, 0, 948, 965,
-	0, 0, 0, 0, 964, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 958, 966, 0, 0, 0, 956,
-	933, 0, 0, 939, 0, 949, 948, 0, 0, 0,
-	0, 0, 964, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 958, 966, 0, 0, 0, 956, 0, 0,
-	955, 0, 939, 949, 0, 0, 0, 0, 0, 0,
-	964, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 958, 966, 0, 0, 956, 0, 0, 955, 0,
-	939, 949, 0, 0, 0, 0, 0, 0, 0, 964,
-	0, 0, 0, 0, 0, 0, 0, 0, 950, 958,
-	966, 0, 0, 0, 956, 0, 955, 0, 0, 0,
-	949, 0, 0, 0, 959, 0, 0, 964, 0, 0,
-	0, 0, 0, 0, 0, 0, 950, 0, 0, 0,
-	0, 0, 956, 0, 0, 955, 0, 0, 949, 0,
+	0, 0, 0, 0, 0, 959, 0, 0, 0, 0,
+	0, 0, 963, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 961, 0, 954, 949, 950, 951, 964,
+	0, 948, 946, 947, 939, 940, 941, 942, 944, 945,
+	0, 963, 0, 0, 2039, 0, 0, 0, 0, 0,
+	0, 961, 0, 954, 949, 950, 951, 964, 0, 948,
+	946, 947, 939, 940, 941, 942, 944, 945, 0, 963,
+	0, 0, 2009, 0, 0, 0, 0, 0, 0, 961,
+	0, 0, 949, 950, 951, 964, 0, 948, 946, 947,
+	939, 940, 941, 942, 944, 945, 0, 0, 0, 0,
+	2008, 0, 0, 0, 0, 0, 0, 0, 961, 0,
+	0, 949, 950, 951, 964, 0, 948, 946, 947, 939,
+	940, 941, 942, 944, 945, 0, 0, 0, 0, 1928,
+	0, 0, 0, 0, 0, 0, 961, 0, 0, 949,
+	950, 951, 964, 0, 948, 946, 947, 939, 940, 941,
+	942, 944, 945, 0, 0, 935, 936, 1846, 955, 956,
+	957, 965, 966, 967, 0, 0, 0, 0, 0, 0,
+	0, 958, 0, 0, 0, 0, 0, 0, 938, 0,
+	0, 969, 0, 935, 936, 0, 955, 956, 957, 965,
+	966, 967, 0, 0, 0, 0, 0, 0, 0, 958,
+	0, 0, 937, 0, 0, 0, 938, 0, 952, 969,
+	0, 935, 936, 0, 955, 956, 957, 965, 966, 967,
+	0, 0, 0, 0, 0, 0, 0, 958, 0, 0,
+	937, 0, 0, 0, 938, 0, 952, 969, 0, 0,
+	0, 0, 0, 0, 0, 935, 936, 0, 955, 956,
+	957, 965, 966, 967, 0, 0, 0, 0, 937, 0,
+	0, 958, 943, 0, 952, 0, 0, 0, 938, 0,
+	0, 969, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 962, 970, 0, 0, 0, 0, 0, 0, 0,
+	943, 0, 937, 0, 0, 0, 0, 0, 952, 968,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 962,
+	970, 0, 0, 0, 960, 0, 0, 0, 943, 0,
+	953, 0, 0, 0, 0, 0, 0, 968, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 962, 970, 0,
+	0, 0, 960, 0, 0, 959, 0, 0, 953, 0,
+	0, 0, 943, 2265, 0, 968, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	960, 962, 970, 959, 0, 0, 953, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 968,
+	0, 0, 0, 954, 0, 0, 0, 0, 0, 0,
+	0, 959, 0, 0, 960, 0, 0, 0, 0, 963,
+	953, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 954, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 959, 0, 963, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 954,
+	0, 2264, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 963, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 961, 0, 0, 949,
+	950, 951, 964, 954, 948, 946, 947, 939, 940, 941,
+	942, 944, 945, 0, 0, 0, 0, 1822, 0, 963,
+	0, 0, 0, 0, 961, 0, 0, 949, 950, 951,
+	964, 0, 948, 946, 947, 939, 940, 941, 942, 944,
+	945, 0, 0, 0, 0, 1339, 0, 0, 0, 0,
+	0, 0, 961, 0, 0, 949, 950, 951, 964, 0,
+	948, 946, 947, 939, 940, 941, 942, 944, 945, 0,
+	0, 1602, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 961, 0, 0, 949,
+	950, 951, 964, 0, 948, 946, 947, 939, 940, 941,
+	942, 944, 945, 935, 936, 0, 955, 956, 957, 965,
+	966, 967, 0, 0, 0, 0, 0, 0, 0, 958,
+	0, 0, 0, 0, 0, 0, 938, 0, 0, 969,
+	0, 935, 936, 0, 955, 956, 957, 965, 966, 967,
+	0, 0, 0, 0, 0, 0, 0, 958, 0, 0,
+	937, 0, 1482, 0, 938, 0, 952, 969, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 937, 0,
+	0, 0, 1213, 0, 952, 0, 0, 0, 935, 936,
+	0, 955, 956, 957, 965, 966, 967, 0, 0, 0,
+	0, 0, 0, 0, 958, 0, 0, 0, 1212, 0,
+	943, 938, 1672, 1483, 969, 1671, 935, 936, 0, 955,
+	956, 957, 965, 966, 967, 0, 0, 0, 0, 962,
+	970, 0, 958, 0, 0, 937, 0, 0, 943, 938,
+	0, 952, 969, 0, 0, 0, 0, 968, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 962, 970, 0,
+	0, 0, 960, 937, 0, 0, 0, 0, 953, 952,
+	0, 0, 0, 0, 0, 968, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	960, 0, 0, 959, 0, 943, 953, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 962, 970, 0, 0, 0, 0,
+	0, 959, 0, 943, 0, 0, 0, 0, 0, 0,
+	0, 0, 968, 0, 0, 0, 0, 0, 0, 0,
+	0, 954, 962, 970, 0, 0, 0, 960, 0, 0,
+	0, 0, 0, 953, 0, 0, 0, 963, 0, 0,
+	968, 0, 0, 0, 0, 0, 0, 0, 0, 954,
+	0, 0, 0, 0, 0, 960, 0, 0, 959, 0,
+	0, 953, 0, 0, 0, 963, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 959, 547, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 961, 0, 954, 949, 950, 951,
+	964, 0, 948, 946, 947, 939, 940, 941, 942, 944,
+	945, 0, 963, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 961, 0, 954, 949, 950, 951, 964, 0,
+	948, 946, 947, 939, 940, 941, 942, 944, 945, 0,
+	963, 0, 0, 0, 0, 0, 0, 0, 0, 935,
+	936, 0, 955, 956, 957, 965, 966, 967, 0, 0,
+	0, 0, 0, 0, 0, 958, 0, 0, 0, 0,
+	0, 0, 938, 0, 0, 969, 0, 0, 0, 961,
+	0, 0, 949, 950, 951, 964, 0, 948, 946, 947,
+	939, 940, 941, 942, 944, 945, 937, 0, 0, 0,
+	0, 0, 952, 0, 0, 0, 0, 961, 0, 0,
+	949, 950, 951, 964, 0, 948, 946, 947, 939, 940,
+	941, 942, 944, 945, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 935, 936, 0, 955, 956, 957,
+	965, 966, 967, 0, 0, 0, 0, 0, 0, 0,
+	958, 0, 0, 0, 0, 0, 943, 938, 0, 0,
+	969, 0, 935, 936, 0, 955, 956, 957, 965, 966,
+	967, 0, 0, 0, 0, 962, 970, 0, 958, 0,
+	0, 937, 1673, 0, 0, 938, 0, 952, 969, 0,
+	0, 0, 0, 968, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 960, 937,
+	0, 0, 0, 0, 953, 952, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 959,
+	0, 943, 0, 0, 0, 0, 1678, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	962, 970, 0, 0, 0, 0, 0, 0, 0, 943,
+	0, 0, 0, 0, 0, 0, 0, 0, 968, 0,
+	0, 0, 0, 0, 0, 0, 0, 954, 962, 970,
+	0, 0, 0, 960, 0, 0, 0, 0, 0, 953,
+	0, 0, 0, 963, 0, 0, 968, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 960, 0, 0, 959, 0, 0, 953, 1826, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 959, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 950, 0, 0, 0, 0, 0,
-	0, 0, 0, 955, 0, 0, 0, 0, 0, 0,
-	959, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 957, 0, 950, 945, 946, 947, 960, 0, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 0, 959,
-	0, 0, 2080, 0, 0, 0, 0, 0, 0, 957,
-	0, 950, 945, 946, 947, 960, 0, 944, 942, 943,
-	935, 936, 937, 938, 940, 941, 0, 959, 0, 0,
-	2076, 0, 0, 0, 0, 0, 0, 957, 0, 0,
-	945, 946, 947, 960, 0, 944, 942, 943, 935, 936,
-	937, 938, 940, 941, 0, 0, 0, 0, 2023, 0,
-	0, 0, 0, 0, 0, 0, 957, 0, 0, 945,
-	946, 947, 960, 0, 944, 942, 943, 935, 936, 937,
-	938, 940, 941, 0, 0, 0, 0, 1993, 0, 0,
-	0, 0, 0, 0, 957, 0, 0, 945, 946, 947,
-	960, 0, 944, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 0, 931, 932, 1992, 951, 952, 953, 961,
-	962, 963, 0, 0, 0, 0, 0, 0, 0, 954,
-	0, 0, 0, 0, 0, 0, 934, 0, 0, 965,
-	0, 931, 932, 0, 951, 952, 953, 961, 962, 963,
-	0, 0, 0, 0, 0, 0, 0, 954, 0, 0,
-	933, 0, 0, 0, 934, 0, 948, 965, 0, 931,
-	932, 0, 951, 952, 953, 961, 962, 963, 0, 0,
-	0, 0, 0, 0, 0, 954, 0, 0, 933, 0,
-	0, 0, 934, 0, 948, 965, 0, 0, 931, 932,
-	0, 951, 952, 953, 961, 962, 963, 0, 0, 0,
-	0, 0, 0, 0, 954, 0, 933, 0, 0, 0,
-	939, 934, 948, 0, 965, 0, 931, 932, 0, 951,
-	952, 953, 961, 962, 963, 0, 0, 0, 0, 958,
-	966, 0, 954, 0, 0, 933, 0, 0, 939, 934,
-	0, 948, 965, 0, 0, 0, 0, 964, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 958, 966, 0,
-	0, 0, 956, 933, 0, 0, 939, 0, 949, 948,
-	0, 0, 0, 0, 0, 964, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 958, 966, 0, 0, 0,
-	956, 0, 0, 955, 0, 939, 949, 0, 0, 0,
-	0, 0, 0, 964, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 958, 966, 0, 0, 956, 0,
-	0, 955, 0, 939, 949, 0, 0, 0, 0, 0,
-	0, 0, 964, 0, 0, 0, 0, 0, 0, 0,
-	0, 950, 958, 966, 0, 0, 0, 956, 0, 955,
-	0, 0, 0, 949, 0, 0, 0, 959, 0, 0,
-	964, 0, 0, 0, 0, 0, 0, 0, 0, 950,
-	0, 0, 0, 0, 0, 956, 0, 0, 955, 0,
-	0, 949, 0, 0, 0, 959, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 950, 0, 0,
-	0, 0, 0, 0, 0, 0, 955, 0, 0, 0,
-	0, 0, 0, 959, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 957, 0, 950, 945, 946, 947,
-	960, 0, 944, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 959, 0, 0, 1913, 0, 0, 0, 0,
-	0, 0, 957, 0, 950, 945, 946, 947, 960, 0,
-	944, 942, 943, 935, 936, 937, 938, 940, 941, 0,
-	959, 0, 0, 1831, 0, 0, 0, 0, 0, 0,
-	957, 0, 0, 945, 946, 947, 960, 0, 944, 942,
-	943, 935, 936, 937, 938, 940, 941, 0, 0, 0,
-	0, 1807, 0, 0, 0, 0, 0, 0, 0, 957,
-	0, 0, 945, 946, 947, 960, 0, 944, 942, 943,
-	935, 936, 937, 938, 940, 941, 0, 0, 0, 0,
-	1332, 0, 0, 0, 0, 0, 0, 957, 0, 0,
-	945, 946, 947, 960, 0, 944, 942, 943, 935, 936,
-	937, 938, 940, 941, 931, 932, 1591, 951, 952, 953,
-	961, 962, 963, 0, 0, 0, 0, 0, 0, 0,
-	954, 0, 0, 0, 0, 0, 0, 934, 0, 0,
-	965, 0, 931, 932, 0, 951, 952, 953, 961, 962,
-	963, 0, 0, 0, 0, 0, 0, 0, 954, 0,
-	0, 933, 0, 0, 0, 934, 0, 948, 965, 0,
-	931, 932, 0, 951, 952, 953, 961, 962, 963, 0,
-	0, 0, 0, 0, 0, 0, 954, 0, 0, 933,
-	0, 1474, 0, 934, 0, 948, 965, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 933, 0, 0,
-	0, 939, 2248, 948, 0, 0, 0, 1629, 1630, 0,
-	1647, 1648, 1649, 0, 0, 0, 0, 0, 0, 0,
-	958, 966, 0, 1800, 0, 0, 0, 0, 0, 939,
-	0, 1661, 1475, 0, 1660, 0, 0, 0, 964, 0,
-	1629, 1630, 0, 1647, 1648, 1649, 0, 0, 958, 966,
-	0, 0, 0, 956, 0, 0, 1799, 939, 0, 949,
-	1644, 0, 0, 0, 0, 0, 964, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 958, 966, 0, 0,
-	0, 956, 0, 0, 955, 0, 0, 949, 0, 0,
-	0, 0, 0, 1644, 964, 0, 0, 0, 0, 0,
-	2247, 0, 0, 0, 0, 0, 0, 0, 0, 956,
-	0, 0, 955, 0, 1635, 949, 0, 0, 0, 0,
+	961, 0, 954, 949, 950, 951, 964, 0, 948, 946,
+	947, 939, 940, 941, 942, 944, 945, 0, 963, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 935, 936,
+	954, 955, 956, 957, 965, 966, 967, 0, 0, 0,
+	0, 0, 0, 0, 958, 0, 963, 0, 0, 0,
+	0, 938, 0, 0, 969, 0, 935, 936, 0, 955,
+	956, 957, 965, 966, 967, 0, 0, 0, 0, 0,
+	0, 0, 958, 0, 0, 937, 1631, 0, 0, 938,
+	0, 952, 969, 0, 0, 961, 0, 0, 949, 950,
+	951, 964, 0, 948, 946, 947, 939, 940, 941, 942,
+	944, 945, 0, 937, 0, 0, 0, 0, 0, 952,
+	0, 0, 0, 961, 0, 0, 949, 950, 951, 964,
+	0, 948, 946, 947, 939, 940, 941, 942, 944, 945,
+	0, 0, 0, 0, 0, 943, 0, 0, 0, 0,
+	0, 935, 936, 0, 955, 956, 957, 965, 966, 967,
+	0, 0, 0, 0, 962, 970, 0, 958, 0, 0,
+	0, 0, 0, 943, 938, 0, 0, 969, 0, 0,
+	0, 0, 968, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 962, 970, 0, 0, 0, 960, 937, 0,
+	0, 0, 0, 953, 952, 0, 0, 0, 0, 0,
+	968, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 960, 0, 0, 959, 0,
+	0, 953, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1638, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 959, 0, 943, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 954, 962, 970, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 963, 0, 0, 968, 0, 0, 0, 0,
+	0, 0, 0, 0, 954, 0, 0, 0, 0, 0,
+	960, 0, 0, 0, 0, 0, 953, 0, 0, 0,
+	963, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	935, 936, 0, 955, 956, 957, 965, 966, 967, 0,
+	0, 959, 0, 0, 0, 0, 958, 0, 0, 0,
+	0, 0, 0, 938, 0, 0, 969, 0, 0, 961,
+	0, 0, 949, 950, 951, 964, 0, 948, 946, 947,
+	939, 940, 941, 942, 944, 945, 0, 937, 0, 0,
+	0, 0, 0, 952, 0, 0, 0, 961, 0, 954,
+	949, 950, 951, 964, 0, 948, 946, 947, 939, 940,
+	941, 942, 944, 945, 0, 963, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 935, 936, 0, 955, 956,
+	957, 965, 966, 967, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 943, 938, 0,
+	0, 969, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 962, 970, 0, 0,
+	0, 0, 937, 0, 0, 0, 0, 0, 952, 0,
+	0, 0, 961, 0, 968, 949, 950, 951, 964, 0,
+	948, 946, 947, 939, 940, 941, 942, 944, 945, 960,
+	0, 0, 0, 0, 0, 953, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 950, 0, 0, 0, 0, 0, 0, 0,
-	955, 0, 0, 0, 0, 0, 0, 1635, 959, 0,
-	0, 1650, 0, 0, 0, 0, 0, 1208, 0, 0,
-	950, 0, 0, 931, 932, 0, 951, 952, 953, 961,
-	962, 963, 1645, 0, 0, 0, 959, 0, 0, 954,
-	0, 0, 0, 1207, 1650, 0, 934, 0, 950, 965,
-	0, 931, 932, 0, 951, 952, 953, 961, 962, 963,
-	0, 0, 0, 0, 959, 1645, 0, 954, 0, 0,
-	933, 0, 0, 0, 934, 957, 948, 965, 945, 946,
-	947, 960, 0, 944, 942, 943, 935, 936, 937, 938,
-	940, 941, 0, 0, 0, 0, 0, 0, 933, 0,
-	0, 0, 0, 957, 948, 1646, 945, 946, 947, 960,
-	0, 944, 942, 943, 935, 936, 937, 938, 940, 941,
+	0, 0, 943, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	939, 957, 0, 0, 945, 946, 947, 960, 1646, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 0, 958,
-	966, 0, 0, 0, 0, 0, 0, 0, 939, 0,
-	0, 0, 0, 0, 0, 0, 0, 964, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 958, 966, 0,
-	0, 0, 956, 0, 0, 0, 0, 0, 949, 0,
-	0, 1641, 1642, 1643, 0, 964, 1640, 1638, 1639, 1631,
-	1632, 1633, 1634, 1636, 1637, 0, 0, 0, 0, 0,
-	956, 0, 0, 955, 0, 0, 949, 0, 0, 0,
-	0, 0, 0, 0, 1641, 1642, 1643, 0, 0, 1640,
-	1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637, 0, 0,
-	0, 955, 547, 0, 0, 0, 0, 0, 0, 0,
+	0, 962, 970, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 968,
+	0, 0, 0, 0, 0, 0, 0, 0, 954, 0,
+	0, 0, 0, 0, 960, 0, 0, 0, 0, 0,
+	953, 0, 0, 0, 963, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 950, 0, 0, 931, 932, 0, 951, 952, 953,
-	961, 962, 963, 0, 0, 0, 0, 959, 0, 0,
-	954, 0, 0, 0, 0, 0, 0, 934, 0, 950,
-	965, 0, 931, 932, 0, 951, 952, 953, 961, 962,
-	963, 0, 0, 0, 0, 959, 0, 0, 954, 0,
-	0, 933, 0, 0, 0, 934, 0, 948, 965, 0,
-	931, 932, 0, 951, 952, 953, 961, 962, 963, 0,
-	0, 0, 0, 0, 0, 0, 954, 0, 0, 933,
-	1662, 0, 0, 934, 957, 948, 965, 945, 946, 947,
-	960, 0, 944, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 0, 0, 0, 0, 0, 933, 0, 0,
-	0, 939, 957, 948, 0, 945, 946, 947, 960, 0,
-	944, 942, 943, 935, 936, 937, 938, 940, 941, 0,
-	958, 966, 0, 0, 0, 0, 0, 0, 0, 939,
-	0, 0, 0, 0, 1667, 0, 0, 0, 964, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 958, 966,
-	0, 0, 0, 956, 0, 0, 0, 939, 0, 949,
-	0, 0, 0, 0, 0, 0, 964, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 958, 966, 0, 0,
-	0, 956, 0, 0, 955, 0, 0, 949, 0, 0,
-	0, 0, 0, 0, 964, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 956,
-	0, 0, 955, 0, 0, 949, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 950, 0, 0, 0, 0, 0, 0, 0,
-	955, 0, 0, 0, 0, 0, 0, 0, 959, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	950, 0, 0, 931, 932, 0, 951, 952, 953, 961,
-	962, 963, 0, 1811, 0, 0, 959, 0, 0, 954,
-	0, 0, 0, 0, 0, 0, 934, 0, 950, 965,
-	0, 931, 932, 0, 951, 952, 953, 961, 962, 963,
-	0, 0, 0, 0, 959, 0, 0, 954, 0, 0,
-	933, 1620, 0, 0, 934, 957, 948, 965, 945, 946,
-	947, 960, 0, 944, 942, 943, 935, 936, 937, 938,
-	940, 941, 0, 0, 0, 0, 0, 0, 933, 0,
-	0, 0, 0, 957, 948, 0, 945, 946, 947, 960,
-	0, 944, 942, 943, 935, 936, 937, 938, 940, 941,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	939, 957, 0, 0, 945, 946, 947, 960, 0, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 0, 958,
-	966, 0, 0, 0, 0, 0, 0, 0, 939, 0,
-	0, 0, 0, 0, 0, 0, 0, 964, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 958, 966, 0,
-	0, 0, 956, 0, 0, 0, 0, 0, 949, 0,
-	0, 0, 0, 0, 0, 964, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	956, 0, 0, 955, 0, 0, 949, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1627, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 955, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 961, 0, 954, 949, 950, 951, 964, 0, 948,
+	946, 947, 939, 940, 941, 942, 944, 945, 0, 963,
+	0, 0, 0, 0, 0, 0, 1061, 1051, 1080, 1043,
+	1071, 1070, 0, 0, 1045, 1044, 0, 0, 0, 0,
+	1082, 1081, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 950, 0, 0, 931, 932, 0, 951, 952, 953,
-	961, 962, 963, 0, 0, 0, 0, 959, 0, 0,
-	954, 0, 0, 0, 0, 0, 0, 934, 0, 950,
-	965, 0, 931, 932, 0, 951, 952, 953, 961, 962,
-	963, 0, 0, 0, 0, 959, 0, 0, 954, 0,
-	0, 933, 0, 0, 0, 934, 0, 948, 965, 0,
-	931, 932, 0, 951, 952, 953, 961, 962, 963, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 933,
-	0, 0, 0, 934, 957, 948, 965, 945, 946, 947,
-	960, 0, 944, 942, 943, 935, 936, 937, 938, 940,
-	941, 0, 0, 0, 0, 0, 0, 933, 0, 0,
-	0, 939, 957, 948, 0, 945, 946, 947, 960, 0,
-	944, 942, 943, 935, 936, 937, 938, 940, 941, 0,
-	958, 966, 1629, 1630, 0, 1647, 1648, 1649, 0, 939,
-	0, 0, 0, 0, 0, 0, 0, 0, 964, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 958, 966,
-	0, 0, 0, 956, 0, 0, 0, 939, 0, 949,
-	0, 0, 0, 0, 0, 0, 964, 0, 0, 0,
-	0, 0, 0, 0, 0, 1644, 958, 966, 0, 0,
-	0, 956, 0, 0, 955, 0, 0, 949, 0, 0,
-	0, 0, 0, 0, 964, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 956,
-	0, 0, 0, 0, 0, 949, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1635,
-	0, 0, 950, 0, 0, 0, 0, 0, 931, 932,
-	0, 951, 952, 953, 961, 962, 963, 0, 959, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	950, 934, 0, 0, 965, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 959, 0, 0, 0,
-	0, 0, 0, 0, 0, 933, 0, 1645, 950, 0,
-	0, 948, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 959, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 957, 0, 0, 945, 946,
-	947, 960, 0, 944, 942, 943, 935, 936, 937, 938,
-	940, 941, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 957, 0, 939, 945, 946, 947, 960,
-	0, 944, 942, 943, 935, 936, 937, 938, 940, 941,
-	1646, 0, 0, 0, 958, 966, 0, 0, 0, 0,
-	0, 957, 0, 0, 945, 946, 947, 960, 0, 944,
-	942, 943, 935, 936, 937, 938, 940, 941, 0, 0,
-	1056, 1046, 1075, 1038, 1066, 1065, 0, 956, 1040, 1039,
-	0, 0, 0, 949, 1077, 1076, 0, 0, 0, 0,
+	0, 0, 0, 1076, 0, 1068, 1067, 0, 0, 0,
+	0, 0, 0, 0, 0, 1066, 961, 0, 0, 949,
+	950, 951, 964, 0, 948, 946, 947, 939, 940, 941,
+	942, 944, 945, 1065, 1063, 1064, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1061, 1051, 1080, 1043,
+	1071, 1070, 0, 0, 1045, 1044, 0, 0, 0, 0,
+	1082, 1081, 0, 1054, 1053, 1055, 1056, 1057, 1058, 1059,
+	0, 1079, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1076, 0, 1068, 1067, 0, 0, 1047,
+	0, 0, 0, 0, 0, 1066, 0, 0, 0, 0,
+	0, 1069, 0, 0, 0, 1052, 0, 0, 0, 0,
+	0, 0, 0, 1065, 1063, 1064, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1071, 0, 1063,
-	1062, 0, 0, 0, 0, 0, 1641, 1642, 1643, 1061,
-	0, 1640, 1638, 1639, 1631, 1632, 1633, 1634, 1636, 1637,
-	0, 0, 0, 0, 0, 0, 0, 1060, 1058, 1059,
-	0, 0, 0, 0, 0, 0, 950, 0, 0, 0,
+	0, 0, 0, 0, 1062, 0, 0, 0, 1274, 1272,
+	1273, 1276, 1275, 1054, 1053, 1055, 1056, 1057, 1058, 1059,
+	0, 1079, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1048, 0, 0, 0, 0, 0,
+	0, 0, 505, 0, 0, 1060, 1049, 0, 0, 1047,
+	0, 0, 0, 0, 0, 1084, 0, 0, 0, 0,
+	574, 1069, 0, 0, 0, 1052, 0, 1046, 0, 0,
+	1077, 1078, 572, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 959, 0, 0, 0, 0, 1049, 1048, 1050,
-	1051, 1052, 1053, 1054, 0, 1074, 0, 0, 0, 0,
+	0, 0, 1050, 573, 1062, 0, 0, 1083, 575, 0,
+	0, 0, 0, 581, 582, 0, 0, 591, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	507, 576, 0, 0, 1048, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1060, 1049, 0, 0, 0,
+	0, 0, 0, 594, 0, 1084, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1046, 0, 0,
+	1077, 1078, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 578, 579, 0,
+	0, 0, 1050, 0, 0, 0, 0, 1083, 0, 0,
+	0, 0, 0, 0, 0, 585, 0, 0, 580, 587,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 508,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1042, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1064, 0, 0, 0, 1047,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 957,
-	0, 0, 945, 946, 947, 960, 0, 944, 942, 943,
-	935, 936, 937, 938, 940, 941, 0, 0, 1057, 0,
-	0, 0, 1267, 1265, 1266, 1269, 1268, 0, 0, 0,
-	1056, 1046, 1075, 1038, 1066, 1065, 0, 0, 1040, 1039,
-	0, 0, 0, 0, 1077, 1076, 0, 0, 1043, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1055,
-	1044, 0, 0, 0, 0, 0, 0, 0, 0, 1079,
-	505, 0, 0, 0, 0, 0, 0, 1071, 0, 1063,
-	1062, 1041, 0, 0, 1072, 1073, 0, 0, 574, 1061,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	572, 0, 0, 0, 0, 0, 1045, 1060, 1058, 1059,
-	0, 1078, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 573, 0, 0, 0, 0, 575, 0, 0, 0,
-	0, 581, 582, 0, 0, 591, 0, 1049, 1048, 1050,
-	1051, 1052, 1053, 1054, 0, 1074, 0, 0, 507, 576,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 583,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 594, 0, 1042, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1064, 0, 0, 0, 1047,
-	0, 577, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 578, 579, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1057, 0,
-	0, 0, 0, 585, 0, 0, 580, 587, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 584, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 508, 1043, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1055,
-	1044, 0, 0, 0, 0, 0, 0, 0, 0, 1079,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1041, 0, 0, 1072, 1073, 0, 583, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1045, 0, 0, 0,
-	0, 1078, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 571, 588, 509, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 571, 588, 509, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 589, 0,
-	0, 0, 593, 0, 0, 0, 510, 0, 0, 0,
-	0, 586, 590, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 592,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	589, 0, 0, 0, 593, 0, 0, 0, 510, 0,
+	0, 0, 0, 586, 590, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 592,
 }
-var sqlPact = [...]int{
 
-	218, -1000, -7, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17821, -1000, -1000,
-	21916, -1000, -1000, -1000, -1000, -1000, -1000, 21601, 793, 869,
-	-1000, -1000, -1000, -1000, -1000, 21916, 2679, 1039, 5205, 1177,
-	21916, 17821, 1175, 1039, 23176, -1000, -1000, 23176, 1080, -1000,
-	-1000, -1000, -1000, -1000, 27271, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 613, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 890, 650,
-	123, 785, -1000, 675, -5, 14633, 30926, 491, 492, 491,
-	491, 488, 667, 26956, 21916, 1547, -15, -1000, 266, 15587,
-	218, 575, -25, 18451, 21916, -1000, 8, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	326, 8, -1000, -1000, 21286, -1000, 1348, 1183, 1182, 20026,
-	-1000, -1000, -1000, -1000, -1000, 273, -1000, 13323, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 952, -1000, -20, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 10988, 270, 1347, 10988, 951, -1000,
-	21916, -1000, -1000, -1000, 1071, -1000, -1000, -1000, -1000, -1000,
-	391, 1733, 659, 268, 26641, -1000, 907, -1000, 390, 562,
-	-1000, -1000, 14306, 16, 20026, -1000, 10988, 10988, 26326, 21916,
-	21916, 1205, 26011, -1000, 675, -1000, -1000, 25696, 25381, 25066,
-	24751, 24436, -1000, 1036, 1546, 1546, 1546, 1569, 95, 87,
-	123, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1700, -1000, -1000, -1000, -1000, 1717, -1000, -1000, -1000, -1000,
-	-1000, 1071, 17506, 693, 1552, 1346, -1000, 949, 1345, 1336,
-	1334, 1332, 1325, -1000, 716, -1000, 1373, 485, -1000, 1318,
-	787, 459, -1000, 1316, 1312, 907, -1000, 907, -1000, -1000,
-	-1000, 569, 23176, 20971, 24121, 21916, 20656, -34, 21916, -1000,
-	267, -34, 9627, 9627, -1000, -1000, 266, -1000, 8947, 1209,
-	484, -195, 129, 359, -1000, 14949, 16864, -1000, 17506, 18451,
-	-25, -73, -1000, -1000, -1000, 4878, 633, 15906, 8, -1000,
-	-1000, -1000, -1000, -1000, 20026, 21916, 21916, 673, 23806, 314,
-	-1000, 10988, 7, -1000, -1000, 266, 17821, 1075, 30284, -1000,
-	10988, 10988, 10988, 10988, 10988, 876, 306, -1000, 265, 264,
-	6213, -1000, -1000, 1714, -1000, -1000, -1000, 94, -1000, -1000,
-	10988, 8, -1000, -1000, -1000, -1000, 262, 1728, -1000, 439,
-	-1000, -1000, -1000, 366, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1372, 261, 260, 257, -1000, -1000, -1000,
-	-1000, 251, 250, 249, 248, 247, 246, 245, 243, 242,
-	240, 238, 236, 235, 234, 233, 838, -1000, 417, -1000,
-	-1000, 417, 417, -1000, 223, 223, 224, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 223, -1000, -1000, -1000, 362,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 231, 1636, 30853,
-	17506, 30284, 17821, -1000, -1000, -1000, -1000, 21916, 10988, -1000,
-	1634, 907, -38, -1000, -1000, -1000, -1000, 1090, 1203, 537,
-	658, 13979, 13652, 1499, -1000, -1000, -1000, 11, 5205, 117,
-	-1000, 30284, 30284, 229, 1052, 400, 99, 23491, 400, 1048,
-	-1000, 1036, 115, 1446, -1000, 115, 1442, 110, 1439, -1000,
-	-128, -1000, 1432, -73, 1430, -1000, 969, 1157, 10308, 1130,
-	10988, 123, -1000, -1000, 123, 123, 7234, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 20341, -1000, -1000, 660,
-	21916, 1371, 17821, 21916, 21916, 21916, 21916, 21916, -1000, -1000,
-	380, 1355, 21916, 1089, -1000, -1000, 21916, 21916, 757, 612,
-	-1000, -38, -1000, 23176, -1000, 909, 1428, 452, 651, 1426,
-	796, 783, 1423, 21916, -34, 1629, 21916, 78, -1000, 29533,
-	-1000, 78, -1000, -2, -1000, 30284, -1000, 1166, 1165, 15587,
-	1304, 892, 892, 892, -1000, 228, 290, 359, 4210, 10988,
-	-1000, 19711, 896, 19, 89, 1209, -1000, 21916, 353, -1000,
-	21916, -1000, -1000, -1000, 1311, -11, -1000, -1000, 407, 84,
-	1625, 84, 16226, -1000, 21916, -16, 30284, 2471, 18766, 568,
-	-1000, 30653, 30853, 5205, 593, 10988, 10988, 10988, 10988, 10988,
-	10988, 10988, 10988, 10988, 10988, 10988, 10988, 10988, 10988, 10988,
-	10988, 10988, 10988, 10988, 10988, 10988, 10988, 1173, 10988, 541,
-	10988, 10988, 10988, 10988, 1066, 894, 183, 919, -1000, 1695,
-	1695, 1695, 30340, 30340, 200, 306, -1000, 10988, -1000, 9968,
-	87, 28898, -36, -1000, -1000, -1000, 7574, 414, 30284, 8,
-	5872, -1000, 1712, 710, 1382, 1254, 227, 86, 85, 82,
-	10988, 10988, 11668, 11668, 10988, 12008, 10988, 10988, 6553, 10988,
-	10988, 10988, 10988, 10988, 10988, -1000, 226, -1000, -1000, -1000,
-	-1000, 1711, -1000, -1000, 1708, -1000, 585, 582, 1705, -5,
-	-21, -1000, 1638, -1000, -1000, -1000, -1000, 81, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	224, 838, 223, 223, 223, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 362, -1000, -1000, 417, 417, 417, -1000, -1000,
-	-131, -1000, 1310, -1000, 388, -23, 476, -38, 907, 1009,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1727, -42, -1000, -1000, -42, -1000, -1000,
-	5205, -1000, -1000, 20026, -1000, -1000, 19081, 1624, 1421, -1000,
-	857, -1000, 1615, 21916, 945, 1028, -1000, 605, 1418, -1000,
-	-1000, 21916, 21916, -1000, 21916, -1000, 21916, 21916, 5205, 21916,
-	21916, -1000, -1000, -1000, 30284, -1000, 345, -1000, -1000, 30284,
-	703, 1192, -1000, 1192, -47, -1000, 29320, 1162, 21916, -1000,
-	-1000, -1000, -1000, -1000, 21916, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 136, -1000, -1000, -1000, -1000, 21916, 21916,
-	21916, -1000, -93, 765, -1000, 19396, 1517, 1515, 1519, 20026,
-	1606, -5, 1309, 1581, -5, 1306, 540, 21916, 538, 535,
-	20026, -1000, 220, -27, 407, 9627, 15587, 5205, -1000, 407,
-	8947, -1000, 15587, 15587, 1284, 1164, 15587, -1000, -1000, -1000,
-	-1000, 19711, 356, 359, 19711, -1000, 1704, 1134, -29, -1000,
-	-1000, 21916, 219, -1000, 359, 79, 1238, 975, -1000, 631,
-	1299, 21916, 715, 10988, 831, -1000, 15268, 440, 21916, 715,
-	47, -1000, -1000, -1000, 346, 21916, -1000, -1000, 10988, -1000,
-	-1000, 21916, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 373, 2386, 2386, 526, 526, 526, 526, 1647,
-	372, 849, 2603, 3159, 3159, 3159, 3270, 409, 409, 3159,
-	3159, 3159, 30340, 30312, 1059, 10988, 10988, 530, 872, 183,
-	1059, 10988, 1059, 1059, 1059, 1059, -1000, 1266, -1000, -1000,
-	-1000, -1000, 1293, 181, 12008, 12008, -1000, -1000, -1000, 6213,
-	12348, -1000, -1000, -1000, -1000, 177, 10988, -1000, 28926, -206,
-	-46, -1000, -1000, 10988, -45, -106, -1000, -1000, 645, -1000,
-	10988, -1000, -39, 10988, 10988, 10988, 76, 75, -1000, 528,
-	-1000, 527, 524, 521, -1000, 173, 886, 171, 170, 10988,
-	-1000, -1000, -1000, 30071, 27907, 74, 1290, -172, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 73, 72, 30043, -172, 71,
-	2520, -1000, 12008, 12008, 12008, 6213, 169, 70, 29292, -172,
-	29830, 9287, 9287, 9287, 63, 29802, 10988, -172, 3796, 3737,
-	3541, -51, -52, -56, 1703, -57, 62, 370, 363, 61,
-	-1000, -1000, 30853, 299, 295, -1000, 1702, 401, 17506, 21916,
-	-1000, 1174, -1000, -1000, 723, -1000, 775, -1000, 14633, -1000,
-	-1000, 60, -58, -72, 30853, -1000, -1000, -1000, -1000, -1000,
-	21916, 272, 21916, 21916, -1000, 168, 1155, 1153, -5, 21916,
-	1726, -5, 21916, 1417, 1460, 328, 21916, -1000, 115, 115,
-	-1000, 110, -1000, -1000, -73, 703, -1000, 10988, 1690, -1000,
-	-1000, -1000, 7234, -1000, -1000, -1000, 21916, -188, -84, -1000,
-	-1000, -1000, -1000, -1000, 353, 752, 21916, 21916, 21916, -1000,
-	-1000, 1025, 22861, 1023, 30853, 166, 21916, 21916, 22546, 22231,
-	21916, 739, -5, -1000, 165, -5, -1000, 163, 21916, 764,
-	21916, 21916, 742, -5, -1000, 1253, -1000, -159, 1209, -1000,
-	1253, -1000, -1000, 1284, -1000, 162, 10988, 15587, -1000, 285,
-	-1000, -62, -1000, 19711, -1000, 284, -201, -1000, 337, -1000,
-	359, 161, 21916, 19711, -1000, 401, 1286, 615, -1000, 629,
-	-1000, -1000, 6893, 30284, -1000, 715, 1522, -63, -1000, -1000,
-	-1000, 407, 16226, 15587, 8947, -64, 30284, -84, 10988, 1059,
-	1059, 10988, 12008, 12008, -1000, 1059, -1000, -1000, -1000, -1000,
-	-1000, 1274, 158, 10988, 30853, 29410, 29377, -75, -1000, 8607,
-	-74, -1000, 10988, -1000, 28869, -1000, -1000, 293, 1450, -1000,
-	10988, 29774, 56, 8267, -1000, 29561, -41, -41, -1000, 1725,
-	1045, 882, 819, 687, 1688, -1000, 16545, 410, 893, 28841,
-	30653, 30853, -1000, 10988, -1000, -1000, 1271, 10988, -1000, 30653,
-	30853, 12008, 12008, 12008, 12008, 12008, 12008, 12008, 12008, 12008,
-	12008, 12008, 12008, 12008, 12008, 12008, 12008, 12008, 12008, 12008,
-	1010, 12008, 1689, 1689, 1689, -80, 7927, -1000, 1278, 1271,
-	10988, 10988, 30853, 55, 54, 48, -1000, 10988, -172, 10988,
-	10988, 10988, -1000, -1000, -1000, 46, -1000, 1679, -1000, -1000,
-	-1000, -1000, -1000, 504, 1676, 45, -1000, 900, -1000, -84,
-	-1000, -1000, -1000, -1000, -1000, 1207, -1000, 19081, -1000, 157,
-	21916, 13004, 155, 899, 10988, 154, 153, -1000, 152, -1000,
-	-1000, 151, 21916, 1096, 328, 18136, -1000, 400, -1000, -1000,
-	-1000, 905, 28813, -1000, 1550, 5205, 21916, -1000, -1000, -1000,
-	520, 519, 1412, -1000, 1018, -1000, 450, 830, -1000, 1405,
-	1041, 1400, 1041, -1000, -93, 760, -1000, 10988, -1000, 10988,
-	-1000, 515, -1000, -1000, 511, 42, 1249, 1558, -1000, 15587,
-	1249, -1000, 21916, 30284, -1000, -195, -1000, 1670, -1000, -1000,
-	1134, -1000, 4548, 19711, 21916, -82, -1000, -1000, 17506, 580,
-	-1000, -99, -1000, -1000, 195, -5, 715, -1000, -100, -1000,
-	332, 1683, 1059, 3676, 3095, 10988, 30853, 30528, -83, 10988,
-	10988, -1000, -91, -1000, 10988, 282, 30284, -1000, -1000, -1000,
-	30284, 10988, 1697, 10988, 39, 36, 35, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 34, -1000, -1000, 27586, 10988,
-	33, -1000, 32, 29, 30284, 1278, 30284, -1000, -1000, 534,
-	534, 664, 664, 664, 664, 1689, 2262, 1305, 2495, 2172,
-	2172, 2172, 3501, 734, 734, 2172, 2172, 2172, 1268, 1077,
-	149, 3851, 10988, -92, -1000, -1000, -1000, 30284, 30284, 28,
-	-1000, -1000, -1000, -172, 221, 28473, 28445, -1000, 27, -1000,
-	281, 275, -1000, 147, -1000, 1227, -1000, 2578, 13004, 145,
-	-109, -1000, 288, 1535, 10988, -1000, -1000, 144, 21916, -1000,
-	28416, 13004, 21916, 19081, 1614, 13004, 928, 1132, 328, 18136,
-	-1000, 605, -1000, -1000, -1000, 1550, -1000, 21916, 21916, 21916,
-	1399, -1000, -1000, 1114, 1197, 470, 21916, -1000, 21916, -1000,
-	21916, 21916, 21916, -126, -130, 21916, 21916, -1000, 403, 10988,
-	10988, 1209, 403, -132, 359, -1000, -1000, -1000, 1668, -1000,
-	-136, -1000, -125, 17191, 1471, 21916, 1510, -1000, -1000, 143,
-	10988, 10988, 30528, -138, -1000, 1059, 1059, -1000, 28388, -1000,
-	30284, -1000, 29561, -1000, -1000, -1000, -1000, 863, -1000, 28360,
-	-1000, -1000, -1000, -1000, 12008, 1261, 142, 30853, 28020, -1000,
-	-1000, 10988, -1000, -1000, -1000, -1000, -1000, 1723, 866, -1000,
-	21916, -1000, 5205, 21916, 1275, 998, -1000, -1000, 1143, 141,
-	12008, 21916, -140, 13004, 829, 13004, 1550, 8, 5205, 1550,
-	27992, 5872, -144, -1000, -145, -146, 26, -5, -151, 21916,
-	-1000, 328, 18136, -1000, 1460, -1000, -1000, -1000, -1000, 21916,
-	989, -1000, 988, 10988, -1000, 30853, 1041, 1041, -1000, 510,
-	509, 501, 500, -1000, -1000, -1000, 21916, 30284, -172, -1000,
-	-1000, 19711, 222, -1000, -1000, 132, 8, 579, -157, 21916,
-	-1000, -1000, 5532, 1059, 1059, -1000, -1000, 25, 893, 1557,
-	-1000, 30412, 12008, 30853, -158, -1000, 27963, -162, -1000, 323,
-	21916, 1131, -1000, -1000, 21916, 1013, -1000, -1000, 10988, 3851,
-	131, 829, -163, 1207, 125, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1535, -39, -1000, -1000, 799, 1207, -1000, 829, 124,
-	18136, -1000, 1096, -1000, -1000, -1000, 30284, 1530, -1000, -1000,
-	21916, 21916, 113, 112, -137, -1000, 1613, -1000, -1000, 10988,
-	652, -1000, 407, -1000, -1000, 771, 10988, 30412, -167, -1000,
-	-1000, 1723, -1000, -1000, 1720, 101, -1000, -1000, 1393, 27935,
-	1065, 21916, 1207, 829, -1000, 21916, 1550, 22, 21916, -1000,
-	1207, 13004, -1000, 1132, 454, 5205, -1000, -1000, 10988, 10988,
-	21916, 100, -41, 16226, -1000, 21, 11328, 11328, -172, -1000,
-	-1000, -1000, 21916, 1385, -1000, 913, 965, 20, -1000, 1207,
-	-171, -1000, -1000, 99, -1000, -173, -1000, -1000, 10988, -1000,
-	-177, -179, -1000, -1000, 18, -168, -1000, -1000, -1000, 12677,
-	939, 711, 29264, -1000, -185, 21916, -1000, 924, 922, 678,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1065, 829, 30284, -1000,
-	-1000, 1697, -1000, 1643, -1000, -1000, -1000, -1000, -1000, 981,
-	-1000, -1000, 1479, -1000, 463, 868, 868, 913, 1207, 10648,
-	-1000, -1000, -1000, -1000, 1651, -1000, -1000, 1082, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000,
+var sqlPact = [...]int16{
+	173, -32768, 0, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 18263, -32768, -32768,
+	22358, -32768, -32768, -32768, -32768, -32768, -32768, 22043, 725, 983,
+	-32768, -32768, -32768, -32768, -32768, 22358, 2836, 1153, 5647, 1223,
+	22358, 18263, 1222, 1153, 23618, -32768, -32768, 23618, 1019, -32768,
+	-32768, -32768, -32768, -32768, 27713, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, 662, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 949, 790,
+	114, 746, -32768, 765, 46, 15075, 31278, 536, 556, 536,
+	536, 548, 756, 27398, 22358, 1602, -3, -32768, 328, 16029,
+	173, 626, -14, 18893, 22358, -32768, 8, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	384, 8, -32768, -32768, 21728, -32768, 1358, 1234, 1229, 20468,
+	-32768, -32768, -32768, -32768, -32768, 340, -32768, 13765, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 990, -32768, -8, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 11430, 337, 1355, 11430, 982, -32768,
+	22358, -32768, -32768, -32768, 1275, -32768, -32768, -32768, -32768, -32768,
+	438, 1762, 716, 333, 27083, -32768, 999, -32768, 437, 594,
+	-32768, -32768, 14748, 34, 20468, -32768, 11430, 11430, 26768, 22358,
+	22358, 1256, 26453, -32768, 765, -32768, -32768, 26138, 25823, 25508,
+	25193, 24878, -32768, 1203, 1567, 1567, 1567, 1622, 150, 142,
+	114, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	1726, -32768, -32768, -32768, -32768, 1744, -32768, -32768, -32768, -32768,
+	-32768, 1275, 17948, 873, 1598, 1354, -32768, 977, 1353, 1352,
+	1346, 1345, 1344, -32768, 866, -32768, 1384, 547, -32768, 1342,
+	879, 520, -32768, 1341, 1340, 999, -32768, 999, -32768, -32768,
+	-32768, 618, 23618, 21413, 24563, 22358, 21098, -18, 22358, -32768,
+	330, -18, 10069, 10069, 10069, -32768, 971, 328, -32768, 9389,
+	1204, 2551, -181, 156, 415, -32768, 15391, 17306, -32768, 17948,
+	18893, -14, -68, -32768, -32768, -32768, 5320, 701, 16348, 8,
+	-32768, -32768, -32768, -32768, -32768, 20468, 22358, 22358, 729, 24248,
+	364, -32768, 11430, 28, -32768, -32768, 328, 18263, 738, 30521,
+	-32768, 11430, 11430, 11430, 11430, 11430, 912, 357, -32768, 327,
+	326, 6655, -32768, -32768, 1743, -32768, -32768, -32768, 148, -32768,
+	-32768, 11430, 8, -32768, -32768, -32768, -32768, 323, 1758, -32768,
+	461, -32768, -32768, -32768, 433, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 1375, 322, 320, 318, 317, -32768,
+	-32768, -32768, -32768, 315, 314, 310, 308, 306, 305, 304,
+	301, 300, 294, 293, 291, 290, 284, 283, 881, -32768,
+	478, -32768, -32768, 478, 478, -32768, 251, 251, 257, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 251, -32768, -32768,
+	-32768, 434, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 282,
+	1667, 31109, 17948, 30521, 18263, -32768, -32768, -32768, -32768, 22358,
+	11430, -32768, 1657, 999, -33, -32768, -32768, -32768, -32768, 1127,
+	1202, 550, 785, 14421, 14094, 1529, -32768, -32768, -32768, 31,
+	5647, 126, -32768, 30521, 30521, 281, 1077, 444, 74, 23933,
+	444, 1071, -32768, 1203, 120, 1469, -32768, 120, 1461, 117,
+	1460, -32768, -174, -32768, 1455, -68, 1454, -32768, 1012, 1210,
+	10750, 1243, 11430, 114, -32768, -32768, 114, 114, 7676, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 20783, -32768,
+	-32768, 705, 22358, 1371, 18263, 22358, 22358, 22358, 22358, 22358,
+	-32768, -32768, 2372, 1368, 22358, 1126, -32768, -32768, 22358, 22358,
+	812, 660, -32768, -33, -32768, 23618, -32768, 886, 1450, 492,
+	838, 1448, 831, 821, 1441, 22358, -18, 1656, 22358, 79,
+	-32768, 29768, -32768, 79, 79, 278, -32768, 27, -32768, 30521,
+	-32768, 1219, 1197, 16029, 1227, 929, 929, 929, -32768, 275,
+	347, 415, 4652, 11430, -32768, 20153, 947, 49, 143, 1204,
+	-32768, 22358, 403, -32768, 22358, -32768, -32768, -32768, 1337, 22,
+	-32768, -32768, 501, 91, 1655, 91, 16668, -32768, 22358, 21,
+	30521, 2511, 19208, 617, -32768, 31019, 31109, 5647, 645, 11430,
+	11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430,
+	11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430, 11430,
+	11430, 1179, 11430, 615, 11430, 11430, 11430, 11430, 1236, 930,
+	227, 1099, -32768, 1710, 1710, 1710, 30785, 30785, 197, 357,
+	-32768, 11430, -32768, 10410, 142, 29283, -31, -32768, -32768, -32768,
+	8016, 473, 30521, 8, 6314, -32768, 1742, 693, 1392, 1291,
+	274, 140, 139, 137, 134, 11430, 11430, 12110, 12110, 11430,
+	12450, 11430, 11430, 6995, 11430, 11430, 11430, 11430, 11430, 11430,
+	-32768, 273, -32768, -32768, -32768, -32768, 1739, -32768, -32768, 1737,
+	-32768, 638, 634, 1734, 46, 14, -32768, 1671, -32768, -32768,
+	-32768, -32768, 112, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, 257, 881, 251, 251, 251,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 434, -32768, -32768,
+	478, 478, 478, -32768, -32768, -104, -32768, 1335, -32768, 418,
+	-4, 524, -33, 999, 880, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 1754, -48,
+	-32768, -32768, -48, -32768, -32768, 5647, -32768, -32768, 20468, -32768,
+	-32768, 19523, 1654, 1433, -32768, 896, -32768, 1653, 22358, 968,
+	1051, -32768, 655, 1430, -32768, -32768, 22358, 22358, -32768, 22358,
+	-32768, 22358, 22358, 5647, 22358, 22358, -32768, -32768, -32768, 30521,
+	-32768, 379, -32768, -32768, 30521, 850, 1239, -32768, 1239, -50,
+	-32768, 29711, 1213, 22358, -32768, -32768, -32768, -32768, -32768, 22358,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 196, -32768,
+	-32768, -32768, -32768, 22358, 22358, 22358, -32768, -88, 1005, -32768,
+	19838, 1571, 1562, 1560, 20468, 1641, 46, 1328, 1637, 46,
+	1326, 611, 22358, 608, 606, 20468, -32768, 232, -9, 460,
+	10069, 16029, 5647, -32768, 460, 460, 11430, 9389, -32768, 16029,
+	16029, 735, 1178, 16029, -32768, -32768, -32768, -32768, 20153, 368,
+	415, 20153, -32768, 1733, 1206, -10, -32768, -32768, 22358, 231,
+	-32768, 415, 132, 1276, 962, -32768, 699, 1324, 22358, 460,
+	16029, 906, -32768, 15710, 481, 22358, 768, 73, -32768, -32768,
+	-32768, 393, 22358, -32768, -32768, 11430, -32768, -32768, 22358, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, 430,
+	394, 394, 610, 610, 610, 610, 1658, 271, 3191, 3422,
+	221, 221, 221, 2887, 253, 253, 221, 221, 221, 30785,
+	30700, 2476, 11430, 11430, 605, 926, 227, 2476, 11430, 2476,
+	2476, 2476, 2476, -32768, 1568, -32768, -32768, -32768, -32768, 1322,
+	226, 12450, 12450, -32768, -32768, -32768, 6655, 12790, -32768, -32768,
+	-32768, -32768, 225, 11430, -32768, 29311, -199, -16, -32768, -32768,
+	11430, -15, -114, -32768, -32768, 696, -32768, 11430, -32768, -30,
+	11430, 11430, 11430, 131, 124, -32768, 604, -32768, 597, 593,
+	590, -32768, 224, 918, 223, 220, 11430, -32768, -32768, -32768,
+	-32768, 30436, 4089, 122, 1320, -162, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, 118, 116, 30408, -162, 115, 3083, -32768,
+	12450, 12450, 12450, 6655, 219, 110, 29683, -162, 30172, 9729,
+	9729, 9729, 109, 30144, 11430, -162, 3848, 3793, 3557, -21,
+	-22, -23, 1732, -34, 105, 428, 421, 104, -32768, -32768,
+	31109, 355, 352, -32768, 1729, 452, 17948, 22358, -32768, 1226,
+	-32768, -32768, 764, -32768, 853, -32768, 15075, -32768, -32768, 103,
+	-35, -59, 31109, -32768, -32768, -32768, -32768, -32768, 22358, 259,
+	22358, 22358, -32768, 208, 1199, 1176, 46, 22358, 1751, 46,
+	22358, 1429, 1483, 387, 22358, -32768, 120, 120, -32768, 117,
+	-32768, -32768, -68, 850, -32768, 11430, 1723, -32768, -32768, -32768,
+	7676, -32768, -32768, -32768, 22358, -180, -81, -32768, -32768, -32768,
+	-32768, -32768, 403, 919, 22358, 22358, 22358, -32768, -32768, 1048,
+	23303, 1031, 31109, 206, 22358, 22358, 22988, 22673, 22358, 868,
+	46, -32768, 205, 46, -32768, 203, 22358, 810, 22358, 22358,
+	805, 46, -32768, 1287, 11430, -32768, -139, 1204, -32768, 1287,
+	1287, -36, -32768, -32768, 735, -32768, 200, 11430, 16029, -32768,
+	346, -32768, -40, -32768, 20153, -32768, 345, -197, -32768, 391,
+	-32768, 415, 198, 22358, 20153, -32768, 452, 1318, 674, -32768,
+	698, -32768, 768, -106, -32768, 768, 7335, 1575, -41, -32768,
+	-32768, -32768, 460, 16668, 16029, 9389, -46, 30521, -81, 11430,
+	2476, 2476, 11430, 12450, 12450, -32768, 2476, -32768, -32768, -32768,
+	-32768, -32768, 1317, 194, 11430, 31109, 2936, 2798, -54, -32768,
+	9049, -72, -32768, 11430, -32768, 29255, -32768, -32768, 350, 1477,
+	-32768, 11430, 30059, 100, 8709, -32768, 29796, -32, -32, -32768,
+	1750, 1094, 958, 936, 733, 1721, -32768, 16987, 465, 940,
+	28915, 31019, 31109, -32768, 11430, -32768, -32768, 1311, 11430, -32768,
+	31019, 31109, 12450, 12450, 12450, 12450, 12450, 12450, 12450, 12450,
+	12450, 12450, 12450, 12450, 12450, 12450, 12450, 12450, 12450, 12450,
+	12450, 1196, 12450, 1706, 1706, 1706, -80, 8369, -32768, 1365,
+	1311, 11430, 11430, 31109, 98, 96, 92, -32768, 11430, -162,
+	11430, 11430, 11430, -32768, -32768, -32768, 86, -32768, 1719, -32768,
+	-32768, -32768, -32768, -32768, 546, 1711, 85, -32768, 952, -32768,
+	-81, -32768, -32768, -32768, -32768, -32768, 1259, -32768, 19523, -32768,
+	193, 22358, 13446, 192, 763, 11430, 186, 185, -32768, 184,
+	-32768, -32768, 183, 22358, 1139, 387, 18578, -32768, 444, -32768,
+	-32768, -32768, 955, 28887, -32768, 1589, 5647, 22358, -32768, -32768,
+	-32768, 589, 587, 1428, -32768, 1029, -32768, 489, 939, -32768,
+	1421, 994, 1420, 994, -32768, -88, 887, -32768, 11430, -32768,
+	11430, -32768, 582, -32768, -32768, 570, 83, 1279, 1619, 30521,
+	-32768, 16029, 1279, 1279, -32768, -32768, 22358, 30521, -32768, -181,
+	-32768, 1704, -32768, -32768, 1206, -32768, 4990, 20153, 22358, -60,
+	-32768, -32768, 17948, 631, -32768, -32768, -32768, -94, -32768, 250,
+	46, 768, -32768, -106, -32768, 388, 1668, 2476, 2677, 245,
+	11430, 31109, 4270, -61, 11430, 11430, -32768, -67, -32768, 11430,
+	344, 30521, -32768, -32768, -32768, 30521, 11430, 1747, 11430, 80,
+	78, 77, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	72, -32768, -32768, 28028, 11430, 69, -32768, 67, 66, 30521,
+	1365, 30521, -32768, -32768, 477, 477, 745, 745, 745, 745,
+	1706, 997, 1972, 2946, 739, 739, 739, 2152, 429, 429,
+	739, 739, 739, 1308, 1131, 181, 4153, 11430, -83, -32768,
+	-32768, -32768, 30521, 30521, 60, -32768, -32768, -32768, -162, 3496,
+	28858, 28830, -32768, 55, -32768, 342, 272, -32768, 176, -32768,
+	1262, -32768, 2378, 13446, 175, -102, -32768, 296, 1587, 11430,
+	-32768, -32768, 171, 22358, -32768, 28802, 13446, 22358, 19523, 1652,
+	13446, 964, 1165, 387, 18578, -32768, 655, -32768, -32768, -32768,
+	1589, -32768, 22358, 22358, 22358, 1412, -32768, -32768, 1235, 1282,
+	518, 22358, -32768, 22358, -32768, 22358, 22358, 22358, -107, -109,
+	22358, 22358, -32768, 458, 11430, 11430, 1204, 458, 458, -122,
+	415, -32768, -32768, -32768, 1700, -32768, -128, -32768, -120, 17633,
+	1492, 22358, 1539, -32768, -32768, 169, 11430, 11430, 4270, -129,
+	-32768, 2476, 2476, -32768, 28462, -32768, 30521, -32768, 29796, -32768,
+	-32768, -32768, -32768, 899, -32768, 28434, -32768, -32768, -32768, -32768,
+	12450, 1302, 167, 31109, 28405, -32768, -32768, 11430, -32768, -32768,
+	-32768, -32768, -32768, 1749, 903, -32768, 22358, -32768, 5647, 22358,
+	1297, 1025, -32768, -32768, 1173, 166, 12450, 22358, -130, 13446,
+	901, 13446, 1589, 8, 5647, 1589, 28377, 6314, -134, -32768,
+	-140, -141, 51, 46, -142, 22358, -32768, 387, 18578, -32768,
+	1483, -32768, -32768, -32768, -32768, 22358, 1022, -32768, 1003, 11430,
+	-32768, 31109, 994, 994, -32768, 568, 564, 561, 560, -32768,
+	-32768, -32768, 22358, 30521, -162, -32768, -32768, -32768, 20153, 222,
+	-32768, -32768, 165, 8, 627, -146, 22358, -32768, -32768, 5974,
+	2476, 2476, -32768, -32768, 50, 940, 1614, -32768, 4298, 12450,
+	31109, -152, -32768, 28349, -163, -32768, 377, 22358, 1177, -32768,
+	-32768, 22358, 979, -32768, -32768, 11430, 4153, 163, 901, -164,
+	1259, 161, -32768, -32768, -32768, -32768, -32768, -32768, 1587, -30,
+	-32768, -32768, 834, 1259, -32768, 901, 159, 18578, -32768, 1139,
+	-32768, -32768, -32768, 30521, 1583, -32768, -32768, 22358, 22358, 158,
+	157, -150, -32768, 1650, -32768, -32768, 11430, 720, -32768, 460,
+	-32768, -32768, 828, 11430, 4298, -165, -32768, -32768, 1749, -32768,
+	-32768, 1748, 153, -32768, -32768, 1410, 4219, 1116, 22358, 1259,
+	901, -32768, 22358, 1589, 48, 22358, -32768, 1259, 13446, -32768,
+	1165, 498, 5647, -32768, -32768, 11430, 11430, 22358, 152, -32,
+	16668, -32768, 41, 11770, 11770, -162, -32768, -32768, -32768, 22358,
+	1400, -32768, 960, 865, 40, -32768, 1259, -169, -32768, -32768,
+	74, -32768, -171, -32768, -32768, 11430, -32768, -175, -182, -32768,
+	-32768, 37, -157, -32768, -32768, -32768, 13119, 988, 760, 29345,
+	-32768, -188, 22358, -32768, 963, 961, 713, -32768, -32768, -32768,
+	-32768, -32768, -32768, 1116, 901, 30521, -32768, -32768, 1747, -32768,
+	1675, -32768, -32768, -32768, -32768, -32768, 969, -32768, -32768, 1497,
+	-32768, 511, 1115, 1115, 960, 1259, 11090, -32768, -32768, -32768,
+	-32768, 1685, -32768, -32768, 1140, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768,
 }
-var sqlPgo = [...]int{
 
-	0, 2077, 2063, 1388, 1539, 2061, 2059, 2058, 2056, 2054,
-	2052, 2047, 2045, 2043, 2038, 2035, 2032, 2031, 2029, 2028,
-	2025, 2024, 2023, 2022, 2020, 2018, 2017, 1537, 2016, 2015,
-	2014, 2011, 2010, 2009, 1478, 2008, 1475, 2007, 2005, 2002,
-	1997, 1995, 1472, 1460, 1994, 79, 108, 1448, 1992, 1990,
-	1423, 1988, 1986, 1983, 1980, 1979, 1975, 366, 1973, 1972,
-	1971, 1970, 1968, 1964, 1962, 132, 152, 1961, 1417, 1958,
-	1957, 1955, 1954, 1951, 1950, 1947, 1942, 1939, 1925, 1924,
-	1923, 1917, 1916, 1914, 1913, 1911, 1908, 151, 1907, 1906,
-	1410, 1386, 1901, 1900, 15, 1898, 80, 1897, 27, 156,
-	2156, 139, 1896, 1894, 1893, 1892, 24, 81, 83, 1891,
-	63, 1890, 1889, 37, 29, 17, 16, 1888, 88, 1887,
-	1886, 1228, 22, 1884, 1883, 131, 1882, 20, 21, 418,
-	146, 144, 101, 102, 1881, 1879, 1878, 1876, 40, 1870,
-	2, 1868, 1867, 8, 57, 14, 1865, 46, 86, 1864,
-	1863, 122, 1862, 74, 93, 1379, 140, 113, 28, 1860,
-	71, 12, 67, 1859, 1857, 136, 31, 1856, 33, 73,
-	133, 1853, 55, 164, 119, 76, 114, 109, 1852, 1851,
-	1850, 90, 1847, 107, 78, 1846, 1844, 49, 1843, 126,
-	128, 1841, 1840, 85, 1839, 115, 1838, 1836, 150, 143,
-	100, 6, 43, 54, 11, 1369, 1368, 110, 70, 45,
-	36, 1830, 1960, 909, 683, 30, 95, 60, 44, 92,
-	47, 64, 138, 25, 1828, 1827, 112, 1826, 1825, 1824,
-	1823, 1820, 91, 1819, 1817, 72, 137, 89, 26, 82,
-	84, 35, 50, 148, 97, 147, 98, 1816, 77, 118,
-	1814, 117, 1813, 1812, 1684, 104, 1809, 1805, 1803, 1678,
-	1661, 1604, 51, 1802, 1801, 1439, 1034, 1799, 1798, 58,
-	1797, 106, 103, 1796, 127, 145, 18, 1793, 111, 66,
-	87, 1792, 99, 42, 0, 227, 186, 1790, 105, 52,
-	1789, 1788, 1787, 1786, 23, 1, 9, 4, 7, 5,
-	39, 32, 1785, 1783, 123, 120, 53, 1781, 149, 1777,
-	1772, 1765, 34, 1764, 19, 1762, 13, 1755, 1754, 10,
-	3, 1751, 1750, 129, 1746, 94, 1745, 1589, 1742, 141,
-	1737, 1736, 1507, 69, 96,
+var sqlPgo = [...]int16{
+	0, 2086, 2075, 1419, 1526, 2074, 2057, 2053, 2052, 2051,
+	2048, 2047, 2043, 2041, 2040, 2039, 2038, 2037, 2036, 2034,
+	2033, 2032, 2026, 2025, 2024, 2023, 2022, 1521, 2021, 2018,
+	2016, 2015, 2014, 2013, 1511, 2012, 1498, 2010, 2009, 2008,
+	2007, 2005, 1488, 1471, 2003, 77, 106, 1454, 2001, 2000,
+	1435, 1999, 1995, 1994, 1993, 1989, 1988, 137, 1987, 1984,
+	1981, 1980, 1979, 1978, 1977, 143, 157, 1968, 1416, 1967,
+	1965, 1964, 1963, 1961, 1960, 1959, 1954, 1950, 1943, 1940,
+	1939, 1937, 1932, 1930, 1929, 1921, 1920, 159, 1917, 1916,
+	1412, 1410, 1915, 1913, 21, 1912, 78, 1911, 26, 202,
+	349, 136, 1910, 1909, 1908, 1905, 44, 85, 80, 1904,
+	145, 1903, 1902, 31, 25, 20, 12, 1901, 84, 1900,
+	1898, 1530, 30, 1896, 1894, 131, 1893, 32, 17, 98,
+	156, 149, 128, 103, 1892, 1891, 1889, 1886, 43, 1884,
+	7, 1883, 1882, 1881, 9, 58, 15, 1879, 24, 13,
+	1878, 1877, 119, 74, 1876, 1873, 97, 1404, 151, 115,
+	40, 1872, 71, 10, 73, 1869, 1868, 144, 60, 1866,
+	22, 86, 132, 1865, 45, 163, 117, 79, 118, 108,
+	1862, 1861, 1856, 91, 1855, 107, 70, 1854, 1853, 55,
+	1852, 127, 129, 1851, 1850, 82, 1849, 114, 1848, 1846,
+	154, 146, 104, 4, 51, 49, 11, 1403, 1400, 109,
+	67, 39, 37, 1845, 2394, 707, 869, 27, 93, 64,
+	36, 94, 54, 66, 142, 63, 1843, 1840, 112, 1838,
+	1837, 1835, 1834, 1832, 92, 1831, 1830, 69, 140, 76,
+	35, 29, 81, 34, 87, 153, 111, 150, 101, 1826,
+	83, 113, 1825, 57, 1824, 1820, 1486, 126, 1818, 1817,
+	1816, 1193, 986, 970, 670, 1815, 1814, 905, 786, 1813,
+	1811, 72, 1808, 99, 100, 1807, 133, 152, 23, 1805,
+	110, 102, 88, 1804, 89, 28, 0, 1073, 46, 1803,
+	95, 47, 1800, 1798, 1797, 1796, 18, 2, 8, 3,
+	6, 5, 53, 52, 1795, 1794, 120, 121, 50, 1792,
+	155, 1791, 1786, 1785, 33, 1784, 16, 1782, 19, 1781,
+	1778, 14, 1, 1776, 1775, 123, 1774, 90, 1771, 1650,
+	1770, 147, 1769, 1767, 1502, 42, 96,
 }
-var sqlR1 = [...]int{
 
+var sqlR1 = [...]int16{
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -4969,135 +5036,136 @@ var sqlR1 = [...]int{
 	9, 10, 16, 13, 18, 12, 12, 15, 15, 108,
 	108, 107, 107, 107, 107, 107, 107, 107, 107, 107,
 	107, 107, 107, 107, 107, 107, 105, 105, 110, 110,
-	110, 112, 112, 109, 109, 104, 104, 20, 55, 282,
-	282, 283, 283, 93, 93, 97, 97, 94, 95, 95,
+	110, 112, 112, 109, 109, 104, 104, 20, 55, 284,
+	284, 285, 285, 93, 93, 97, 97, 94, 95, 95,
 	96, 96, 26, 26, 26, 22, 22, 23, 24, 27,
-	27, 27, 27, 27, 27, 34, 35, 35, 35, 35,
-	35, 36, 36, 36, 36, 36, 41, 41, 39, 39,
-	38, 38, 37, 37, 40, 40, 157, 157, 156, 156,
-	159, 159, 42, 42, 45, 45, 45, 45, 45, 46,
-	46, 46, 46, 46, 46, 46, 46, 250, 250, 249,
-	44, 224, 224, 47, 182, 182, 48, 48, 48, 48,
-	49, 56, 323, 323, 323, 327, 327, 328, 328, 329,
-	329, 329, 329, 329, 329, 329, 325, 325, 53, 53,
-	92, 60, 60, 60, 60, 60, 60, 62, 64, 61,
-	61, 61, 63, 63, 65, 65, 65, 65, 66, 66,
-	66, 66, 67, 67, 137, 137, 275, 274, 274, 280,
-	280, 280, 135, 135, 135, 135, 135, 136, 136, 136,
-	273, 273, 273, 273, 281, 281, 281, 281, 281, 281,
-	279, 279, 68, 68, 68, 68, 68, 68, 68, 68,
+	27, 27, 27, 27, 27, 34, 155, 155, 35, 35,
+	35, 35, 35, 36, 36, 36, 36, 36, 41, 41,
+	39, 39, 38, 38, 37, 37, 40, 40, 159, 159,
+	158, 158, 161, 161, 42, 42, 45, 45, 45, 45,
+	45, 46, 46, 46, 46, 46, 46, 46, 46, 252,
+	252, 251, 44, 226, 226, 47, 184, 184, 48, 48,
+	48, 48, 49, 56, 325, 325, 325, 329, 329, 330,
+	330, 331, 331, 331, 331, 331, 331, 331, 327, 327,
+	53, 53, 92, 60, 60, 60, 60, 60, 60, 62,
+	64, 61, 61, 61, 63, 63, 65, 65, 65, 65,
+	66, 66, 66, 66, 67, 67, 137, 137, 277, 276,
+	276, 282, 282, 282, 135, 135, 135, 135, 135, 136,
+	136, 136, 275, 275, 275, 275, 283, 283, 283, 283,
+	283, 283, 281, 281, 68, 68, 68, 68, 68, 68,
 	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	80, 80, 87, 87, 87, 87, 87, 87, 69, 74,
-	74, 74, 70, 75, 76, 77, 77, 77, 71, 71,
-	79, 79, 79, 78, 84, 84, 84, 84, 81, 81,
-	81, 82, 82, 85, 85, 85, 72, 73, 86, 83,
-	83, 83, 43, 324, 324, 326, 326, 51, 30, 30,
-	31, 31, 138, 138, 139, 139, 203, 203, 203, 203,
-	140, 140, 111, 111, 111, 202, 292, 292, 293, 293,
-	293, 293, 293, 293, 294, 294, 294, 294, 294, 294,
-	294, 290, 290, 291, 288, 288, 289, 289, 289, 289,
-	330, 330, 201, 201, 143, 143, 297, 297, 297, 297,
-	295, 295, 295, 295, 295, 298, 296, 299, 299, 299,
-	299, 299, 236, 236, 236, 89, 32, 117, 117, 33,
-	29, 29, 186, 186, 147, 147, 241, 241, 241, 128,
-	128, 106, 106, 106, 14, 11, 11, 11, 11, 11,
-	11, 19, 19, 17, 17, 187, 187, 188, 188, 52,
-	54, 59, 88, 88, 88, 21, 21, 25, 25, 58,
-	332, 332, 124, 124, 124, 124, 125, 125, 198, 198,
-	199, 199, 200, 200, 200, 119, 118, 120, 120, 28,
-	28, 113, 113, 114, 114, 115, 115, 116, 116, 333,
-	333, 50, 50, 91, 131, 131, 195, 195, 195, 197,
-	197, 196, 196, 196, 172, 172, 172, 90, 152, 152,
-	161, 161, 162, 162, 247, 163, 163, 57, 57, 100,
-	100, 98, 98, 98, 98, 98, 98, 99, 99, 101,
-	101, 101, 101, 101, 101, 101, 103, 305, 305, 305,
-	308, 308, 304, 306, 306, 307, 307, 331, 331, 173,
-	173, 173, 142, 141, 141, 145, 145, 144, 146, 146,
-	240, 240, 240, 170, 170, 170, 170, 189, 189, 190,
-	190, 191, 191, 192, 192, 192, 193, 193, 194, 194,
-	169, 169, 220, 220, 102, 102, 151, 151, 153, 153,
-	210, 210, 210, 211, 211, 209, 209, 209, 209, 242,
-	242, 242, 242, 242, 242, 242, 321, 321, 321, 322,
-	322, 239, 239, 243, 243, 243, 243, 243, 243, 237,
-	237, 237, 237, 238, 238, 248, 248, 176, 176, 176,
-	176, 174, 174, 175, 175, 244, 244, 244, 244, 171,
-	171, 245, 245, 245, 204, 204, 251, 251, 251, 269,
-	269, 150, 150, 150, 252, 252, 252, 252, 252, 252,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 253, 253, 253, 253, 255, 255, 255, 254, 254,
+	68, 68, 80, 80, 87, 87, 87, 87, 87, 87,
+	69, 74, 74, 74, 70, 75, 76, 77, 77, 77,
+	71, 71, 79, 79, 79, 78, 84, 84, 84, 84,
+	81, 81, 81, 82, 82, 85, 85, 85, 72, 73,
+	86, 83, 83, 83, 43, 326, 326, 328, 328, 51,
+	30, 30, 31, 31, 138, 138, 139, 139, 205, 205,
+	205, 205, 140, 140, 111, 111, 111, 204, 294, 294,
+	295, 295, 295, 295, 295, 295, 296, 296, 296, 296,
+	296, 296, 296, 292, 292, 293, 290, 290, 291, 291,
+	291, 291, 332, 332, 203, 203, 144, 144, 299, 299,
+	299, 299, 297, 297, 297, 297, 297, 300, 298, 301,
+	301, 301, 301, 301, 238, 238, 238, 89, 32, 117,
+	117, 33, 29, 29, 188, 188, 148, 148, 243, 243,
+	243, 128, 128, 106, 106, 106, 14, 11, 11, 11,
+	11, 11, 11, 19, 19, 17, 17, 189, 189, 190,
+	190, 52, 54, 59, 88, 88, 88, 21, 21, 25,
+	25, 58, 334, 334, 124, 124, 124, 124, 125, 125,
+	200, 200, 201, 201, 202, 202, 202, 119, 118, 120,
+	120, 28, 28, 113, 113, 114, 114, 115, 115, 116,
+	116, 335, 335, 50, 50, 91, 131, 131, 197, 197,
+	197, 199, 199, 198, 198, 198, 174, 174, 174, 90,
+	154, 154, 163, 163, 164, 164, 249, 165, 165, 57,
+	57, 100, 100, 98, 98, 98, 98, 98, 98, 99,
+	99, 101, 101, 101, 101, 101, 101, 101, 101, 103,
+	307, 307, 307, 310, 310, 306, 308, 308, 309, 309,
+	333, 333, 175, 175, 175, 142, 143, 141, 141, 146,
+	146, 145, 147, 147, 242, 242, 242, 172, 172, 172,
+	172, 191, 191, 192, 192, 193, 193, 194, 194, 194,
+	195, 195, 196, 196, 171, 171, 222, 222, 102, 102,
+	152, 152, 153, 153, 212, 212, 212, 213, 213, 211,
+	211, 211, 211, 244, 244, 244, 244, 244, 244, 244,
+	323, 323, 323, 324, 324, 241, 241, 245, 245, 245,
+	245, 245, 245, 239, 239, 239, 239, 240, 240, 250,
+	250, 178, 178, 178, 178, 176, 176, 177, 177, 246,
+	246, 246, 246, 173, 173, 247, 247, 247, 206, 206,
+	253, 253, 253, 271, 271, 151, 151, 151, 254, 254,
 	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 268, 268, 268, 268,
-	268, 256, 256, 263, 263, 264, 264, 265, 266, 257,
-	257, 258, 258, 259, 260, 267, 267, 267, 267, 271,
-	271, 261, 261, 261, 261, 261, 262, 183, 183, 183,
-	183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
-	183, 184, 184, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 212, 212, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 214, 214, 214, 214, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	300, 300, 300, 300, 300, 300, 300, 302, 302, 303,
-	303, 301, 301, 301, 301, 301, 301, 301, 301, 301,
-	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
-	301, 301, 301, 301, 301, 301, 301, 301, 301, 301,
-	309, 309, 310, 310, 312, 312, 313, 313, 314, 315,
-	315, 315, 316, 317, 317, 311, 311, 318, 318, 318,
-	319, 319, 320, 320, 320, 320, 320, 226, 226, 226,
-	227, 227, 228, 234, 234, 234, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 126, 126, 126, 126, 126, 158, 158, 223,
-	223, 221, 221, 221, 225, 225, 177, 177, 270, 270,
-	270, 270, 270, 270, 270, 178, 178, 178, 185, 179,
-	179, 180, 180, 180, 180, 180, 180, 217, 218, 181,
-	181, 181, 219, 219, 229, 233, 233, 232, 231, 231,
-	230, 230, 207, 207, 208, 208, 206, 205, 166, 166,
-	165, 165, 164, 164, 334, 334, 235, 235, 167, 167,
-	168, 160, 160, 246, 246, 246, 246, 154, 154, 133,
-	133, 155, 155, 129, 129, 132, 132, 130, 130, 130,
-	130, 148, 148, 149, 149, 127, 127, 215, 215, 215,
-	215, 215, 215, 215, 215, 215, 215, 215, 272, 272,
-	272, 222, 121, 121, 121, 122, 122, 123, 123, 277,
-	277, 277, 278, 278, 278, 278, 276, 276, 276, 276,
-	276, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 286, 286, 286, 286, 286,
+	254, 254, 254, 254, 254, 255, 255, 255, 255, 257,
+	257, 257, 256, 256, 256, 256, 256, 256, 256, 256,
+	256, 256, 256, 256, 256, 256, 256, 256, 256, 256,
+	270, 270, 270, 270, 270, 258, 258, 265, 265, 266,
+	266, 267, 268, 259, 259, 260, 260, 261, 262, 269,
+	269, 269, 269, 273, 273, 263, 263, 263, 263, 263,
+	264, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 186, 186, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 216,
+	216, 216, 216, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 302, 302, 302, 302, 302, 302,
+	302, 304, 304, 305, 305, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
+	303, 303, 303, 303, 303, 303, 311, 311, 312, 312,
+	314, 314, 315, 315, 316, 317, 317, 317, 318, 319,
+	319, 313, 313, 320, 320, 320, 321, 321, 322, 322,
+	322, 322, 322, 228, 228, 228, 229, 229, 230, 236,
+	236, 236, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 126, 126,
+	126, 126, 126, 160, 160, 225, 225, 223, 223, 223,
+	227, 227, 179, 179, 272, 272, 272, 272, 272, 272,
+	272, 180, 180, 180, 187, 181, 181, 182, 182, 182,
+	182, 182, 182, 219, 220, 183, 183, 183, 221, 221,
+	231, 235, 235, 234, 233, 233, 232, 232, 209, 209,
+	210, 210, 208, 207, 168, 168, 167, 167, 166, 166,
+	336, 336, 237, 237, 169, 169, 170, 162, 162, 248,
+	248, 248, 248, 156, 156, 133, 133, 157, 157, 129,
+	129, 132, 132, 130, 130, 130, 130, 149, 149, 150,
+	150, 127, 127, 217, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 274, 274, 274, 224, 121, 121,
+	121, 122, 122, 123, 123, 279, 279, 279, 280, 280,
+	280, 280, 278, 278, 278, 278, 278, 286, 286, 286,
 	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
 	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
 	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
 	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
 	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
-	286, 285, 285, 285, 285, 285, 285, 285, 285, 285,
-	285, 285, 285, 285, 285, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 288, 287, 287, 287,
 	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287,
+	287, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289,
 }
-var sqlR2 = [...]int{
 
+var sqlR2 = [...]int8{
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5109,97 +5177,98 @@ var sqlR2 = [...]int{
 	0, 2, 0, 2, 0, 2, 0, 7, 6, 1,
 	1, 1, 3, 3, 0, 2, 0, 2, 1, 3,
 	5, 0, 4, 6, 7, 1, 1, 3, 3, 1,
-	1, 1, 1, 1, 1, 6, 2, 2, 2, 2,
-	2, 1, 1, 1, 1, 1, 4, 6, 4, 6,
-	4, 6, 3, 5, 3, 5, 1, 3, 1, 2,
-	2, 3, 2, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	5, 3, 0, 3, 3, 0, 2, 3, 2, 3,
-	6, 6, 1, 2, 2, 1, 1, 1, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 2, 3,
-	2, 1, 1, 1, 1, 1, 3, 4, 5, 3,
-	2, 6, 3, 4, 3, 3, 3, 3, 1, 3,
-	3, 1, 2, 2, 1, 0, 1, 1, 3, 1,
-	1, 1, 2, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 7, 2, 0, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 4, 6,
+	4, 6, 4, 6, 3, 5, 3, 5, 1, 3,
+	1, 2, 2, 3, 2, 5, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 5, 3, 0, 3, 3, 0, 2, 3,
+	2, 3, 6, 6, 1, 2, 2, 1, 1, 1,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	2, 3, 2, 1, 1, 1, 1, 1, 3, 4,
+	5, 3, 2, 6, 3, 4, 3, 3, 3, 3,
+	1, 3, 3, 1, 2, 2, 1, 0, 1, 1,
+	3, 1, 1, 1, 2, 2, 1, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 3, 1, 1, 1, 1, 1, 2, 3, 4,
-	4, 4, 4, 2, 4, 4, 4, 4, 4, 4,
-	2, 3, 3, 2, 4, 5, 4, 5, 2, 3,
-	3, 4, 2, 4, 3, 3, 4, 4, 2, 5,
-	5, 6, 2, 2, 0, 2, 0, 3, 7, 10,
-	6, 9, 1, 0, 1, 3, 1, 1, 1, 1,
-	8, 0, 1, 1, 0, 3, 2, 0, 3, 1,
-	2, 2, 3, 6, 2, 1, 1, 2, 4, 2,
-	5, 7, 8, 5, 3, 1, 4, 6, 5, 10,
-	1, 1, 4, 0, 3, 0, 2, 2, 2, 0,
-	1, 1, 2, 2, 0, 3, 3, 2, 1, 1,
-	2, 2, 1, 2, 1, 4, 4, 3, 0, 6,
-	11, 14, 1, 0, 1, 3, 3, 3, 5, 2,
-	0, 1, 1, 0, 6, 6, 8, 8, 10, 8,
-	10, 6, 8, 6, 8, 1, 0, 2, 0, 2,
-	3, 2, 1, 1, 1, 3, 3, 2, 2, 2,
-	1, 0, 1, 3, 2, 0, 2, 1, 1, 0,
-	1, 3, 1, 1, 1, 2, 3, 2, 2, 8,
-	11, 3, 0, 3, 0, 3, 0, 3, 0, 1,
-	0, 6, 7, 6, 1, 3, 1, 4, 2, 8,
-	5, 4, 3, 0, 2, 2, 0, 8, 2, 0,
-	1, 3, 1, 1, 3, 5, 5, 1, 1, 3,
-	3, 1, 2, 3, 2, 3, 4, 1, 1, 8,
-	8, 1, 1, 4, 4, 4, 2, 2, 2, 3,
-	1, 3, 6, 1, 0, 1, 0, 1, 0, 1,
-	1, 0, 1, 1, 0, 1, 0, 3, 1, 3,
-	2, 4, 5, 2, 2, 1, 1, 2, 5, 2,
-	3, 1, 1, 1, 3, 0, 1, 1, 1, 1,
-	3, 0, 2, 0, 2, 3, 3, 0, 1, 3,
-	3, 5, 1, 1, 3, 2, 4, 4, 0, 8,
-	4, 6, 3, 1, 5, 5, 0, 2, 3, 1,
-	3, 2, 0, 3, 4, 5, 4, 5, 4, 5,
-	2, 4, 1, 1, 0, 5, 0, 2, 2, 2,
-	1, 1, 0, 4, 2, 1, 2, 2, 4, 1,
-	3, 1, 2, 3, 2, 0, 2, 5, 2, 1,
-	1, 3, 4, 0, 1, 1, 1, 1, 2, 4,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 5, 0, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 3, 0, 1, 1, 1, 1, 5, 2, 1,
-	1, 1, 1, 4, 1, 2, 2, 1, 1, 1,
-	0, 1, 1, 4, 1, 4, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	0, 1, 4, 1, 3, 3, 3, 5, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 1, 2, 3, 1, 1, 1, 1, 1, 2,
+	3, 4, 4, 4, 4, 2, 4, 4, 4, 4,
+	4, 4, 2, 3, 3, 2, 4, 5, 4, 5,
+	2, 3, 3, 4, 2, 4, 3, 3, 4, 4,
+	2, 5, 5, 6, 2, 2, 0, 2, 0, 3,
+	7, 10, 6, 9, 1, 0, 1, 3, 1, 1,
+	1, 1, 8, 0, 1, 1, 0, 3, 2, 0,
+	3, 1, 2, 2, 3, 6, 2, 1, 1, 2,
+	4, 2, 5, 7, 8, 5, 3, 1, 4, 6,
+	5, 10, 1, 1, 4, 0, 3, 0, 2, 2,
+	2, 0, 1, 1, 2, 2, 0, 3, 3, 2,
+	1, 1, 2, 2, 1, 2, 1, 4, 4, 3,
+	0, 6, 11, 14, 1, 0, 1, 3, 3, 3,
+	5, 2, 0, 1, 1, 0, 6, 6, 8, 8,
+	10, 8, 10, 6, 8, 6, 8, 1, 0, 2,
+	0, 2, 3, 2, 1, 1, 1, 3, 3, 2,
+	2, 2, 1, 0, 1, 3, 2, 0, 2, 1,
+	1, 0, 1, 3, 1, 1, 1, 2, 3, 2,
+	2, 8, 11, 3, 0, 3, 0, 3, 0, 3,
+	0, 1, 0, 6, 7, 6, 1, 3, 1, 4,
+	2, 8, 5, 4, 3, 0, 2, 2, 0, 8,
+	2, 0, 1, 3, 1, 1, 3, 5, 5, 1,
+	1, 3, 3, 1, 2, 3, 2, 3, 4, 1,
+	1, 8, 8, 8, 1, 1, 4, 4, 4, 2,
+	2, 2, 3, 1, 3, 6, 1, 0, 1, 0,
+	1, 0, 1, 1, 0, 1, 5, 1, 0, 1,
+	0, 3, 1, 3, 2, 4, 5, 2, 2, 1,
+	1, 2, 5, 2, 3, 1, 1, 1, 3, 0,
+	1, 1, 1, 1, 3, 0, 2, 0, 2, 3,
+	3, 0, 1, 3, 3, 5, 1, 1, 3, 2,
+	4, 4, 0, 8, 4, 6, 3, 1, 5, 5,
+	0, 2, 3, 1, 3, 2, 0, 3, 4, 5,
+	4, 5, 4, 5, 2, 4, 1, 1, 0, 5,
+	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
+	2, 2, 4, 1, 3, 1, 2, 3, 2, 0,
+	2, 5, 2, 1, 1, 3, 4, 0, 1, 1,
+	1, 1, 2, 4, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	5, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 1, 1, 1,
+	1, 5, 2, 1, 1, 1, 1, 4, 1, 2,
+	2, 1, 1, 1, 0, 1, 1, 4, 1, 4,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 0, 1, 4, 1, 3, 3,
+	3, 5, 2, 2, 2, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 2, 3, 4, 3, 4, 4, 5,
-	3, 3, 3, 3, 3, 4, 3, 4, 3, 3,
-	4, 3, 4, 3, 4, 5, 6, 6, 7, 6,
-	7, 6, 7, 3, 4, 4, 1, 3, 3, 2,
-	2, 2, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 3, 4,
+	3, 4, 4, 5, 3, 3, 3, 3, 3, 4,
+	3, 4, 3, 3, 4, 3, 4, 3, 4, 5,
+	6, 6, 7, 6, 7, 6, 7, 3, 4, 4,
+	1, 3, 3, 2, 2, 2, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 5, 6, 6, 7, 1, 2, 1, 2, 1,
-	1, 2, 1, 3, 1, 1, 2, 2, 1, 1,
-	3, 5, 6, 8, 6, 6, 4, 4, 1, 1,
-	1, 5, 1, 3, 1, 3, 1, 3, 1, 1,
-	1, 1, 6, 6, 4, 4, 4, 4, 4, 6,
-	5, 5, 5, 4, 8, 6, 6, 4, 4, 4,
-	5, 0, 5, 0, 2, 0, 1, 3, 3, 2,
-	2, 0, 6, 1, 0, 3, 0, 2, 2, 0,
-	1, 4, 2, 2, 2, 2, 2, 4, 3, 5,
-	4, 3, 5, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 5, 6, 6, 7, 1,
+	2, 1, 2, 1, 1, 2, 1, 3, 1, 1,
+	2, 2, 1, 1, 3, 5, 6, 8, 6, 6,
+	4, 4, 1, 1, 1, 5, 1, 3, 1, 3,
+	1, 3, 1, 3, 1, 1, 1, 1, 6, 6,
+	4, 4, 4, 4, 4, 6, 5, 5, 5, 4,
+	8, 6, 6, 4, 4, 4, 5, 0, 5, 0,
+	2, 0, 1, 3, 3, 2, 2, 0, 6, 1,
+	0, 3, 0, 2, 2, 0, 1, 4, 2, 2,
+	2, 2, 2, 4, 3, 5, 4, 3, 5, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 1, 2, 1, 3, 1,
-	3, 3, 3, 2, 1, 3, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 3, 1, 2, 3,
-	0, 3, 3, 2, 2, 1, 0, 2, 2, 3,
-	2, 1, 1, 3, 5, 1, 2, 4, 2, 0,
-	1, 0, 3, 5, 1, 0, 2, 2, 1, 1,
-	1, 2, 1, 2, 1, 0, 1, 1, 1, 3,
-	3, 1, 3, 3, 2, 1, 1, 1, 3, 1,
-	3, 1, 3, 1, 2, 3, 1, 1, 1, 2,
-	2, 1, 3, 3, 0, 1, 2, 1, 1, 1,
-	1, 6, 2, 1, 5, 1, 1, 1, 1, 2,
-	2, 3, 1, 1, 1, 1, 0, 3, 0, 1,
+	2, 1, 2, 1, 3, 1, 3, 3, 3, 2,
+	1, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 3, 1, 2, 3, 0, 3, 3, 2,
+	2, 1, 0, 2, 2, 3, 2, 1, 1, 3,
+	5, 1, 2, 4, 2, 0, 1, 0, 3, 5,
+	1, 0, 2, 2, 1, 1, 1, 2, 1, 2,
+	1, 0, 1, 1, 1, 3, 3, 1, 3, 3,
+	2, 1, 1, 1, 3, 1, 3, 1, 3, 1,
+	2, 3, 1, 1, 1, 2, 2, 1, 3, 3,
+	0, 1, 2, 1, 1, 1, 1, 6, 2, 1,
+	5, 1, 1, 1, 1, 2, 2, 3, 1, 1,
+	1, 1, 0, 3, 0, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5231,25 +5300,25 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1,
 }
-var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -20, -22, -26, -27, -48,
+var sqlChk = [...]int16{
+	-32768, -1, -2, -3, -4, -20, -22, -26, -27, -48,
 	-34, -35, -36, -47, -42, -49, -43, -50, -51, -44,
 	-55, -54, -56, -59, -57, -52, -53, -60, -68, -88,
 	-89, -90, -91, -5, -6, -7, -8, 34, -23, -24,
-	67, -28, -29, -30, -31, -32, -33, 89, -307, 93,
+	67, -28, -29, -30, -31, -32, -33, 89, -309, 93,
 	-37, -38, -39, -41, -40, 104, 106, 124, 130, 212,
 	218, 238, 240, 242, 249, -98, -100, 236, 237, -61,
 	-62, -63, -64, -92, 260, -69, -70, -71, -72, -73,
 	-74, -75, -76, -77, -78, -79, -80, -81, -82, -83,
 	-84, -85, -86, -21, -25, -58, 300, -9, -10, -13,
 	-12, -11, -16, -18, -15, -17, -19, -14, 47, 69,
-	-305, 97, -101, -99, 351, 309, 263, 35, 272, 61,
+	-307, 97, -101, -99, 351, 309, 263, 35, 272, 61,
 	100, 244, 23, 325, 332, 253, -102, -103, 317, 281,
-	354, -323, -155, 281, 82, -130, -121, 344, 4, -284,
-	-286, 20, 21, 23, 33, 34, 35, 40, 44, 47,
+	354, -325, -157, 281, 82, -130, -121, 344, 4, -286,
+	-288, 20, 21, 23, 33, 34, 35, 40, 44, 47,
 	48, 55, 60, 61, 62, 64, 66, 67, 68, 71,
 	72, 80, 81, 83, 85, 89, 91, 93, 96, 97,
 	99, 104, 105, 106, 112, 113, 118, 120, 125, 130,
@@ -5273,8 +5342,8 @@ var sqlChk = [...]int{
 	-129, -121, 82, -121, 218, 22, 91, 143, 308, 307,
 	22, 214, 254, 283, 285, -121, -46, 351, -45, -4,
 	-27, -36, -47, -42, -43, -68, -57, -34, -50, -90,
-	-91, -327, 22, -328, -329, 69, 97, 124, 253, 143,
-	91, 307, -276, 4, -284, -286, -285, -287, 58, 70,
+	-91, -329, 22, -330, -331, 69, 97, 124, 253, 143,
+	91, 307, -278, 4, -286, -288, -287, -289, 58, 70,
 	123, 142, 136, 154, 158, 167, 169, 182, 204, 206,
 	243, 264, 22, 24, 25, 26, 27, 29, 30, 31,
 	32, 43, 49, 50, 54, 57, 59, 65, 69, 73,
@@ -5284,419 +5353,423 @@ var sqlChk = [...]int{
 	170, 172, 173, 187, 188, 189, 195, 197, 198, 200,
 	201, 213, 219, 228, 241, 253, 259, 269, 279, 281,
 	289, 293, 294, 299, 304, 305, 310, 312, 319, 320,
-	322, 323, 324, 325, 156, -121, -323, 156, -327, -125,
+	322, 323, 324, 325, 156, -121, -325, 156, -329, -125,
 	249, -121, -125, -87, 257, 4, 22, 82, 181, 259,
-	290, 171, 55, 246, 257, -66, 296, -65, 290, -275,
-	-67, -156, 181, -121, -331, 281, 156, 222, 281, 310,
-	320, -186, 82, 305, -99, -101, -100, 320, 281, 139,
-	82, 310, -144, -145, 304, 151, 102, 201, -98, -100,
-	-305, -280, -273, -236, 9, 299, 109, 197, -279, 8,
-	343, -272, -278, 5, 7, 342, 4, -284, -286, -285,
+	290, 171, 55, 246, 257, -66, 296, -65, 290, -277,
+	-67, -158, 181, -121, -333, 281, 156, 222, 281, 310,
+	320, -188, 82, 305, -99, -101, -100, 320, 281, 139,
+	82, 310, -145, -146, 304, 151, 102, 201, -98, -100,
+	-307, -282, -275, -238, 9, 299, 109, 197, -281, 8,
+	343, -274, -280, 5, 7, 342, 4, -286, -288, -287,
 	-87, 257, 34, 55, 22, 60, 83, 125, 139, 140,
 	160, 65, 66, 221, 171, 157, 295, 161, 258, 282,
-	296, 69, 311, 286, 105, -332, 296, 296, -332, -332,
-	-124, 296, 293, 281, 139, 82, 320, -308, 226, -304,
-	-121, -308, -141, -142, 22, 94, 355, -168, 351, -242,
-	349, -244, -129, -100, -243, 351, 198, -3, 293, 355,
-	-155, -148, -121, -206, -205, 353, 122, 351, -165, -166,
-	-205, -206, -121, 22, 122, 153, 153, -245, -244, -129,
-	-182, 351, -250, -249, -278, 317, 197, 355, -212, -214,
-	342, 343, 337, 187, 331, -226, -216, -229, 103, 246,
-	351, -129, -215, 356, 9, -302, -100, 29, -227, -228,
-	49, -121, 7, 8, 5, 6, -127, -253, -222, -262,
-	299, 109, 189, -300, -301, 4, -284, -277, -254, -264,
-	-258, -261, 152, 58, 74, 75, 78, 76, 79, 259,
-	310, 50, 28, 107, 108, 207, 215, 278, 297, 298,
-	134, 190, 135, 56, 126, 166, 96, 37, 39, 41,
-	42, 51, 52, 84, 86, 87, 114, 115, 116, 144,
-	146, 147, 148, 149, 150, 192, 225, 266, 276, 291,
-	292, 318, -285, -265, -266, -259, -260, -267, -224, 351,
-	122, -212, 197, -121, -87, 330, 2, 261, 351, -66,
-	53, 296, -199, -200, -118, -119, -120, 155, 220, 224,
-	330, 293, 336, 122, -280, -137, 88, -159, 353, -171,
-	-244, -212, -212, -156, 134, -121, -156, 139, -121, 134,
-	-144, -145, -157, 134, -156, -157, 134, -133, 134, -132,
-	-129, -121, 134, -148, 134, -170, -189, -190, 170, 111,
-	195, -173, 22, 94, -173, -173, 44, 352, 352, 8,
-	7, 7, -87, -282, -279, 9, 261, 221, 258, 55,
-	122, -324, 197, 122, 122, 122, 122, 122, 221, 258,
-	119, 295, 122, 155, 220, 273, 281, 320, 122, 122,
-	-198, -199, -198, 293, -125, -244, 134, -129, -132, 134,
-	-121, -244, 134, 355, -308, -149, 351, -160, -246, -212,
-	344, -160, -168, -167, -235, -212, 88, 70, -176, 158,
-	182, 123, 167, 243, 142, 7, -46, -209, 356, 351,
-	344, -239, 332, -100, -243, -242, -129, 351, -282, -130,
-	355, -276, 344, 274, 352, -154, -129, -166, -245, -131,
-	-129, -131, 260, -121, 30, -158, -212, 352, 355, -323,
-	-329, 10, 11, 57, 33, 342, 343, 344, 345, 117,
-	346, 347, 340, 341, 339, 334, 335, 336, 63, 175,
-	248, 13, 14, 15, 26, 200, 169, 331, 136, 264,
-	337, 16, 17, 18, 154, 36, 137, -126, -134, -212,
-	-212, -212, -212, -212, 206, -164, -207, 349, -100, 351,
-	-100, -212, -158, 7, -100, -221, 349, -230, -212, -165,
-	351, 5, 351, 5, -309, 326, 119, 351, 351, 351,
+	296, 69, 311, 286, 105, -334, 296, 296, -334, -334,
+	-124, 296, 293, 281, 139, 82, 320, -310, 226, -306,
+	-121, -310, -141, -142, -143, 22, 94, 355, -170, 351,
+	-244, 349, -246, -129, -100, -245, 351, 198, -3, 293,
+	355, -157, -149, -121, -208, -207, 353, 122, 351, -167,
+	-168, -207, -208, -121, 22, 122, 153, 153, -247, -246,
+	-129, -184, 351, -252, -251, -280, 317, 197, 355, -214,
+	-216, 342, 343, 337, 187, 331, -228, -218, -231, 103,
+	246, 351, -129, -217, 356, 9, -304, -100, 29, -229,
+	-230, 49, -121, 7, 8, 5, 6, -127, -255, -224,
+	-264, 299, 109, 189, -302, -303, 4, -286, -279, -256,
+	-266, -260, -263, 152, 58, 74, 75, 78, 173, 76,
+	79, 259, 310, 50, 28, 107, 108, 207, 215, 278,
+	297, 298, 134, 190, 135, 56, 126, 166, 96, 37,
+	39, 41, 42, 51, 52, 84, 86, 87, 114, 115,
+	116, 144, 146, 147, 148, 149, 150, 192, 225, 266,
+	276, 291, 292, 318, -287, -267, -268, -261, -262, -269,
+	-226, 351, 122, -214, 197, -121, -87, 330, 2, 261,
+	351, -66, 53, 296, -201, -202, -118, -119, -120, 155,
+	220, 224, 330, 293, 336, 122, -282, -137, 88, -161,
+	353, -173, -246, -214, -214, -158, 134, -121, -158, 139,
+	-121, 134, -145, -146, -159, 134, -158, -159, 134, -133,
+	134, -132, -129, -121, 134, -149, 134, -172, -191, -192,
+	170, 111, 195, -175, 22, 94, -175, -175, 44, 352,
+	352, 8, 7, 7, -87, -284, -281, 9, 261, 221,
+	258, 55, 122, -326, 197, 122, 122, 122, 122, 122,
+	221, 258, 119, 295, 122, 155, 220, 273, 281, 320,
+	122, 122, -200, -201, -200, 293, -125, -246, 134, -129,
+	-132, 134, -121, -246, 134, 355, -310, -150, 351, -162,
+	-248, -214, 344, -162, -162, 197, -170, -169, -237, -214,
+	88, 70, -178, 158, 182, 123, 167, 243, 142, 7,
+	-46, -211, 356, 351, 344, -241, 332, -100, -245, -244,
+	-129, 351, -284, -130, 355, -278, 344, 274, 352, -156,
+	-129, -168, -247, -131, -129, -131, 260, -121, 30, -160,
+	-214, 352, 355, -325, -331, 10, 11, 57, 33, 342,
+	343, 344, 345, 117, 346, 347, 340, 341, 339, 334,
+	335, 336, 63, 175, 248, 13, 14, 15, 26, 200,
+	169, 331, 136, 264, 337, 16, 17, 18, 154, 36,
+	137, -126, -134, -214, -214, -214, -214, -214, 206, -166,
+	-209, 349, -100, 351, -100, -214, -160, 7, -100, -223,
+	349, -232, -214, -167, 351, 5, 351, 5, -311, 326,
+	119, 351, 351, 351, 351, 351, 351, 351, 351, 351,
 	351, 351, 351, 351, 351, 351, 351, 351, 351, 351,
-	351, 351, 351, 351, 351, 217, -271, 321, -271, -271,
-	-255, 351, -255, -256, 351, -255, 327, 332, 351, 30,
-	-223, -251, -252, -254, -263, -257, -261, -262, 40, 46,
-	45, 288, 180, 255, 267, 313, 38, 196, 145, 144,
-	146, 147, 148, 149, 150, 266, 37, 225, 115, 116,
-	114, 96, 87, 86, 192, 42, 41, -265, -266, -259,
-	-260, 84, 291, 292, 152, 39, 52, 51, 318, 276,
-	-283, -282, -323, -65, -275, -158, 30, -199, 355, 168,
-	-136, 174, 186, 131, 198, 328, -281, 5, 4, -222,
-	-236, 88, 171, -262, -274, 88, -280, -274, 88, 72,
-	353, -276, -110, 355, 48, 239, 351, -143, 187, -117,
-	-306, 325, -143, 351, -122, 134, -121, -306, 187, -170,
-	-110, 355, 103, -110, 103, -110, 355, 103, 356, 103,
-	103, -190, -189, -191, -212, 22, -194, 113, 183, -212,
-	-214, -99, -99, -99, -146, -240, -212, 219, 139, -156,
-	22, 262, -275, -326, 119, -323, -275, -275, -275, -275,
-	-275, 257, -46, 119, -121, 168, -275, -275, 281, 139,
-	281, -125, -108, 234, -107, 21, 23, 97, 315, 103,
-	270, 287, 250, 270, 287, 250, 234, 103, 234, 234,
-	103, -304, 30, -148, -151, 355, 122, 30, 4, -151,
-	355, 352, 158, 158, -242, -176, 158, -174, 204, -174,
-	-174, -321, 351, 350, -239, -276, 349, 357, -158, -238,
-	-237, 30, -121, 202, 352, -129, -248, 333, -121, 122,
-	352, 355, -204, 323, -195, -57, 351, 88, 30, -195,
-	-161, -162, -247, -163, -129, 351, -121, 352, 355, -46,
-	-249, 293, -269, -251, -268, 230, 231, 229, 233, 232,
-	-251, -276, 290, -212, -212, -212, -212, -212, -212, -212,
-	-212, -212, -212, -212, -212, -212, -212, -212, -212, -212,
-	-212, -212, -212, -212, -212, 169, 136, 264, 36, 137,
-	-212, 293, -212, -212, -212, -212, 179, 187, 189, 299,
-	109, 306, 94, 193, -334, 279, 32, -219, -100, 351,
-	-234, 27, 269, 22, -226, 246, 351, -207, -212, -208,
-	-158, 352, 352, 355, -158, -225, 350, -221, -233, -232,
-	322, 352, -158, 319, 22, 94, 344, 7, -183, 329,
-	178, 85, 132, 177, -184, 252, -310, 112, 127, 351,
-	352, 352, 352, -212, -212, -177, -270, -158, 4, 329,
-	178, 85, 132, 177, 252, -177, -178, -212, -158, -179,
-	-213, -214, 342, 343, 337, 351, 246, -180, -212, -158,
-	-212, 43, 165, 294, -181, -212, 122, -158, -212, -212,
-	-212, -158, -158, -158, 351, 7, 7, 290, 290, 7,
-	-45, 352, 355, -150, 29, -183, 351, -248, 355, 122,
-	352, 296, -200, -135, 224, 268, 235, 256, 355, -276,
-	-244, -138, -148, -139, -121, -203, -202, -290, -291, -288,
-	139, 305, 110, 65, -289, 54, 219, 121, 30, 103,
-	211, 30, 197, 187, -113, 284, 103, -156, -157, -157,
-	-132, -133, -276, -121, -148, -192, -272, 351, 343, -193,
-	246, 247, 355, -106, 31, 92, 159, -129, -325, -121,
-	257, -46, -129, -132, -129, 355, 293, -187, 65, 59,
-	-202, 134, 59, -288, -121, 305, -187, 65, -187, 65,
-	65, -244, 33, -57, 122, 33, -57, 122, 293, -132,
-	293, 293, -244, 351, 352, -204, -246, -153, -242, -276,
-	-204, -235, -242, -242, -175, 312, 197, 158, -242, -237,
-	352, -322, 7, -239, -238, 7, -211, -210, 120, 185,
-	352, -121, 351, -239, 352, -93, 133, 193, 274, 122,
-	-129, -172, 241, -212, -172, -197, 197, -154, 317, -121,
-	-172, -152, 355, 122, 336, -154, -212, -325, 330, -212,
-	-212, 293, -334, 279, -219, -212, 179, 189, 299, 109,
-	306, 94, 193, 122, 351, -213, -213, -158, -216, 351,
-	-158, 350, 359, 352, -212, 350, 350, 355, -231, -232,
-	98, -212, -145, 355, -144, -212, -158, -158, 352, 352,
-	293, 293, 293, 293, 351, -315, 205, 351, 351, -212,
-	30, 355, 352, 122, 352, 352, -185, 213, 352, 10,
-	11, 342, 343, 344, 345, 117, 346, 347, 340, 341,
-	339, 334, 335, 336, 63, 175, 248, 13, 14, 15,
-	154, 137, -213, -213, -213, -158, 351, 352, -217, -218,
-	122, 119, 30, -181, -181, -181, 352, 122, -158, 355,
-	355, 355, 352, 352, 352, 7, 352, 355, 352, 330,
-	330, 352, -251, 349, 349, 7, -96, 325, -282, -325,
-	-118, 303, 62, 224, -280, 352, 352, 355, -251, -122,
-	139, 351, -122, -121, 351, 159, 159, -57, -156, 5,
-	-57, -129, 103, -114, 99, -333, 336, -121, -110, -110,
-	-110, -193, -212, -240, -129, 356, 355, -248, -107, -129,
-	-121, -121, 187, -202, 134, -112, 187, -121, -121, 134,
-	-121, 134, -121, -121, -108, 234, -57, 351, -57, 351,
-	-121, 234, -121, -129, 234, -45, -169, 127, -248, 355,
-	-169, -175, 351, -212, -242, 350, 352, 355, -238, 350,
-	355, 358, 336, -239, 351, -148, -237, -96, 122, 280,
-	274, -160, 188, -172, 64, 352, -204, -162, -153, -235,
-	352, -212, -212, -213, -213, 122, 351, -212, -223, 26,
-	26, 352, -158, 352, 355, -208, -212, 352, -221, 100,
-	-212, 289, 352, 319, -145, -145, -145, 5, 178, 132,
-	177, -184, 177, -184, -184, 7, -316, -121, 351, 323,
-	-144, 352, -269, -251, -212, -217, -212, -269, -251, -213,
-	-213, -213, -213, -213, -213, -213, -213, -213, -213, -213,
-	-213, -213, -213, -213, -213, -213, -213, -213, 94, 187,
-	193, -213, 355, -158, 352, -218, -217, -212, -212, -251,
-	352, 352, 352, -158, -212, -212, -212, 352, 7, 350,
-	7, 7, 352, 199, -140, 138, -203, -292, 351, -122,
-	-147, -241, -121, -303, 351, -300, -301, -127, 351, -289,
-	-212, 351, 351, 351, -143, 351, -121, -115, 164, -333,
-	-279, -306, 198, 352, -106, -276, -121, 293, 293, 103,
-	187, 314, -105, 97, 260, -188, 103, -110, 103, -110,
-	293, -187, 65, -158, -158, 293, 293, 352, -220, 129,
-	44, -242, -220, -148, -209, 7, -210, -276, 349, -238,
-	-148, 352, -283, 290, -196, 351, 197, -57, -172, 336,
-	26, 26, -212, -223, 352, -212, -212, 352, -212, 350,
-	-212, 5, -212, 352, 352, 352, 352, -317, -121, -212,
-	352, 352, 352, -218, 122, 94, 193, 351, -212, 352,
-	352, 355, 352, 352, 352, 350, 350, 351, 137, -293,
-	65, -294, 57, 110, 69, 187, 189, 305, 219, 54,
-	88, 228, -147, 351, 352, 355, -128, -165, 57, -128,
-	-212, 351, -148, 352, -147, -148, -138, 30, -147, 197,
-	-116, 163, -333, -279, -113, -106, -121, -121, -202, 103,
-	187, 88, 187, 88, 81, 301, -121, -121, -129, -121,
-	-121, 352, 352, -121, -129, -312, 324, -212, -158, -312,
-	352, -239, 7, 352, -215, -127, -121, 95, -148, 65,
-	-168, -100, 351, -212, -212, 352, 352, -145, -311, 210,
-	352, -213, 122, 351, -223, 352, -212, -95, -94, 5,
-	208, -121, -276, -121, 110, 134, 189, 159, 351, -213,
-	-129, 352, -147, -201, -330, 68, 277, -241, -106, -276,
-	-106, 352, -158, 352, 352, 352, 352, -57, 352, -129,
-	-333, -279, -114, -202, 189, 189, -212, -251, -110, -110,
-	293, 293, 293, 293, -313, -314, -121, -238, 350, 351,
-	307, 188, 352, -121, 352, -145, 44, -213, -223, 352,
-	352, 355, 352, -97, 336, -129, -294, -122, 187, -212,
-	-123, 351, -201, 352, -140, 351, -128, -145, 228, -140,
-	-201, 351, -279, -115, -109, 57, -121, -121, 351, 351,
-	355, 30, -158, 260, -204, -318, 223, 247, -158, 352,
-	-94, 5, 351, 103, 352, -297, 176, -121, -140, -201,
-	-148, -106, 352, -129, -140, -147, -116, -104, 312, -276,
-	-158, -158, -314, -316, -145, -161, 352, -319, -320, 36,
-	302, 72, -212, -319, -148, 110, -295, -298, -296, 197,
-	123, 209, 265, 352, -140, 352, -143, 352, -212, 352,
-	352, 352, -204, -320, 216, 118, 246, 216, 118, 352,
-	-121, -296, 197, -298, 197, 307, 91, -297, -201, 26,
-	-111, 48, 239, -299, 184, 239, 48, 260, -299, -295,
-	-140, -320, 20, 189, 88,
+	217, -273, 321, -273, -273, -257, 351, -257, -258, 351,
+	-257, 327, 332, 351, 30, -225, -253, -254, -256, -265,
+	-259, -263, -264, 40, 46, 45, 288, 180, 255, 267,
+	313, 38, 196, 145, 144, 146, 147, 148, 149, 150,
+	266, 37, 225, 115, 116, 114, 96, 87, 86, 192,
+	42, 41, -267, -268, -261, -262, 84, 291, 292, 152,
+	39, 52, 51, 318, 276, -285, -284, -325, -65, -277,
+	-160, 30, -201, 355, 168, -136, 174, 186, 131, 198,
+	328, -283, 5, 4, -224, -238, 88, 171, -264, -276,
+	88, -282, -276, 88, 72, 353, -278, -110, 355, 48,
+	239, 351, -144, 187, -117, -308, 325, -144, 351, -122,
+	134, -121, -308, 187, -172, -110, 355, 103, -110, 103,
+	-110, 355, 103, 356, 103, 103, -192, -191, -193, -214,
+	22, -196, 113, 183, -214, -216, -99, -99, -99, -147,
+	-242, -214, 219, 139, -158, 22, 262, -277, -328, 119,
+	-325, -277, -277, -277, -277, -277, 257, -46, 119, -121,
+	168, -277, -277, 281, 139, 281, -125, -108, 234, -107,
+	21, 23, 97, 315, 103, 270, 287, 250, 270, 287,
+	250, 234, 103, 234, 234, 103, -306, 30, -149, -152,
+	355, 122, 30, 4, -152, -152, 351, 355, 352, 158,
+	158, -244, -178, 158, -176, 204, -176, -176, -323, 351,
+	350, -241, -278, 349, 357, -160, -240, -239, 30, -121,
+	202, 352, -129, -250, 333, -121, 122, 352, 355, -155,
+	312, -197, -57, 351, 88, 30, -197, -163, -164, -249,
+	-165, -129, 351, -121, 352, 355, -46, -251, 293, -271,
+	-253, -270, 230, 231, 229, 233, 232, -253, -278, 290,
+	-214, -214, -214, -214, -214, -214, -214, -214, -214, -214,
+	-214, -214, -214, -214, -214, -214, -214, -214, -214, -214,
+	-214, -214, 169, 136, 264, 36, 137, -214, 293, -214,
+	-214, -214, -214, 179, 187, 189, 299, 109, 306, 94,
+	193, -336, 279, 32, -221, -100, 351, -236, 27, 269,
+	22, -228, 246, 351, -209, -214, -210, -160, 352, 352,
+	355, -160, -227, 350, -223, -235, -234, 322, 352, -160,
+	319, 22, 94, 344, 7, -185, 329, 178, 85, 132,
+	177, -186, 252, -312, 112, 127, 351, 352, 352, 352,
+	352, -214, -214, -179, -272, -160, 4, 329, 178, 85,
+	132, 177, 252, -179, -180, -214, -160, -181, -215, -216,
+	342, 343, 337, 351, 246, -182, -214, -160, -214, 43,
+	165, 294, -183, -214, 122, -160, -214, -214, -214, -160,
+	-160, -160, 351, 7, 7, 290, 290, 7, -45, 352,
+	355, -151, 29, -185, 351, -250, 355, 122, 352, 296,
+	-202, -135, 224, 268, 235, 256, 355, -278, -246, -138,
+	-149, -139, -121, -205, -204, -292, -293, -290, 139, 305,
+	110, 65, -291, 54, 219, 121, 30, 103, 211, 30,
+	197, 187, -113, 284, 103, -158, -159, -159, -132, -133,
+	-278, -121, -149, -194, -274, 351, 343, -195, 246, 247,
+	355, -106, 31, 92, 159, -129, -327, -121, 257, -46,
+	-129, -132, -129, 355, 293, -189, 65, 59, -204, 134,
+	59, -290, -121, 305, -189, 65, -189, 65, 65, -246,
+	33, -57, 122, 33, -57, 122, 293, -132, 293, 293,
+	-246, 351, 352, -206, 323, -248, -153, -244, -278, -206,
+	-206, -160, -237, -244, -244, -177, 312, 197, 158, -244,
+	-239, 352, -324, 7, -241, -240, 7, -213, -212, 120,
+	185, 352, -121, 351, -241, 352, -93, 133, 193, 274,
+	122, -129, -206, -153, -174, -199, 241, 197, -156, 317,
+	-121, -174, -154, 355, 122, 336, -156, -214, -327, 330,
+	-214, -214, 293, -336, 279, -221, -214, 179, 189, 299,
+	109, 306, 94, 193, 122, 351, -215, -215, -160, -218,
+	351, -160, 350, 359, 352, -214, 350, 350, 355, -233,
+	-234, 98, -214, -146, 355, -145, -214, -160, -160, 352,
+	352, 293, 293, 293, 293, 351, -317, 205, 351, 351,
+	-214, 30, 355, 352, 122, 352, 352, -187, 213, 352,
+	10, 11, 342, 343, 344, 345, 117, 346, 347, 340,
+	341, 339, 334, 335, 336, 63, 175, 248, 13, 14,
+	15, 154, 137, -215, -215, -215, -160, 351, 352, -219,
+	-220, 122, 119, 30, -183, -183, -183, 352, 122, -160,
+	355, 355, 355, 352, 352, 352, 7, 352, 355, 352,
+	330, 330, 352, -253, 349, 349, 7, -96, 325, -284,
+	-327, -118, 303, 62, 224, -282, 352, 352, 355, -253,
+	-122, 139, 351, -122, -121, 351, 159, 159, -57, -158,
+	5, -57, -129, 103, -114, 99, -335, 336, -121, -110,
+	-110, -110, -195, -214, -242, -129, 356, 355, -250, -107,
+	-129, -121, -121, 187, -204, 134, -112, 187, -121, -121,
+	134, -121, 134, -121, -121, -108, 234, -57, 351, -57,
+	351, -121, 234, -121, -129, 234, -45, -171, 127, -214,
+	-250, 355, -171, -171, 352, -177, 351, -214, -244, 350,
+	352, 355, -240, 350, 355, 358, 336, -241, 351, -149,
+	-239, -96, 122, 280, 274, -174, -174, -162, 188, 64,
+	352, -206, -164, -153, -237, 352, -214, -214, -215, -215,
+	122, 351, -214, -225, 26, 26, 352, -160, 352, 355,
+	-210, -214, 352, -223, 100, -214, 289, 352, 319, -146,
+	-146, -146, 5, 178, 132, 177, -186, 177, -186, -186,
+	7, -318, -121, 351, 323, -145, 352, -271, -253, -214,
+	-219, -214, -271, -253, -215, -215, -215, -215, -215, -215,
+	-215, -215, -215, -215, -215, -215, -215, -215, -215, -215,
+	-215, -215, -215, 94, 187, 193, -215, 355, -160, 352,
+	-220, -219, -214, -214, -253, 352, 352, 352, -160, -214,
+	-214, -214, 352, 7, 350, 7, 7, 352, 199, -140,
+	138, -205, -294, 351, -122, -148, -243, -121, -305, 351,
+	-302, -303, -127, 351, -291, -214, 351, 351, 351, -144,
+	351, -121, -115, 164, -335, -281, -308, 198, 352, -106,
+	-278, -121, 293, 293, 103, 187, 314, -105, 97, 260,
+	-190, 103, -110, 103, -110, 293, -189, 65, -160, -160,
+	293, 293, 352, -222, 129, 44, -244, -222, -222, -149,
+	-211, 7, -212, -278, 349, -240, -149, 352, -285, 290,
+	-198, 351, 197, -57, -174, 336, 26, 26, -214, -225,
+	352, -214, -214, 352, -214, 350, -214, 5, -214, 352,
+	352, 352, 352, -319, -121, -214, 352, 352, 352, -220,
+	122, 94, 193, 351, -214, 352, 352, 355, 352, 352,
+	352, 350, 350, 351, 137, -295, 65, -296, 57, 110,
+	69, 187, 189, 305, 219, 54, 88, 228, -148, 351,
+	352, 355, -128, -167, 57, -128, -214, 351, -149, 352,
+	-148, -149, -138, 30, -148, 197, -116, 163, -335, -281,
+	-113, -106, -121, -121, -204, 103, 187, 88, 187, 88,
+	81, 301, -121, -121, -129, -121, -121, 352, 352, -121,
+	-129, -314, 324, -214, -160, -314, -314, 352, -241, 7,
+	352, -217, -127, -121, 95, -149, 65, -170, -100, 351,
+	-214, -214, 352, 352, -146, -313, 210, 352, -215, 122,
+	351, -225, 352, -214, -95, -94, 5, 208, -121, -278,
+	-121, 110, 134, 189, 159, 351, -215, -129, 352, -148,
+	-203, -332, 68, 277, -243, -106, -278, -106, 352, -160,
+	352, 352, 352, 352, -57, 352, -129, -335, -281, -114,
+	-204, 189, 189, -214, -253, -110, -110, 293, 293, 293,
+	293, -315, -316, -121, -240, 350, 351, 307, 188, 352,
+	-121, 352, -146, 44, -215, -225, 352, 352, 355, 352,
+	-97, 336, -129, -296, -122, 187, -214, -123, 351, -203,
+	352, -140, 351, -128, -146, 228, -140, -203, 351, -281,
+	-115, -109, 57, -121, -121, 351, 351, 355, 30, -160,
+	260, -206, -320, 223, 247, -160, 352, -94, 5, 351,
+	103, 352, -299, 176, -121, -140, -203, -149, -106, 352,
+	-129, -140, -148, -116, -104, 312, -278, -160, -160, -316,
+	-318, -146, -163, 352, -321, -322, 36, 302, 72, -214,
+	-321, -149, 110, -297, -300, -298, 197, 123, 209, 265,
+	352, -140, 352, -144, 352, -214, 352, 352, 352, -206,
+	-322, 216, 118, 246, 216, 118, 352, -121, -298, 197,
+	-300, 197, 307, 91, -299, -203, 26, -111, 48, 239,
+	-301, 184, 239, 48, 260, -301, -297, -140, -322, 20,
+	189, 88,
 }
-var sqlDef = [...]int{
 
+var sqlDef = [...]int16{
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
 	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
 	30, 31, 32, 34, 35, 36, 37, 0, 105, 106,
 	0, 109, 110, 111, 112, 113, 114, 0, 0, 0,
-	121, 122, 123, 124, 125, 0, 506, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, -2, 0, 0, 191,
-	192, 193, 194, 195, 0, 242, 243, 244, 245, 246,
-	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
-	257, 258, 259, 412, 413, 414, 508, 38, 39, 40,
-	41, 42, 45, 46, 47, 48, 43, 44, 0, 383,
-	505, 0, -2, 516, 0, 0, 0, 421, 0, 421,
-	421, 425, 0, 0, 0, 514, 491, 492, 0, 0,
-	-2, 0, 172, 0, 1053, 981, 987, 988, 1012, 1013,
-	1014, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1050, 1051, 1052, 1054, 1055, 1056, 1057, 1058, 1059, 1060,
-	1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070,
-	1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080,
-	1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090,
-	1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100,
-	1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110,
-	1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120,
-	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130,
-	1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
-	1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160,
-	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
-	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
-	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
-	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
-	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
-	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
-	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
-	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
-	0, 983, 1053, 166, 1112, 168, 0, 0, 0, 0,
-	116, 117, 118, 119, 120, 165, 142, 0, 149, 150,
-	151, 152, 153, 154, 155, 156, 144, 145, 146, 147,
-	148, 0, 175, 176, 177, 179, 180, 181, 182, 183,
-	184, 185, 302, 1026, 1027, 1028, 1029, 1030, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
-	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
-	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
-	1333, 1334, 1335, 1336, 0, 162, 0, 0, 0, 411,
-	1139, 427, 409, 188, 0, 262, 263, 264, 265, 266,
-	0, -2, 1041, 1227, 1145, 200, 1166, 208, 1233, 0,
-	211, 216, -2, 138, 0, 507, 0, 0, 0, 0,
-	0, 0, 0, 382, -2, 487, 488, 0, 0, 0,
-	0, 0, -2, 0, 511, 511, 511, 0, 0, 488,
-	0, 190, 219, 220, 221, 230, 231, 232, 233, 372,
-	0, 374, 240, 241, 1008, 0, 1022, 1023, 1024, 1025,
-	260, 0, 0, 0, 263, 0, 273, 304, 0, 0,
-	0, 0, 0, 280, 0, 283, 0, 0, 288, 292,
-	0, 0, 298, 0, 0, 429, 420, 429, 417, 418,
-	419, 422, 0, 0, 0, 0, 0, 497, 1118, 500,
-	994, 498, 0, 0, 513, 512, 0, 544, 0, 496,
-	506, 558, 595, 572, 563, 0, 0, 2, 0, 0,
-	173, 174, 991, 989, 990, 0, 0, 0, 984, 960,
-	958, 959, 167, 169, 0, 0, 0, 0, 601, 595,
-	163, 0, 0, 157, 159, 1239, 0, 0, 307, 703,
-	0, 0, 0, 0, 0, 0, 795, 797, 1201, 1227,
-	0, 799, 800, 0, 802, 804, 805, 0, 808, 809,
-	951, 983, 997, 998, 999, 1000, 0, 0, 1003, 0,
-	1005, 1006, 1007, 851, 818, -2, -2, 995, 631, 632,
-	633, 634, -2, 1241, 822, 824, 826, 828, 829, 830,
-	831, 0, 1185, 1202, 1203, 1223, 1224, 1232, 1236, 1237,
-	1209, 1220, 1210, 1197, 1207, 1218, 1059, -2, -2, -2,
+	123, 124, 125, 126, 127, 0, 509, 0, 0, 0,
+	0, 0, 0, 0, 0, 479, -2, 0, 0, 193,
+	194, 195, 196, 197, 0, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 414, 415, 416, 511, 38, 39, 40,
+	41, 42, 45, 46, 47, 48, 43, 44, 0, 385,
+	508, 0, -2, 520, 0, 0, 0, 423, 0, 423,
+	423, 427, 0, 0, 0, 518, 494, 495, 0, 0,
+	-2, 0, 174, 0, 1059, 987, 993, 994, 1018, 1019,
+	1020, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1058, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
+	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
+	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
+	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
+	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
+	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
+	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
+	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
+	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
+	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
+	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
+	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
+	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
+	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
+	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	0, 989, 1059, 168, 1118, 170, 0, 0, 0, 0,
+	118, 119, 120, 121, 122, 167, 144, 0, 151, 152,
+	153, 154, 155, 156, 157, 158, 146, 147, 148, 149,
+	150, 0, 177, 178, 179, 181, 182, 183, 184, 185,
+	186, 187, 304, 1032, 1033, 1034, 1035, 1036, 1247, 1248,
+	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
+	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
+	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
+	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
+	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
+	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
+	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318,
+	1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328,
+	1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
+	1339, 1340, 1341, 1342, 0, 164, 0, 0, 0, 413,
+	1145, 429, 411, 190, 0, 264, 265, 266, 267, 268,
+	0, -2, 1047, 1233, 1151, 202, 1172, 210, 1239, 0,
+	213, 218, -2, 140, 0, 510, 0, 0, 0, 0,
+	0, 0, 0, 384, -2, 489, 490, 0, 0, 0,
+	0, 0, -2, 0, 514, 514, 514, 0, 0, 490,
+	0, 192, 221, 222, 223, 232, 233, 234, 235, 374,
+	0, 376, 242, 243, 1014, 0, 1028, 1029, 1030, 1031,
+	262, 0, 0, 0, 265, 0, 275, 306, 0, 0,
+	0, 0, 0, 282, 0, 285, 0, 0, 290, 294,
+	0, 0, 300, 0, 0, 431, 422, 431, 419, 420,
+	421, 424, 0, 0, 0, 0, 0, 500, 1124, 503,
+	1000, 501, 0, 0, 0, 517, 515, 0, 548, 0,
+	499, 509, 562, 599, 576, 567, 0, 0, 2, 0,
+	0, 175, 176, 997, 995, 996, 0, 0, 0, 990,
+	966, 964, 965, 169, 171, 0, 0, 0, 0, 605,
+	599, 165, 0, 0, 159, 161, 1245, 0, 0, 309,
+	707, 0, 0, 0, 0, 0, 0, 799, 801, 1207,
+	1233, 0, 803, 804, 0, 806, 808, 809, 0, 812,
+	813, 957, 989, 1003, 1004, 1005, 1006, 0, 0, 1009,
+	0, 1011, 1012, 1013, 857, 822, -2, -2, 1001, 635,
+	636, 637, 638, -2, 1247, 826, 828, 830, 832, 834,
+	835, 836, 837, 0, 1191, 1208, 1209, 1229, 1230, 1238,
+	1242, 1243, 1215, 1226, 1216, 1203, 1213, 1224, 1065, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, 1021, 665, 666, 671, 672, 674, 0, 0,
-	0, 410, 0, 426, 189, 267, 196, 0, 0, 199,
-	1196, 1166, 202, 430, 432, 433, 434, 0, 0, 0,
-	0, 0, 0, 0, 212, 213, 214, 139, 0, 80,
-	599, 107, 108, 355, 1209, -2, 355, 1016, 504, 1209,
-	-2, 0, 80, 1209, 136, 80, 1209, 80, 1209, 979,
-	986, 132, 1209, 134, 1209, 483, 525, 526, 0, 0,
-	0, 0, 509, 510, 0, 0, 0, 479, 480, 373,
-	1010, 1009, 261, 268, 89, 90, 0, 281, 289, 0,
-	0, 306, 0, 0, 0, 0, 0, 0, 282, 290,
-	506, 0, 0, 0, 294, 295, 0, 0, 0, 0,
-	415, 428, 416, 0, 424, 0, 1209, 595, 0, 1209,
-	0, 0, 1209, 0, 499, 0, 0, 547, 971, 975,
-	976, 547, 545, 0, 968, 966, 967, 0, 0, 0,
-	0, 592, 592, 592, 590, 566, 0, 572, 0, 0,
-	596, 584, 0, -2, 563, 0, 597, 0, 586, 982,
-	0, 956, 957, 102, 0, 0, 977, 961, 605, 0,
-	454, 0, 0, 602, 0, 0, 907, 506, 0, 0,
-	178, 0, 0, 0, 0, 886, 887, 888, 889, 890,
-	891, 894, 895, 892, 893, 896, 897, 898, 0, 0,
-	0, 899, 900, 901, 0, 0, 903, 0, 905, 0,
-	0, 0, 0, 0, 0, 965, 0, 0, 902, 708,
-	709, 710, 732, 733, 0, 796, 962, 955, 798, 0,
-	-2, 907, 0, 801, 806, 807, 0, 0, 950, -2,
-	0, 1002, 0, 700, 853, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 930, 936, 0, 0, 0,
-	0, 0, 0, 0, 0, 650, 668, 679, 676, 675,
-	652, 0, 651, 649, 0, 653, 0, 0, 0, 506,
-	0, 909, 613, 614, 615, 616, 617, 700, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
-	662, 0, 637, 637, 637, 654, 655, 663, 664, 669,
-	670, 681, 682, 684, 686, 680, 680, 680, 677, 678,
-	586, 91, 0, 197, 0, 0, 0, 203, 0, 0,
-	435, 227, 228, 229, 437, 438, 209, 234, 235, 236,
-	237, 238, 239, 0, 204, 206, 217, 205, 207, 210,
-	0, 140, 375, 0, 78, 79, 313, 0, 0, 376,
-	0, 503, 0, 0, 0, 1209, 1015, 442, 0, 486,
-	126, 0, 0, 128, 0, 130, 0, 0, 0, 0,
-	0, 523, 524, 527, 531, 532, 535, 538, 539, 529,
-	703, 493, 494, 495, 517, 518, 393, 0, 0, 269,
-	270, 271, 272, 274, 0, 303, 275, 276, 277, 278,
-	279, 284, 286, 506, 291, 293, 296, 297, 0, 0,
-	0, 423, 49, 406, 59, 0, 406, 406, 0, 0,
-	0, 0, 55, 0, 0, 57, 0, 0, 0, 0,
-	0, 501, 0, 0, 605, 0, 0, 0, 974, 605,
-	0, 970, 0, 0, 0, 0, 0, 587, 591, 588,
-	589, 0, 0, 572, 584, 555, 0, 0, 0, 562,
-	583, 0, 582, 571, -2, 0, 94, 0, 992, 0,
-	0, 0, 466, 0, 466, 456, 0, 0, 0, 466,
-	469, 470, 472, 473, 0, 0, 603, 164, 0, 143,
-	158, 0, 704, 609, 610, 656, 657, 658, 659, 660,
-	705, 706, 0, 711, 712, 713, 714, 715, 716, 717,
-	718, 719, 720, -2, -2, -2, 724, 725, 726, -2,
-	-2, -2, 730, 731, -2, 904, 906, 0, 965, 0,
-	-2, 0, -2, -2, -2, -2, 744, 0, 746, 749,
-	751, 753, 0, 0, 0, 0, 964, 763, 942, 0,
-	0, 883, 884, 885, 748, 0, 0, 963, 954, 0,
-	0, -2, 803, 0, 0, 0, 913, 914, 949, 945,
-	0, 810, 516, 0, 0, 0, 0, 0, 1011, 687,
-	688, 689, 690, 691, 692, 701, 861, 0, 0, 0,
-	823, 825, 827, 0, 0, 0, 0, 917, -2, -2,
-	-2, -2, -2, -2, -2, 0, 0, 907, 927, 0,
-	0, 766, 0, 0, 0, 0, 1227, 0, 907, 935,
-	0, 0, 0, 0, 0, 907, 0, 941, 0, 0,
+	-2, -2, -2, -2, 1027, 669, 670, 675, 676, 678,
+	0, 0, 0, 412, 0, 428, 191, 269, 198, 0,
+	0, 201, 1202, 1172, 204, 432, 434, 435, 436, 0,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 141,
+	0, 80, 603, 107, 108, 357, 1215, -2, 357, 1022,
+	507, 1215, -2, 0, 80, 1215, 138, 80, 1215, 80,
+	1215, 985, 992, 134, 1215, 136, 1215, 485, 529, 530,
+	0, 0, 0, 0, 512, 513, 0, 0, 0, 481,
+	482, 375, 1016, 1015, 263, 270, 89, 90, 0, 283,
+	291, 0, 0, 308, 0, 0, 0, 0, 0, 0,
+	284, 292, 509, 0, 0, 0, 296, 297, 0, 0,
+	0, 0, 417, 430, 418, 0, 426, 0, 1215, 599,
+	0, 1215, 0, 0, 1215, 0, 502, 0, 0, 551,
+	977, 981, 982, 551, 551, 0, 549, 0, 974, 972,
+	973, 0, 0, 0, 0, 596, 596, 596, 594, 570,
+	0, 576, 0, 0, 600, 588, 0, -2, 567, 0,
+	601, 0, 590, 988, 0, 962, 963, 102, 0, 0,
+	983, 967, 117, 0, 456, 0, 0, 606, 0, 0,
+	913, 509, 0, 0, 180, 0, 0, 0, 0, 892,
+	893, 894, 895, 896, 897, 900, 901, 898, 899, 902,
+	903, 904, 0, 0, 0, 905, 906, 907, 0, 0,
+	909, 0, 911, 0, 0, 0, 0, 0, 0, 971,
+	0, 0, 908, 712, 713, 714, 736, 737, 0, 800,
+	968, 961, 802, 0, -2, 913, 0, 805, 810, 811,
+	0, 0, 956, -2, 0, 1008, 0, 704, 859, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 161, 0, 606, 608, 618, 0, 101, 0, 0,
-	198, 0, 431, 436, 0, 224, 0, 226, 0, 141,
-	600, 0, 0, 312, 991, 314, 316, 317, 318, 319,
-	1016, 0, 1016, 0, 345, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 444, 450, 0, 137, 80, 80,
-	980, 80, 985, 133, 135, 0, 533, 0, 0, 530,
-	536, 537, 0, 520, 391, 392, 0, 0, 305, 186,
-	285, 287, 299, 300, 586, 0, 0, 0, 0, 405,
-	61, 1209, 0, 82, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 53, 0, 0, 54, 0, 0, 0,
-	0, 0, 0, 506, 993, 541, 972, 586, 548, 973,
-	541, 969, 574, 0, 576, 0, 0, 0, 578, 0,
-	567, 0, 569, 584, 560, 0, 0, 553, 0, 552,
-	572, 580, 0, 0, 598, 101, 0, 0, 103, 0,
-	978, 115, 0, 604, 451, 466, 0, 0, 458, 455,
-	453, 605, 0, 0, 0, 0, 908, 170, 0, -2,
-	-2, 0, 0, 0, 764, -2, 745, 747, 750, 752,
-	754, 0, 0, 0, 0, 0, 0, 0, 765, 0,
-	0, 952, 955, -2, 908, 911, 912, 0, 0, 946,
-	0, 0, 0, 0, 515, 516, 516, 516, 816, 0,
-	0, 0, 0, 0, 0, 817, 0, 0, 0, 0,
-	0, 0, 834, 0, 835, 836, 0, 0, 837, 0,
+	936, 942, 0, 0, 0, 0, 0, 0, 0, 0,
+	654, 672, 683, 680, 679, 656, 0, 655, 653, 0,
+	657, 0, 0, 0, 509, 0, 915, 617, 618, 619,
+	620, 621, 704, 624, 625, 626, 627, 628, 629, 630,
+	631, 632, 633, 634, 642, 643, 644, 645, 646, 647,
+	648, 649, 650, 651, 652, 666, 0, 641, 641, 641,
+	658, 659, 667, 668, 673, 674, 685, 686, 688, 690,
+	684, 684, 684, 681, 682, 590, 91, 0, 199, 0,
+	0, 0, 205, 0, 0, 437, 229, 230, 231, 439,
+	440, 211, 236, 237, 238, 239, 240, 241, 0, 206,
+	208, 219, 207, 209, 212, 0, 142, 377, 0, 78,
+	79, 315, 0, 0, 378, 0, 506, 0, 0, 0,
+	1215, 1021, 444, 0, 488, 128, 0, 0, 130, 0,
+	132, 0, 0, 0, 0, 0, 527, 528, 531, 535,
+	536, 539, 542, 543, 533, 707, 496, 497, 498, 521,
+	522, 395, 0, 0, 271, 272, 273, 274, 276, 0,
+	305, 277, 278, 279, 280, 281, 286, 288, 509, 293,
+	295, 298, 299, 0, 0, 0, 425, 49, 408, 59,
+	0, 408, 408, 0, 0, 0, 0, 55, 0, 0,
+	57, 0, 0, 0, 0, 0, 504, 0, 0, 609,
+	0, 0, 0, 980, 609, 609, 0, 0, 976, 0,
+	0, 0, 0, 0, 591, 595, 592, 593, 0, 0,
+	576, 588, 559, 0, 0, 0, 566, 587, 0, 586,
+	575, -2, 0, 94, 0, 998, 0, 0, 0, 609,
+	0, 468, 458, 0, 0, 0, 468, 471, 472, 474,
+	475, 0, 0, 607, 166, 0, 145, 160, 0, 708,
+	613, 614, 660, 661, 662, 663, 664, 709, 710, 0,
+	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
+	-2, -2, -2, 728, 729, 730, -2, -2, -2, 734,
+	735, -2, 910, 912, 0, 971, 0, -2, 0, -2,
+	-2, -2, -2, 748, 0, 750, 753, 755, 757, 0,
+	0, 0, 0, 970, 767, 948, 0, 0, 889, 890,
+	891, 752, 0, 0, 969, 960, 0, 0, -2, 807,
+	0, 0, 0, 919, 920, 955, 951, 0, 814, 520,
+	0, 0, 0, 0, 0, 1017, 691, 692, 693, 694,
+	695, 696, 705, 867, 0, 0, 0, 827, 829, 831,
+	833, 0, 0, 0, 0, 923, -2, -2, -2, -2,
+	-2, -2, -2, 0, 0, 913, 933, 0, 0, 770,
+	0, 0, 0, 0, 1233, 0, 913, 941, 0, 0,
+	0, 0, 0, 913, 0, 947, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 163,
+	0, 610, 612, 622, 0, 101, 0, 0, 200, 0,
+	433, 438, 0, 226, 0, 228, 0, 143, 604, 0,
+	0, 314, 997, 316, 318, 319, 320, 321, 1022, 0,
+	1022, 0, 347, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 446, 452, 0, 139, 80, 80, 986, 80,
+	991, 135, 137, 0, 537, 0, 0, 534, 540, 541,
+	0, 524, 393, 394, 0, 0, 307, 188, 287, 289,
+	301, 302, 590, 0, 0, 0, 0, 407, 61, 1215,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 53, 0, 0, 54, 0, 0, 0, 0, 0,
+	0, 509, 999, 545, 0, 978, 590, 552, 979, 545,
+	545, 0, 975, 578, 0, 580, 0, 0, 0, 582,
+	0, 571, 0, 573, 588, 564, 0, 0, 557, 0,
+	556, 576, 584, 0, 0, 602, 101, 0, 0, 103,
+	0, 984, 468, 116, 453, 468, 0, 0, 0, 460,
+	457, 455, 609, 0, 0, 0, 0, 914, 172, 0,
+	-2, -2, 0, 0, 0, 768, -2, 749, 751, 754,
+	756, 758, 0, 0, 0, 0, 0, 0, 0, 769,
+	0, 0, 958, 961, -2, 914, 917, 918, 0, 0,
+	952, 0, 0, 0, 0, 519, 520, 520, 520, 820,
+	0, 0, 0, 0, 0, 0, 821, 0, 0, 0,
+	0, 0, 0, 840, 0, 841, 842, 0, 0, 843,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 769, 770, 771, 0, 0, 838, 933, 934,
-	0, 0, 0, 0, 0, 0, 843, 0, 940, 0,
-	0, 0, 847, 848, 849, 0, 635, 0, 661, 683,
-	685, 673, 910, 0, 0, 0, 88, 0, 92, 171,
-	201, 222, 223, 225, 218, 321, 354, 0, 327, 0,
-	1016, 0, 0, 0, 0, 0, 0, 310, 355, 377,
-	379, 0, 0, 446, 450, 0, 449, 504, 127, 129,
-	131, 0, 0, 519, 393, 0, 0, 301, 60, 395,
-	0, 0, 0, 63, 1209, 71, 0, 408, 72, 1209,
-	80, 1209, 80, 73, 50, 406, 51, 0, 52, 0,
-	403, 0, 394, 401, 0, 0, 543, 0, 546, 0,
-	543, 575, 0, 594, 577, 558, 568, 0, 565, 556,
-	0, 557, 0, 584, 0, 0, 564, 87, 0, 0,
-	104, 464, 465, 452, 463, 0, 466, 471, 468, 474,
-	0, 707, -2, 0, 0, 0, 0, -2, 0, 0,
-	0, 943, 0, 878, 0, 0, 954, -2, 915, 944,
-	948, 0, 811, 0, 0, 0, 0, 1004, 693, 694,
-	695, 696, 697, 698, 699, 0, 859, 860, 864, 0,
-	0, 821, 0, 0, 916, 926, 928, 767, 768, 772,
-	773, 774, 775, 776, 777, 778, 779, 780, 781, -2,
-	-2, -2, 785, 786, 787, -2, -2, -2, 0, 0,
-	0, 929, 0, 0, 881, 931, 932, 937, 938, 0,
-	840, 841, 842, 939, 0, 0, 0, 667, 0, 611,
-	0, 0, 619, 0, 308, 0, 315, 325, 0, 0,
-	0, 384, 390, 390, 0, 819, 820, 0, 0, 344,
-	0, 0, 0, 313, 0, 0, 0, 448, 450, 0,
-	441, 442, 528, 534, 521, 393, 187, 0, 0, 0,
-	0, 81, 65, 0, 0, 0, 0, 69, 0, 75,
-	0, 0, 0, 0, 0, 0, 0, 502, 855, 0,
-	0, 549, 855, 0, 572, 570, 554, 550, 0, 561,
-	0, 581, 93, 0, 0, 0, 0, 457, 467, 0,
-	0, 0, -2, 0, 757, -2, -2, 877, 908, 953,
-	947, 1001, 516, 812, 814, 815, 702, 866, 863, 0,
-	850, 832, 833, 925, 0, 0, 0, 0, 908, 880,
-	839, 0, 845, 846, 636, 612, 607, 0, 0, 326,
-	0, 329, 0, 0, 0, 0, 335, 336, 0, 0,
-	0, 0, 0, 0, 353, 0, 393, 996, 0, 393,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 0,
-	439, 450, 0, 443, 444, 522, 397, 399, 62, 0,
-	0, 77, 0, 0, 407, 0, 80, 80, 396, 0,
-	0, 0, 0, 404, 402, 489, 0, 542, 540, 490,
-	593, 584, 0, 579, 585, 0, 0, 0, 0, 0,
-	475, 476, 0, -2, -2, 758, 879, 0, 516, 0,
-	852, -2, 0, 0, 0, 882, 0, 0, 98, 96,
-	0, 0, 330, 331, 1016, 0, 334, 337, 0, 339,
-	1018, 353, 0, 321, 0, 350, 351, 385, 386, 389,
-	387, 390, 516, 343, 348, 0, 321, 311, 353, 0,
-	0, 445, 446, 64, 66, 67, 76, 84, 68, 74,
-	0, 0, 0, 0, 854, 856, 0, 559, 551, 0,
-	0, 460, 605, 462, 813, 869, 0, -2, 0, 793,
-	844, 0, 100, 97, 0, 0, 328, 332, 0, 0,
-	359, 0, 321, 353, 347, 0, 393, 0, 0, 309,
-	321, 0, 447, 448, 86, 0, 398, 400, 0, 0,
-	0, 0, 516, 0, 461, 0, 0, 0, 865, 794,
-	99, 95, 0, 0, 338, 364, 0, 0, 341, 321,
-	0, 388, 811, 355, 380, 0, 440, 70, 0, 83,
-	0, 0, 857, 858, 0, 605, 862, 867, 870, -2,
-	1169, 1050, 0, 868, 0, 0, 340, 360, 361, 0,
-	356, 357, 358, 1017, 342, 352, 359, 353, 85, 56,
-	58, 0, 459, 0, 872, 873, 874, 875, 876, 324,
-	333, 362, 0, 363, 0, 0, 0, 364, 321, 0,
-	320, 322, 323, 365, 0, 368, 369, 0, 366, 349,
-	381, 871, 367, 370, 371,
+	0, 0, 0, 773, 774, 775, 0, 0, 844, 939,
+	940, 0, 0, 0, 0, 0, 0, 849, 0, 946,
+	0, 0, 0, 853, 854, 855, 0, 639, 0, 665,
+	687, 689, 677, 916, 0, 0, 0, 88, 0, 92,
+	173, 203, 224, 225, 227, 220, 323, 356, 0, 329,
+	0, 1022, 0, 0, 0, 0, 0, 0, 312, 357,
+	379, 381, 0, 0, 448, 452, 0, 451, 507, 129,
+	131, 133, 0, 0, 523, 395, 0, 0, 303, 60,
+	397, 0, 0, 0, 63, 1215, 71, 0, 410, 72,
+	1215, 80, 1215, 80, 73, 50, 408, 51, 0, 52,
+	0, 405, 0, 396, 403, 0, 0, 547, 0, 608,
+	550, 0, 547, 547, 516, 579, 0, 598, 581, 562,
+	572, 0, 569, 560, 0, 561, 0, 588, 0, 0,
+	568, 87, 0, 0, 104, 115, 454, 466, 467, 465,
+	0, 468, 473, 470, 476, 0, 711, -2, 0, 0,
+	0, 0, -2, 0, 0, 0, 949, 0, 884, 0,
+	0, 960, -2, 921, 950, 954, 0, 815, 0, 0,
+	0, 0, 1010, 697, 698, 699, 700, 701, 702, 703,
+	0, 865, 866, 870, 0, 0, 825, 0, 0, 922,
+	932, 934, 771, 772, 776, 777, 778, 779, 780, 781,
+	782, 783, 784, 785, -2, -2, -2, 789, 790, 791,
+	-2, -2, -2, 0, 0, 0, 935, 0, 0, 887,
+	937, 938, 943, 944, 0, 846, 847, 848, 945, 0,
+	0, 0, 671, 0, 615, 0, 0, 623, 0, 310,
+	0, 317, 327, 0, 0, 0, 386, 392, 392, 0,
+	823, 824, 0, 0, 346, 0, 0, 0, 315, 0,
+	0, 0, 450, 452, 0, 443, 444, 532, 538, 525,
+	395, 189, 0, 0, 0, 0, 81, 65, 0, 0,
+	0, 0, 69, 0, 75, 0, 0, 0, 0, 0,
+	0, 0, 505, 861, 0, 0, 553, 861, 861, 0,
+	576, 574, 558, 554, 0, 565, 0, 585, 93, 0,
+	0, 0, 0, 459, 469, 0, 0, 0, -2, 0,
+	761, -2, -2, 883, 914, 959, 953, 1007, 520, 816,
+	818, 819, 706, 872, 869, 0, 856, 838, 839, 931,
+	0, 0, 0, 0, 914, 886, 845, 0, 851, 852,
+	640, 616, 611, 0, 0, 328, 0, 331, 0, 0,
+	0, 0, 337, 338, 0, 0, 0, 0, 0, 0,
+	355, 0, 395, 1002, 0, 395, 0, 0, 0, 348,
+	0, 0, 0, 0, 0, 0, 441, 452, 0, 445,
+	446, 526, 399, 401, 62, 0, 0, 77, 0, 0,
+	409, 0, 80, 80, 398, 0, 0, 0, 0, 406,
+	404, 491, 0, 546, 544, 492, 493, 597, 588, 0,
+	583, 589, 0, 0, 0, 0, 0, 477, 478, 0,
+	-2, -2, 762, 885, 0, 520, 0, 858, -2, 0,
+	0, 0, 888, 0, 0, 98, 96, 0, 0, 332,
+	333, 1022, 0, 336, 339, 0, 341, 1024, 355, 0,
+	323, 0, 352, 353, 387, 388, 391, 389, 392, 520,
+	345, 350, 0, 323, 313, 355, 0, 0, 447, 448,
+	64, 66, 67, 76, 84, 68, 74, 0, 0, 0,
+	0, 860, 862, 0, 563, 555, 0, 0, 462, 609,
+	464, 817, 875, 0, -2, 0, 797, 850, 0, 100,
+	97, 0, 0, 330, 334, 0, 0, 361, 0, 323,
+	355, 349, 0, 395, 0, 0, 311, 323, 0, 449,
+	450, 86, 0, 400, 402, 0, 0, 0, 0, 520,
+	0, 463, 0, 0, 0, 871, 798, 99, 95, 0,
+	0, 340, 366, 0, 0, 343, 323, 0, 390, 815,
+	357, 382, 0, 442, 70, 0, 83, 0, 0, 863,
+	864, 0, 609, 868, 873, 876, -2, 1175, 1056, 0,
+	874, 0, 0, 342, 362, 363, 0, 358, 359, 360,
+	1023, 344, 354, 361, 355, 85, 56, 58, 0, 461,
+	0, 878, 879, 880, 881, 882, 326, 335, 364, 0,
+	365, 0, 0, 0, 366, 323, 0, 322, 324, 325,
+	367, 0, 370, 371, 0, 368, 351, 383, 877, 369,
+	372, 373,
 }
-var sqlTok1 = [...]int{
 
+var sqlTok1 = [...]int16{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5711,8 +5784,8 @@ var sqlTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 357, 339, 358, 337,
 }
-var sqlTok2 = [...]int{
 
+var sqlTok2 = [...]int16{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -5740,7 +5813,8 @@ var sqlTok2 = [...]int{
 	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
 	252, 253, 254, 255, 256, 257,
 }
-var sqlTok3 = [...]int{
+
+var sqlTok3 = [...]uint16{
 	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
 	57605, 263, 57606, 264, 57607, 265, 57608, 266, 57609, 267,
 	57610, 268, 57611, 269, 57612, 270, 57613, 271, 57614, 272,
@@ -5798,7 +5872,7 @@ func sqlNewParser() sqlParser {
 	return &sqlParserImpl{}
 }
 
-const sqlFlag = -1000
+const sqlFlag = -32768
 
 func sqlTokname(c int) string {
 	if c >= 1 && c-1 < len(sqlToknames) {
@@ -5837,9 +5911,9 @@ func sqlErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := sqlPact[state]
+	base := int(sqlPact[state])
 	for tok := TOKSTART; tok-1 < len(sqlToknames); tok++ {
-		if n := base + tok; n >= 0 && n < sqlLast && sqlChk[sqlAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < sqlLast && int(sqlChk[int(sqlAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -5849,13 +5923,13 @@ func sqlErrorMessage(state, lookAhead int) string {
 
 	if sqlDef[state] == -2 {
 		i := 0
-		for sqlExca[i] != -1 || sqlExca[i+1] != state {
+		for sqlExca[i] != -1 || int(sqlExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; sqlExca[i] >= 0; i += 2 {
-			tok := sqlExca[i]
+			tok := int(sqlExca[i])
 			if tok < TOKSTART || sqlExca[i+1] == 0 {
 				continue
 			}
@@ -5886,30 +5960,30 @@ func sqllex1(lex sqlLexer, lval *sqlSymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = sqlTok1[0]
+		token = int(sqlTok1[0])
 		goto out
 	}
 	if char < len(sqlTok1) {
-		token = sqlTok1[char]
+		token = int(sqlTok1[char])
 		goto out
 	}
 	if char >= sqlPrivate {
 		if char < sqlPrivate+len(sqlTok2) {
-			token = sqlTok2[char-sqlPrivate]
+			token = int(sqlTok2[char-sqlPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(sqlTok3); i += 2 {
-		token = sqlTok3[i+0]
+		token = int(sqlTok3[i+0])
 		if token == char {
-			token = sqlTok3[i+1]
+			token = int(sqlTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = sqlTok2[1] /* unknown char */
+		token = int(sqlTok2[1]) /* unknown char */
 	}
 	if sqlDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", sqlTokname(token), uint(char))
@@ -5964,7 +6038,7 @@ sqlstack:
 	sqlS[sqlp].yys = sqlstate
 
 sqlnewstate:
-	sqln = sqlPact[sqlstate]
+	sqln = int(sqlPact[sqlstate])
 	if sqln <= sqlFlag {
 		goto sqldefault /* simple state */
 	}
@@ -5975,8 +6049,8 @@ sqlnewstate:
 	if sqln < 0 || sqln >= sqlLast {
 		goto sqldefault
 	}
-	sqln = sqlAct[sqln]
-	if sqlChk[sqln] == sqltoken { /* valid shift */
+	sqln = int(sqlAct[sqln])
+	if int(sqlChk[sqln]) == sqltoken { /* valid shift */
 		sqlrcvr.char = -1
 		sqltoken = -1
 		sqlVAL = sqlrcvr.lval
@@ -5989,7 +6063,7 @@ sqlnewstate:
 
 sqldefault:
 	/* default state action */
-	sqln = sqlDef[sqlstate]
+	sqln = int(sqlDef[sqlstate])
 	if sqln == -2 {
 		if sqlrcvr.char < 0 {
 			sqlrcvr.char, sqltoken = sqllex1(sqllex, &sqlrcvr.lval)
@@ -5998,18 +6072,18 @@ sqldefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if sqlExca[xi+0] == -1 && sqlExca[xi+1] == sqlstate {
+			if sqlExca[xi+0] == -1 && int(sqlExca[xi+1]) == sqlstate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			sqln = sqlExca[xi+0]
+			sqln = int(sqlExca[xi+0])
 			if sqln < 0 || sqln == sqltoken {
 				break
 			}
 		}
-		sqln = sqlExca[xi+1]
+		sqln = int(sqlExca[xi+1])
 		if sqln < 0 {
 			goto ret0
 		}
@@ -6031,10 +6105,10 @@ sqldefault:
 
 			/* find a state where "error" is a legal shift action */
 			for sqlp >= 0 {
-				sqln = sqlPact[sqlS[sqlp].yys] + sqlErrCode
+				sqln = int(sqlPact[sqlS[sqlp].yys]) + sqlErrCode
 				if sqln >= 0 && sqln < sqlLast {
-					sqlstate = sqlAct[sqln] /* simulate a shift of "error" */
-					if sqlChk[sqlstate] == sqlErrCode {
+					sqlstate = int(sqlAct[sqln]) /* simulate a shift of "error" */
+					if int(sqlChk[sqlstate]) == sqlErrCode {
 						goto sqlstack
 					}
 				}
@@ -6070,7 +6144,7 @@ sqldefault:
 	sqlpt := sqlp
 	_ = sqlpt // guard against "declared and not used"
 
-	sqlp -= sqlR2[sqln]
+	sqlp -= int(sqlR2[sqln])
 	// sqlp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if sqlp+1 >= len(sqlS) {
@@ -6081,16 +6155,16 @@ sqldefault:
 	sqlVAL = sqlS[sqlp+1]
 
 	/* consult goto table to find next state */
-	sqln = sqlR1[sqln]
-	sqlg := sqlPgo[sqln]
+	sqln = int(sqlR1[sqln])
+	sqlg := int(sqlPgo[sqln])
 	sqlj := sqlg + sqlS[sqlp].yys + 1
 
 	if sqlj >= sqlLast {
-		sqlstate = sqlAct[sqlg]
+		sqlstate = int(sqlAct[sqlg])
 	} else {
-		sqlstate = sqlAct[sqlj]
-		if sqlChk[sqlstate] != -sqln {
-			sqlstate = sqlAct[sqlg]
+		sqlstate = int(sqlAct[sqlj])
+		if int(sqlChk[sqlstate]) != -sqln {
+			sqlstate = int(sqlAct[sqlg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -6098,13 +6172,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:861
+//line sql.y:886
 		{
 			sqllex.(*Scanner).stmts = sqlDollar[1].union.stmts()
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:867
+//line sql.y:892
 		{
 			if sqlDollar[3].union.stmt() != nil {
 				sqlVAL.union.val = append(sqlDollar[1].union.stmts(), sqlDollar[3].union.stmt())
@@ -6112,7 +6186,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:873
+//line sql.y:898
 		{
 			if sqlDollar[1].union.stmt() != nil {
 				sqlVAL.union.val = []Statement{sqlDollar[1].union.stmt()}
@@ -6122,141 +6196,141 @@ sqldefault:
 		}
 	case 24:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:903
+//line sql.y:928
 		{
 			sqlVAL.union.val = sqlDollar[1].union.slct()
 		}
 	case 33:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:915
+//line sql.y:940
 		{
 			sqlVAL.union.val = Statement(nil)
 		}
 	case 49:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:946
+//line sql.y:971
 		{
 			sqlVAL.union.val = &AlterTable{Table: sqlDollar[3].union.normalizableTableName(), IfExists: false, Cmds: sqlDollar[4].union.alterTableCmds()}
 		}
 	case 50:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:950
+//line sql.y:975
 		{
 			sqlVAL.union.val = &AlterTable{Table: sqlDollar[5].union.normalizableTableName(), IfExists: true, Cmds: sqlDollar[6].union.alterTableCmds()}
 		}
 	case 51:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:956
+//line sql.y:981
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Split{Table: sqlDollar[3].union.newNormalizableTableName(), Rows: sqlDollar[6].union.slct()}
 		}
 	case 52:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:962
+//line sql.y:988
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Split{Index: sqlDollar[3].union.tableWithIdx(), Rows: sqlDollar[6].union.slct()}
 		}
 	case 53:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:968
+//line sql.y:995
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &TestingRelocate{Table: sqlDollar[3].union.newNormalizableTableName(), Rows: sqlDollar[5].union.slct()}
 		}
 	case 54:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:974
+//line sql.y:1002
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &TestingRelocate{Index: sqlDollar[3].union.tableWithIdx(), Rows: sqlDollar[5].union.slct()}
 		}
 	case 55:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:980
+//line sql.y:1009
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Scatter{Table: sqlDollar[3].union.newNormalizableTableName()}
 		}
 	case 56:
 		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
-		//line sql.y:984
+//line sql.y:1014
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Scatter{Table: sqlDollar[3].union.newNormalizableTableName(), From: sqlDollar[7].union.exprs(), To: sqlDollar[11].union.exprs()}
 		}
 	case 57:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:990
+//line sql.y:1021
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Scatter{Index: sqlDollar[3].union.tableWithIdx()}
 		}
 	case 58:
 		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
-		//line sql.y:994
+//line sql.y:1026
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Scatter{Index: sqlDollar[3].union.tableWithIdx(), From: sqlDollar[7].union.exprs(), To: sqlDollar[11].union.exprs()}
 		}
 	case 59:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1000
+//line sql.y:1033
 		{
 			sqlVAL.union.val = AlterTableCmds{sqlDollar[1].union.alterTableCmd()}
 		}
 	case 60:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1004
+//line sql.y:1037
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.alterTableCmds(), sqlDollar[3].union.alterTableCmd())
 		}
 	case 61:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1011
+//line sql.y:1044
 		{
 			sqlVAL.union.val = &AlterTableAddColumn{columnKeyword: false, IfNotExists: false, ColumnDef: sqlDollar[2].union.colDef()}
 		}
 	case 62:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1016
+//line sql.y:1049
 		{
 			sqlVAL.union.val = &AlterTableAddColumn{columnKeyword: false, IfNotExists: true, ColumnDef: sqlDollar[5].union.colDef()}
 		}
 	case 63:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1021
+//line sql.y:1054
 		{
 			sqlVAL.union.val = &AlterTableAddColumn{columnKeyword: true, IfNotExists: false, ColumnDef: sqlDollar[3].union.colDef()}
 		}
 	case 64:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1026
+//line sql.y:1059
 		{
 			sqlVAL.union.val = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: sqlDollar[6].union.colDef()}
 		}
 	case 65:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1031
+//line sql.y:1064
 		{
 			sqlVAL.union.val = &AlterTableSetDefault{columnKeyword: sqlDollar[2].union.bool(), Column: Name(sqlDollar[3].str), Default: sqlDollar[4].union.expr()}
 		}
 	case 66:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1036
+//line sql.y:1069
 		{
 			sqlVAL.union.val = &AlterTableDropNotNull{columnKeyword: sqlDollar[2].union.bool(), Column: Name(sqlDollar[3].str)}
 		}
 	case 67:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1040
+//line sql.y:1073
 		{
 			return unimplemented(sqllex, "alter set non null")
 		}
 	case 68:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1043
+//line sql.y:1076
 		{
 			sqlVAL.union.val = &AlterTableDropColumn{
 				columnKeyword: sqlDollar[2].union.bool(),
@@ -6267,7 +6341,7 @@ sqldefault:
 		}
 	case 69:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1053
+//line sql.y:1086
 		{
 			sqlVAL.union.val = &AlterTableDropColumn{
 				columnKeyword: sqlDollar[2].union.bool(),
@@ -6278,13 +6352,13 @@ sqldefault:
 		}
 	case 70:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1063
+//line sql.y:1096
 		{
 			return unimplemented(sqllex, "alter set type")
 		}
 	case 71:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1066
+//line sql.y:1099
 		{
 			sqlVAL.union.val = &AlterTableAddConstraint{
 				ConstraintDef:      sqlDollar[2].union.constraintDef(),
@@ -6293,13 +6367,13 @@ sqldefault:
 		}
 	case 72:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1073
+//line sql.y:1106
 		{
 			return unimplemented(sqllex, "alter constraint")
 		}
 	case 73:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1076
+//line sql.y:1109
 		{
 			sqlVAL.union.val = &AlterTableValidateConstraint{
 				Constraint: Name(sqlDollar[3].str),
@@ -6307,7 +6381,7 @@ sqldefault:
 		}
 	case 74:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1083
+//line sql.y:1116
 		{
 			sqlVAL.union.val = &AlterTableDropConstraint{
 				IfExists:     true,
@@ -6317,7 +6391,7 @@ sqldefault:
 		}
 	case 75:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1092
+//line sql.y:1125
 		{
 			sqlVAL.union.val = &AlterTableDropConstraint{
 				IfExists:     false,
@@ -6327,252 +6401,264 @@ sqldefault:
 		}
 	case 76:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1102
+//line sql.y:1135
 		{
 			sqlVAL.union.val = sqlDollar[3].union.expr()
 		}
 	case 77:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1106
+//line sql.y:1139
 		{
 			sqlVAL.union.val = nil
 		}
 	case 78:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1112
+//line sql.y:1145
 		{
 			sqlVAL.union.val = DropCascade
 		}
 	case 79:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1116
+//line sql.y:1149
 		{
 			sqlVAL.union.val = DropRestrict
 		}
 	case 80:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1120
+//line sql.y:1153
 		{
 			sqlVAL.union.val = DropDefault
 		}
 	case 81:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1126
+//line sql.y:1159
 		{
 			sqlVAL.union.val = ValidationSkip
 		}
 	case 82:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1130
+//line sql.y:1163
 		{
 			sqlVAL.union.val = ValidationDefault
 		}
 	case 83:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1135
+//line sql.y:1168
 		{
 			return unimplementedWithIssue(sqllex, 9851)
 		}
 	case 84:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1136
+//line sql.y:1169
 		{
 		}
 	case 85:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1139
+//line sql.y:1172
 		{
 			return unimplemented(sqllex, "alter using")
 		}
 	case 86:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1140
+//line sql.y:1173
 		{
 		}
 	case 87:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1144
+//line sql.y:1177
 		{
 			sqlVAL.union.val = &Backup{Targets: sqlDollar[2].union.targetList(), To: sqlDollar[4].union.expr(), IncrementalFrom: sqlDollar[6].union.exprs(), AsOf: sqlDollar[5].union.asOfClause(), Options: sqlDollar[7].union.kvOptions()}
 		}
 	case 88:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1150
+//line sql.y:1183
 		{
 			sqlVAL.union.val = &Restore{Targets: sqlDollar[2].union.targetList(), From: sqlDollar[4].union.exprs(), AsOf: sqlDollar[5].union.asOfClause(), Options: sqlDollar[6].union.kvOptions()}
 		}
 	case 89:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1156
+//line sql.y:1189
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
 	case 90:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1160
+//line sql.y:1193
 		{
 			sqlVAL.union.val = NewPlaceholder(sqlDollar[1].str)
 		}
 	case 91:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1166
+//line sql.y:1199
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr()}
 		}
 	case 92:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1170
+//line sql.y:1203
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.exprs(), sqlDollar[3].union.expr())
 		}
 	case 93:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1176
+//line sql.y:1209
 		{
 			sqlVAL.union.val = sqlDollar[3].union.exprs()
 		}
 	case 94:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1180
+//line sql.y:1213
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
 	case 95:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1186
+//line sql.y:1219
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
 	case 96:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1190
+//line sql.y:1223
 		{
 			sqlVAL.str = ""
 		}
 	case 97:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1196
+//line sql.y:1229
 		{
 			sqlVAL.union.val = KVOption{Key: sqlDollar[1].str, Value: sqlDollar[2].str}
 		}
 	case 98:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1202
+//line sql.y:1235
 		{
 			sqlVAL.union.val = []KVOption{sqlDollar[1].union.kvOption()}
 		}
 	case 99:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1206
+//line sql.y:1239
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.kvOptions(), sqlDollar[3].union.kvOption())
 		}
 	case 100:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1212
+//line sql.y:1245
 		{
 			sqlVAL.union.val = sqlDollar[4].union.kvOptions()
 		}
 	case 101:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1215
+//line sql.y:1248
 		{
 		}
 	case 102:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1219
+//line sql.y:1252
 		{
 			sqlVAL.union.val = &CopyFrom{Table: sqlDollar[2].union.normalizableTableName(), Stdin: true}
 		}
 	case 103:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1223
+//line sql.y:1256
 		{
 			sqlVAL.union.val = &CopyFrom{Table: sqlDollar[2].union.normalizableTableName(), Stdin: true}
 		}
 	case 104:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1227
+//line sql.y:1260
 		{
 			sqlVAL.union.val = &CopyFrom{Table: sqlDollar[2].union.normalizableTableName(), Columns: sqlDollar[4].union.unresolvedNames(), Stdin: true}
 		}
 	case 107:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1238
+//line sql.y:1271
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &CancelJob{ID: sqlDollar[3].union.expr()}
 		}
 	case 108:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1244
+//line sql.y:1278
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &CancelQuery{ID: sqlDollar[3].union.expr()}
 		}
 	case 115:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1260
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+//line sql.y:1295
 		{
-			sqlVAL.union.val = &Delete{Table: sqlDollar[4].union.tblExpr(), Where: newWhere(astWhere, sqlDollar[5].union.expr()), Returning: sqlDollar[6].union.retClause()}
+			sqlVAL.union.val = &Delete{Table: sqlDollar[4].union.tblExpr(), Using: sqlDollar[5].union.tblExprs(), Where: newWhere(astWhere, sqlDollar[6].union.expr()), Returning: sqlDollar[7].union.retClause()}
 		}
 	case 116:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1267
+//line sql.y:1301
 		{
-			sqlVAL.union.val = &Discard{Mode: DiscardModeAll}
+			sqlVAL.union.val = sqlDollar[2].union.tblExprs()
 		}
 	case 117:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+//line sql.y:1305
+		{
+			sqlVAL.union.val = TableExprs(nil)
+		}
+	case 118:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+//line sql.y:1312
+		{
+			sqlVAL.union.val = &Discard{Mode: DiscardModeAll}
+		}
+	case 119:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1270
+//line sql.y:1315
 		{
 			return unimplemented(sqllex, "discard plans")
 		}
-	case 118:
+	case 120:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1271
+//line sql.y:1316
 		{
 			return unimplemented(sqllex, "discard sequences")
 		}
-	case 119:
+	case 121:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1272
+//line sql.y:1317
 		{
 			return unimplemented(sqllex, "discard temp")
 		}
-	case 120:
+	case 122:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1273
+//line sql.y:1318
 		{
 			return unimplemented(sqllex, "discard temporary")
 		}
-	case 126:
+	case 128:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1285
+//line sql.y:1330
 		{
 			sqlVAL.union.val = &DropView{Names: sqlDollar[3].union.tableNameReferences(), IfExists: false, DropBehavior: sqlDollar[4].union.dropBehavior()}
 		}
-	case 127:
+	case 129:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1289
+//line sql.y:1334
 		{
 			sqlVAL.union.val = &DropView{Names: sqlDollar[5].union.tableNameReferences(), IfExists: true, DropBehavior: sqlDollar[6].union.dropBehavior()}
 		}
-	case 128:
+	case 130:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1295
+//line sql.y:1340
 		{
 			sqlVAL.union.val = &DropTable{Names: sqlDollar[3].union.tableNameReferences(), IfExists: false, DropBehavior: sqlDollar[4].union.dropBehavior()}
 		}
-	case 129:
+	case 131:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1299
+//line sql.y:1344
 		{
 			sqlVAL.union.val = &DropTable{Names: sqlDollar[5].union.tableNameReferences(), IfExists: true, DropBehavior: sqlDollar[6].union.dropBehavior()}
 		}
-	case 130:
+	case 132:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1305
+//line sql.y:1350
 		{
 			sqlVAL.union.val = &DropIndex{
 				IndexList:    sqlDollar[3].union.tableWithIdxList(),
@@ -6580,9 +6666,9 @@ sqldefault:
 				DropBehavior: sqlDollar[4].union.dropBehavior(),
 			}
 		}
-	case 131:
+	case 133:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1313
+//line sql.y:1358
 		{
 			sqlVAL.union.val = &DropIndex{
 				IndexList:    sqlDollar[5].union.tableWithIdxList(),
@@ -6590,104 +6676,104 @@ sqldefault:
 				DropBehavior: sqlDollar[6].union.dropBehavior(),
 			}
 		}
-	case 132:
+	case 134:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1323
+//line sql.y:1368
 		{
 			sqlVAL.union.val = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 133:
+	case 135:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1327
+//line sql.y:1372
 		{
 			sqlVAL.union.val = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 134:
+	case 136:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1333
+//line sql.y:1378
 		{
 			sqlVAL.union.val = &DropUser{Names: sqlDollar[3].union.nameList(), IfExists: false}
 		}
-	case 135:
+	case 137:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1337
+//line sql.y:1382
 		{
 			sqlVAL.union.val = &DropUser{Names: sqlDollar[5].union.nameList(), IfExists: true}
 		}
-	case 136:
+	case 138:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1343
+//line sql.y:1388
 		{
 			sqlVAL.union.val = TableNameReferences{sqlDollar[1].union.unresolvedName()}
 		}
-	case 137:
+	case 139:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1347
+//line sql.y:1392
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tableNameReferences(), sqlDollar[3].union.unresolvedName())
 		}
-	case 138:
+	case 140:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1353
+//line sql.y:1398
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str)}
 		}
-	case 139:
+	case 141:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1357
+//line sql.y:1402
 		{
 			sqlVAL.union.val = append(UnresolvedName{Name(sqlDollar[1].str)}, sqlDollar[2].union.unresolvedName()...)
 		}
-	case 140:
+	case 142:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1363
+//line sql.y:1408
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[2].str)}
 		}
-	case 141:
+	case 143:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1367
+//line sql.y:1412
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.unresolvedName(), Name(sqlDollar[3].str))
 		}
-	case 142:
+	case 144:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1374
+//line sql.y:1419
 		{
 			sqlVAL.union.val = &Explain{Statement: sqlDollar[2].union.stmt()}
 		}
-	case 143:
+	case 145:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1378
+//line sql.y:1423
 		{
 			sqlVAL.union.val = &Explain{Options: sqlDollar[3].union.strs(), Statement: sqlDollar[5].union.stmt()}
 		}
-	case 144:
+	case 146:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1384
+//line sql.y:1429
 		{
 			sqlVAL.union.val = sqlDollar[1].union.slct()
 		}
-	case 154:
+	case 156:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1398
+//line sql.y:1443
 		{ /* SKIP DOC */
 		}
-	case 157:
+	case 159:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1404
+//line sql.y:1449
 		{
 			sqlVAL.union.val = []string{sqlDollar[1].str}
 		}
-	case 158:
+	case 160:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1408
+//line sql.y:1453
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.strs(), sqlDollar[3].str)
 		}
-	case 160:
+	case 162:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1418
+//line sql.y:1463
 		{
 			sqlVAL.union.val = &Prepare{
 				Name:      Name(sqlDollar[2].str),
@@ -6695,762 +6781,762 @@ sqldefault:
 				Statement: sqlDollar[5].union.stmt(),
 			}
 		}
-	case 161:
+	case 163:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1428
+//line sql.y:1473
 		{
 			sqlVAL.union.val = sqlDollar[2].union.colTypes()
 		}
-	case 162:
+	case 164:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1432
+//line sql.y:1477
 		{
 			sqlVAL.union.val = []ColumnType(nil)
 		}
-	case 163:
+	case 165:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1439
+//line sql.y:1484
 		{
 			sqlVAL.union.val = &Execute{
 				Name:   Name(sqlDollar[2].str),
 				Params: sqlDollar[3].union.exprs(),
 			}
 		}
-	case 164:
+	case 166:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1450
+//line sql.y:1495
 		{
 			sqlVAL.union.val = sqlDollar[2].union.exprs()
 		}
-	case 165:
+	case 167:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1454
+//line sql.y:1499
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
-	case 166:
+	case 168:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1461
+//line sql.y:1506
 		{
 			sqlVAL.union.val = &Deallocate{
 				Name: Name(sqlDollar[2].str),
 			}
 		}
-	case 167:
+	case 169:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1467
+//line sql.y:1512
 		{
 			sqlVAL.union.val = &Deallocate{
 				Name: Name(sqlDollar[3].str),
 			}
 		}
-	case 168:
+	case 170:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1473
+//line sql.y:1518
 		{
 			sqlVAL.union.val = &Deallocate{}
 		}
-	case 169:
+	case 171:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1477
+//line sql.y:1522
 		{
 			sqlVAL.union.val = &Deallocate{}
 		}
-	case 170:
+	case 172:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1484
+//line sql.y:1529
 		{
 			sqlVAL.union.val = &Grant{Privileges: sqlDollar[2].union.privilegeList(), Grantees: sqlDollar[6].union.nameList(), Targets: sqlDollar[4].union.targetList()}
 		}
-	case 171:
+	case 173:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1491
+//line sql.y:1536
 		{
 			sqlVAL.union.val = &Revoke{Privileges: sqlDollar[2].union.privilegeList(), Grantees: sqlDollar[6].union.nameList(), Targets: sqlDollar[4].union.targetList()}
 		}
-	case 172:
+	case 174:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1498
+//line sql.y:1543
 		{
 			sqlVAL.union.val = TargetList{Tables: sqlDollar[1].union.tablePatterns()}
 		}
-	case 173:
+	case 175:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1502
+//line sql.y:1547
 		{
 			sqlVAL.union.val = TargetList{Tables: sqlDollar[2].union.tablePatterns()}
 		}
-	case 174:
+	case 176:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1506
+//line sql.y:1551
 		{
 			sqlVAL.union.val = TargetList{Databases: sqlDollar[2].union.nameList()}
 		}
-	case 175:
+	case 177:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1513
+//line sql.y:1558
 		{
 			sqlVAL.union.val = privilege.List{privilege.ALL}
 		}
-	case 176:
+	case 178:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1516
+//line sql.y:1561
 		{
 		}
-	case 177:
+	case 179:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1520
+//line sql.y:1565
 		{
 			sqlVAL.union.val = privilege.List{sqlDollar[1].union.privilegeType()}
 		}
-	case 178:
+	case 180:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1524
+//line sql.y:1569
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.privilegeList(), sqlDollar[3].union.privilegeType())
 		}
-	case 179:
+	case 181:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1531
+//line sql.y:1576
 		{
 			sqlVAL.union.val = privilege.CREATE
 		}
-	case 180:
+	case 182:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1535
+//line sql.y:1580
 		{
 			sqlVAL.union.val = privilege.DROP
 		}
-	case 181:
+	case 183:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1539
+//line sql.y:1584
 		{
 			sqlVAL.union.val = privilege.GRANT
 		}
-	case 182:
+	case 184:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1543
+//line sql.y:1588
 		{
 			sqlVAL.union.val = privilege.SELECT
 		}
-	case 183:
+	case 185:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1547
+//line sql.y:1592
 		{
 			sqlVAL.union.val = privilege.INSERT
 		}
-	case 184:
+	case 186:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1551
+//line sql.y:1596
 		{
 			sqlVAL.union.val = privilege.DELETE
 		}
-	case 185:
+	case 187:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1555
+//line sql.y:1600
 		{
 			sqlVAL.union.val = privilege.UPDATE
 		}
-	case 186:
+	case 188:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1563
+//line sql.y:1608
 		{
 			sqlVAL.union.val = NameList{Name(sqlDollar[1].str)}
 		}
-	case 187:
+	case 189:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1567
+//line sql.y:1612
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.nameList(), Name(sqlDollar[3].str))
 		}
-	case 188:
+	case 190:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1574
+//line sql.y:1619
 		{
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name(sqlDollar[2].str)}, SetMode: SetModeReset}
 		}
-	case 189:
+	case 191:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1578
+//line sql.y:1623
 		{
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name(sqlDollar[3].str)}, SetMode: SetModeReset}
 		}
-	case 190:
+	case 192:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1585
+//line sql.y:1630
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name("database")}, Values: Exprs{sqlDollar[2].union.expr()}}
 		}
-	case 194:
+	case 196:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1596
+//line sql.y:1642
 		{ /* SKIP DOC */
 		}
-	case 195:
+	case 197:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1597
+//line sql.y:1643
 		{ /* SKIP DOC */
 		}
-	case 196:
+	case 198:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1598
+//line sql.y:1644
 		{
 			return unimplemented(sqllex, "set local")
 		}
-	case 197:
+	case 199:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1602
+//line sql.y:1648
 		{
 			sqlVAL.union.val = sqlDollar[4].union.stmt()
 			sqlVAL.union.val.(*Set).SetMode = SetModeClusterSetting
 		}
-	case 198:
+	case 200:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1611
+//line sql.y:1657
 		{
 			sqlVAL.union.val = &Set{Values: sqlDollar[4].union.exprs()}
 		}
-	case 199:
+	case 201:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1617
+//line sql.y:1663
 		{
 			sqlVAL.union.val = sqlDollar[3].union.stmt()
 		}
-	case 200:
+	case 202:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1621
+//line sql.y:1667
 		{
 			sqlVAL.union.val = sqlDollar[2].union.stmt()
 		}
-	case 201:
+	case 203:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1626
+//line sql.y:1672
 		{
 			sqlVAL.union.val = &SetDefaultIsolation{Isolation: sqlDollar[6].union.isoLevel()}
 		}
-	case 202:
+	case 204:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1632
+//line sql.y:1678
 		{
 			sqlVAL.union.val = &SetTransaction{Modes: sqlDollar[3].union.transactionModes()}
 		}
-	case 203:
+	case 205:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1636
+//line sql.y:1682
 		{
 			sqlVAL.union.val = &SetTransaction{Modes: sqlDollar[4].union.transactionModes()}
 		}
-	case 204:
+	case 206:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1642
+//line sql.y:1688
 		{
 			sqlVAL.union.val = &Set{Name: sqlDollar[1].union.unresolvedName(), Values: sqlDollar[3].union.exprs()}
 		}
-	case 205:
+	case 207:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1646
+//line sql.y:1692
 		{
 			sqlVAL.union.val = &Set{Name: sqlDollar[1].union.unresolvedName(), Values: sqlDollar[3].union.exprs()}
 		}
-	case 206:
+	case 208:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1650
+//line sql.y:1696
 		{
 			sqlVAL.union.val = &Set{Name: sqlDollar[1].union.unresolvedName()}
 		}
-	case 207:
+	case 209:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1654
+//line sql.y:1700
 		{
 			sqlVAL.union.val = &Set{Name: sqlDollar[1].union.unresolvedName()}
 		}
-	case 209:
+	case 211:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1663
+//line sql.y:1709
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name("time zone")}, Values: Exprs{sqlDollar[3].union.expr()}}
 		}
-	case 210:
+	case 212:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1666
+//line sql.y:1713
 		{
 			return unimplemented(sqllex, "set from current")
 		}
-	case 212:
+	case 214:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1673
+//line sql.y:1720
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name("client_encoding")}, Values: Exprs{sqlDollar[2].union.expr()}}
 		}
-	case 213:
+	case 215:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1677
+//line sql.y:1725
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Set{Name: UnresolvedName{Name("client_encoding")}, SetMode: SetModeReset}
 		}
-	case 214:
+	case 216:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1683
+//line sql.y:1732
 		{
 		}
-	case 215:
+	case 217:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1685
+//line sql.y:1734
 		{
 		}
-	case 217:
+	case 219:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1692
+//line sql.y:1741
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr()}
 		}
-	case 218:
+	case 220:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1696
+//line sql.y:1745
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.exprs(), sqlDollar[3].union.expr())
 		}
-	case 221:
+	case 223:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1704
+//line sql.y:1753
 		{
 			sqlVAL.union.val = NewPlaceholder(sqlDollar[1].str)
 		}
-	case 222:
+	case 224:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1710
+//line sql.y:1759
 		{
 			sqlVAL.union.val = SnapshotIsolation
 		}
-	case 223:
+	case 225:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1714
+//line sql.y:1763
 		{
 			sqlVAL.union.val = SnapshotIsolation
 		}
-	case 224:
+	case 226:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1718
+//line sql.y:1767
 		{
 			sqlVAL.union.val = SnapshotIsolation
 		}
-	case 225:
+	case 227:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1722
+//line sql.y:1771
 		{
 			sqlVAL.union.val = SerializableIsolation
 		}
-	case 226:
+	case 228:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1726
+//line sql.y:1775
 		{
 			sqlVAL.union.val = SerializableIsolation
 		}
-	case 227:
+	case 229:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1732
+//line sql.y:1781
 		{
 			sqlVAL.union.val = Low
 		}
-	case 228:
+	case 230:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1736
+//line sql.y:1785
 		{
 			sqlVAL.union.val = Normal
 		}
-	case 229:
+	case 231:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1740
+//line sql.y:1789
 		{
 			sqlVAL.union.val = High
 		}
-	case 230:
+	case 232:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1746
+//line sql.y:1795
 		{
 			sqlVAL.union.val = MakeDBool(true)
 		}
-	case 231:
+	case 233:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1750
+//line sql.y:1799
 		{
 			sqlVAL.union.val = MakeDBool(false)
 		}
-	case 232:
+	case 234:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1754
+//line sql.y:1803
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 233:
+	case 235:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1761
+//line sql.y:1810
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 234:
+	case 236:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1772
+//line sql.y:1821
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 235:
+	case 237:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1776
+//line sql.y:1825
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 236:
+	case 238:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1780
+//line sql.y:1829
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 238:
+	case 240:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1785
+//line sql.y:1834
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 239:
+	case 241:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1789
+//line sql.y:1838
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 260:
+	case 262:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1818
+//line sql.y:1867
 		{
 			sqlVAL.union.val = &Show{Name: sqlDollar[2].str}
 		}
-	case 261:
+	case 263:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1819
+//line sql.y:1868
 		{
 			sqlVAL.union.val = &Show{Name: sqlDollar[3].str}
 		}
-	case 265:
+	case 267:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1830
+//line sql.y:1879
 		{
 			sqlVAL.str = "client_encoding"
 		}
-	case 267:
+	case 269:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1833
+//line sql.y:1882
 		{
 			sqlVAL.str = "TIME ZONE"
 		}
-	case 268:
+	case 270:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1837
+//line sql.y:1886
 		{
 			sqlVAL.union.val = &ShowBackup{Path: sqlDollar[3].union.expr()}
 		}
-	case 269:
+	case 271:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1843
+//line sql.y:1892
 		{
 			sqlVAL.union.val = &Show{Name: AsStringWithFlags(sqlDollar[4].union.unresolvedName(), FmtBareIdentifiers), ClusterSetting: true}
 		}
-	case 270:
+	case 272:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1847
+//line sql.y:1896
 		{
 			sqlVAL.union.val = &Show{Name: "all", ClusterSetting: true}
 		}
-	case 271:
+	case 273:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1851
+//line sql.y:1900
 		{
 			sqlVAL.union.val = &Show{Name: "all", ClusterSetting: true}
 		}
-	case 272:
+	case 274:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1857
+//line sql.y:1906
 		{
 			sqlVAL.union.val = &ShowColumns{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 273:
+	case 275:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1863
+//line sql.y:1912
 		{
 			sqlVAL.union.val = &ShowDatabases{}
 		}
-	case 274:
+	case 276:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1869
+//line sql.y:1918
 		{
 			sqlVAL.union.val = &ShowGrants{Targets: sqlDollar[3].union.targetListPtr(), Grantees: sqlDollar[4].union.nameList()}
 		}
-	case 275:
+	case 277:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1875
+//line sql.y:1924
 		{
 			sqlVAL.union.val = &ShowIndex{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 276:
+	case 278:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1879
+//line sql.y:1928
 		{
 			sqlVAL.union.val = &ShowIndex{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 277:
+	case 279:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1883
+//line sql.y:1932
 		{
 			sqlVAL.union.val = &ShowIndex{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 278:
+	case 280:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1889
+//line sql.y:1938
 		{
 			sqlVAL.union.val = &ShowConstraints{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 279:
+	case 281:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1893
+//line sql.y:1942
 		{
 			sqlVAL.union.val = &ShowConstraints{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 280:
+	case 282:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1899
+//line sql.y:1948
 		{
 			sqlVAL.union.val = &ShowQueries{Cluster: true}
 		}
-	case 281:
+	case 283:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1903
+//line sql.y:1952
 		{
 			sqlVAL.union.val = &ShowQueries{Cluster: true}
 		}
-	case 282:
+	case 284:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1907
+//line sql.y:1956
 		{
 			sqlVAL.union.val = &ShowQueries{Cluster: false}
 		}
-	case 283:
+	case 285:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1913
+//line sql.y:1962
 		{
 			sqlVAL.union.val = &ShowJobs{}
 		}
-	case 284:
+	case 286:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1919
+//line sql.y:1968
 		{
 			sqlVAL.union.val = &ShowTrace{Statement: nil}
 		}
-	case 285:
+	case 287:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1923
+//line sql.y:1972
 		{
 			sqlVAL.union.val = &ShowTrace{Statement: nil, OnlyKVTrace: true}
 		}
-	case 286:
+	case 288:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1927
+//line sql.y:1976
 		{
 			sqlVAL.union.val = &ShowTrace{Statement: sqlDollar[4].union.stmt()}
 		}
-	case 287:
+	case 289:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1931
+//line sql.y:1980
 		{
 			sqlVAL.union.val = &ShowTrace{Statement: sqlDollar[5].union.stmt(), OnlyKVTrace: true}
 		}
-	case 288:
+	case 290:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1937
+//line sql.y:1986
 		{
 			sqlVAL.union.val = &ShowSessions{Cluster: true}
 		}
-	case 289:
+	case 291:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1941
+//line sql.y:1990
 		{
 			sqlVAL.union.val = &ShowSessions{Cluster: true}
 		}
-	case 290:
+	case 292:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1945
+//line sql.y:1994
 		{
 			sqlVAL.union.val = &ShowSessions{Cluster: false}
 		}
-	case 291:
+	case 293:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1951
+//line sql.y:2000
 		{
 			sqlVAL.union.val = &ShowTables{Database: Name(sqlDollar[4].str)}
 		}
-	case 292:
+	case 294:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1955
+//line sql.y:2004
 		{
 			sqlVAL.union.val = &ShowTables{}
 		}
-	case 293:
+	case 295:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1961
+//line sql.y:2010
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
 		}
-	case 294:
+	case 296:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1965
+//line sql.y:2015
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &Show{Name: "TRANSACTION PRIORITY"}
 		}
-	case 295:
+	case 297:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1969
+//line sql.y:2020
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &ShowTransactionStatus{}
 		}
-	case 296:
+	case 298:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1975
+//line sql.y:2027
 		{
 			sqlVAL.union.val = &ShowCreateTable{Table: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 297:
+	case 299:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1981
+//line sql.y:2033
 		{
 			sqlVAL.union.val = &ShowCreateView{View: sqlDollar[4].union.normalizableTableName()}
 		}
-	case 298:
+	case 300:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1987
+//line sql.y:2039
 		{
 			sqlVAL.union.val = &ShowUsers{}
 		}
-	case 299:
+	case 301:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1993
+//line sql.y:2045
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &ShowRanges{Table: sqlDollar[5].union.newNormalizableTableName()}
 		}
-	case 300:
+	case 302:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1997
+//line sql.y:2050
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &ShowRanges{Index: sqlDollar[5].union.tableWithIdx()}
 		}
-	case 301:
+	case 303:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2001
+//line sql.y:2055
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &ShowFingerprints{Table: sqlDollar[5].union.newNormalizableTableName(), AsOf: sqlDollar[6].union.asOfClause()}
 		}
-	case 302:
+	case 304:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2007
+//line sql.y:2062
 		{
 			sqlVAL.union.val = &Help{Name: Name(sqlDollar[2].str)}
 		}
-	case 303:
+	case 305:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2013
+//line sql.y:2068
 		{
 			tmp := sqlDollar[2].union.targetList()
 			sqlVAL.union.val = &tmp
 		}
-	case 304:
+	case 306:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2018
+//line sql.y:2073
 		{
 			sqlVAL.union.val = (*TargetList)(nil)
 		}
-	case 305:
+	case 307:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2024
+//line sql.y:2079
 		{
 			sqlVAL.union.val = sqlDollar[2].union.nameList()
 		}
-	case 306:
+	case 308:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2028
+//line sql.y:2083
 		{
 			sqlVAL.union.val = NameList(nil)
 		}
-	case 307:
+	case 309:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2035
+//line sql.y:2090
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &PauseJob{ID: sqlDollar[3].union.expr()}
 		}
-	case 308:
+	case 310:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2042
+//line sql.y:2098
 		{
 			sqlVAL.union.val = &CreateTable{Table: sqlDollar[3].union.normalizableTableName(), IfNotExists: false, Interleave: sqlDollar[7].union.interleave(), Defs: sqlDollar[5].union.tblDefs(), AsSource: nil, AsColumnNames: nil}
 		}
-	case 309:
+	case 311:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:2046
+//line sql.y:2102
 		{
 			sqlVAL.union.val = &CreateTable{Table: sqlDollar[6].union.normalizableTableName(), IfNotExists: true, Interleave: sqlDollar[10].union.interleave(), Defs: sqlDollar[8].union.tblDefs(), AsSource: nil, AsColumnNames: nil}
 		}
-	case 310:
+	case 312:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2052
+//line sql.y:2108
 		{
 			sqlVAL.union.val = &CreateTable{Table: sqlDollar[3].union.normalizableTableName(), IfNotExists: false, Interleave: nil, Defs: nil, AsSource: sqlDollar[6].union.slct(), AsColumnNames: sqlDollar[4].union.nameList()}
 		}
-	case 311:
+	case 313:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:2056
+//line sql.y:2112
 		{
 			sqlVAL.union.val = &CreateTable{Table: sqlDollar[6].union.normalizableTableName(), IfNotExists: true, Interleave: nil, Defs: nil, AsSource: sqlDollar[9].union.slct(), AsColumnNames: sqlDollar[7].union.nameList()}
 		}
-	case 313:
+	case 315:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2063
+//line sql.y:2119
 		{
 			sqlVAL.union.val = TableDefs(nil)
 		}
-	case 314:
+	case 316:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2069
+//line sql.y:2125
 		{
 			sqlVAL.union.val = TableDefs{sqlDollar[1].union.tblDef()}
 		}
-	case 315:
+	case 317:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2073
+//line sql.y:2129
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tblDefs(), sqlDollar[3].union.tblDef())
 		}
-	case 316:
+	case 318:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2079
+//line sql.y:2135
 		{
 			sqlVAL.union.val = sqlDollar[1].union.colDef()
 		}
-	case 319:
+	case 321:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2085
+//line sql.y:2141
 		{
 			sqlVAL.union.val = sqlDollar[1].union.constraintDef()
 		}
-	case 320:
+	case 322:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2091
+//line sql.y:2147
 		{
 			sqlVAL.union.val = &InterleaveDef{
 				Parent:       sqlDollar[4].union.newNormalizableTableName(),
@@ -7458,35 +7544,35 @@ sqldefault:
 				DropBehavior: sqlDollar[8].union.dropBehavior(),
 			}
 		}
-	case 321:
+	case 323:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2099
+//line sql.y:2155
 		{
 			sqlVAL.union.val = (*InterleaveDef)(nil)
 		}
-	case 322:
+	case 324:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2106
+//line sql.y:2162
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = DropCascade
 		}
-	case 323:
+	case 325:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2110
+//line sql.y:2167
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = DropRestrict
 		}
-	case 324:
+	case 326:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2114
+//line sql.y:2172
 		{
 			sqlVAL.union.val = DropDefault
 		}
-	case 325:
+	case 327:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2120
+//line sql.y:2178
 		{
 			tableDef, err := newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].union.colType(), sqlDollar[3].union.colQuals())
 			if err != nil {
@@ -7495,102 +7581,102 @@ sqldefault:
 			}
 			sqlVAL.union.val = tableDef
 		}
-	case 326:
+	case 328:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2131
+//line sql.y:2189
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.colQuals(), sqlDollar[2].union.colQual())
 		}
-	case 327:
+	case 329:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2135
+//line sql.y:2193
 		{
 			sqlVAL.union.val = []NamedColumnQualification(nil)
 		}
-	case 328:
+	case 330:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2141
+//line sql.y:2199
 		{
 			sqlVAL.union.val = NamedColumnQualification{Name: Name(sqlDollar[2].str), Qualification: sqlDollar[3].union.colQualElem()}
 		}
-	case 329:
+	case 331:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2145
+//line sql.y:2203
 		{
 			sqlVAL.union.val = NamedColumnQualification{Qualification: sqlDollar[1].union.colQualElem()}
 		}
-	case 330:
+	case 332:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2149
+//line sql.y:2207
 		{
 			sqlVAL.union.val = NamedColumnQualification{Qualification: ColumnCollation(sqlDollar[2].str)}
 		}
-	case 331:
+	case 333:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2153
+//line sql.y:2211
 		{
 			sqlVAL.union.val = NamedColumnQualification{Qualification: &ColumnFamilyConstraint{Family: Name(sqlDollar[2].str)}}
 		}
-	case 332:
+	case 334:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2157
+//line sql.y:2215
 		{
 			sqlVAL.union.val = NamedColumnQualification{Qualification: &ColumnFamilyConstraint{Family: Name(sqlDollar[3].str), Create: true}}
 		}
-	case 333:
+	case 335:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2161
+//line sql.y:2219
 		{
 			sqlVAL.union.val = NamedColumnQualification{Qualification: &ColumnFamilyConstraint{Family: Name(sqlDollar[6].str), Create: true, IfNotExists: true}}
 		}
-	case 334:
+	case 336:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2179
+//line sql.y:2237
 		{
 			sqlVAL.union.val = NotNullConstraint{}
 		}
-	case 335:
+	case 337:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2183
+//line sql.y:2241
 		{
 			sqlVAL.union.val = NullConstraint{}
 		}
-	case 336:
+	case 338:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2187
+//line sql.y:2245
 		{
 			sqlVAL.union.val = UniqueConstraint{}
 		}
-	case 337:
+	case 339:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2191
+//line sql.y:2249
 		{
 			sqlVAL.union.val = PrimaryKeyConstraint{}
 		}
-	case 338:
+	case 340:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2195
+//line sql.y:2253
 		{
 			sqlVAL.union.val = &ColumnCheckConstraint{Expr: sqlDollar[3].union.expr()}
 		}
-	case 339:
+	case 341:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2199
+//line sql.y:2257
 		{
 			sqlVAL.union.val = &ColumnDefault{Expr: sqlDollar[2].union.expr()}
 		}
-	case 340:
+	case 342:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2203
+//line sql.y:2261
 		{
 			sqlVAL.union.val = &ColumnFKConstraint{
 				Table: sqlDollar[2].union.normalizableTableName(),
 				Col:   Name(sqlDollar[3].str),
 			}
 		}
-	case 341:
+	case 343:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2212
+//line sql.y:2270
 		{
 			sqlVAL.union.val = &IndexTableDef{
 				Name:       Name(sqlDollar[2].str),
@@ -7599,9 +7685,9 @@ sqldefault:
 				Interleave: sqlDollar[7].union.interleave(),
 			}
 		}
-	case 342:
+	case 344:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2221
+//line sql.y:2279
 		{
 			sqlVAL.union.val = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -7612,39 +7698,39 @@ sqldefault:
 				},
 			}
 		}
-	case 343:
+	case 345:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2234
+//line sql.y:2292
 		{
 			sqlVAL.union.val = &FamilyTableDef{
 				Name:    Name(sqlDollar[2].str),
 				Columns: sqlDollar[4].union.nameList(),
 			}
 		}
-	case 344:
+	case 346:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2246
+//line sql.y:2304
 		{
 			sqlVAL.union.val = sqlDollar[3].union.constraintDef()
 			sqlVAL.union.val.(ConstraintTableDef).setName(Name(sqlDollar[2].str))
 		}
-	case 345:
+	case 347:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2251
+//line sql.y:2309
 		{
 			sqlVAL.union.val = sqlDollar[1].union.constraintDef()
 		}
-	case 346:
+	case 348:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2257
+//line sql.y:2315
 		{
 			sqlVAL.union.val = &CheckConstraintTableDef{
 				Expr: sqlDollar[3].union.expr(),
 			}
 		}
-	case 347:
+	case 349:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2263
+//line sql.y:2321
 		{
 			sqlVAL.union.val = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -7654,9 +7740,9 @@ sqldefault:
 				},
 			}
 		}
-	case 348:
+	case 350:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2273
+//line sql.y:2331
 		{
 			sqlVAL.union.val = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
@@ -7665,9 +7751,9 @@ sqldefault:
 				PrimaryKey: true,
 			}
 		}
-	case 349:
+	case 351:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:2283
+//line sql.y:2341
 		{
 			sqlVAL.union.val = &ForeignKeyConstraintTableDef{
 				Table:    sqlDollar[7].union.normalizableTableName(),
@@ -7675,163 +7761,163 @@ sqldefault:
 				ToCols:   sqlDollar[8].union.nameList(),
 			}
 		}
-	case 352:
+	case 354:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2306
+//line sql.y:2364
 		{
 			sqlVAL.union.val = sqlDollar[3].union.nameList()
 		}
-	case 353:
+	case 355:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2310
+//line sql.y:2368
 		{
 			sqlVAL.union.val = NameList(nil)
 		}
-	case 354:
+	case 356:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2316
+//line sql.y:2374
 		{
 			sqlVAL.union.val = sqlDollar[2].union.nameList()
 		}
-	case 355:
+	case 357:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2320
+//line sql.y:2378
 		{
 			sqlVAL.union.val = NameList(nil)
 		}
-	case 356:
+	case 358:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2325
+//line sql.y:2383
 		{
 			return unimplemented(sqllex, "match full")
 		}
-	case 357:
+	case 359:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2326
+//line sql.y:2384
 		{
 			return unimplemented(sqllex, "match partial")
 		}
-	case 358:
+	case 360:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2327
+//line sql.y:2385
 		{
 			return unimplemented(sqllex, "match simple")
 		}
-	case 359:
+	case 361:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2328
+//line sql.y:2386
 		{
 		}
-	case 360:
+	case 362:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2334
+//line sql.y:2392
 		{
 		}
-	case 361:
+	case 363:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2335
+//line sql.y:2393
 		{
 		}
-	case 362:
+	case 364:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2336
+//line sql.y:2394
 		{
 		}
-	case 363:
+	case 365:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2337
+//line sql.y:2395
 		{
 		}
-	case 364:
+	case 366:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2338
+//line sql.y:2396
 		{
 		}
-	case 365:
+	case 367:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2341
+//line sql.y:2399
 		{
 		}
-	case 366:
+	case 368:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2344
+//line sql.y:2402
 		{
 		}
-	case 367:
+	case 369:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2347
+//line sql.y:2405
 		{
 			return unimplemented(sqllex, "no action")
 		}
-	case 368:
+	case 370:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2350
+//line sql.y:2408
 		{
 		}
-	case 369:
+	case 371:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2351
+//line sql.y:2409
 		{
 			return unimplemented(sqllex, "action cascade")
 		}
-	case 370:
+	case 372:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2352
+//line sql.y:2410
 		{
 			return unimplemented(sqllex, "action set null")
 		}
-	case 371:
+	case 373:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2353
+//line sql.y:2411
 		{
 			return unimplemented(sqllex, "action set default")
 		}
-	case 372:
+	case 374:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2357
+//line sql.y:2415
 		{
 			sqlVAL.union.val = sqlDollar[1].union.numVal()
 		}
-	case 373:
+	case 375:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2361
+//line sql.y:2419
 		{
 			sqlVAL.union.val = &NumVal{Value: constant.UnaryOp(token.SUB, sqlDollar[2].union.numVal().Value, 0)}
 		}
-	case 374:
+	case 376:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2365
+//line sql.y:2423
 		{
 			sqlVAL.union.val = sqlDollar[1].union.numVal()
 		}
-	case 375:
+	case 377:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2372
+//line sql.y:2430
 		{
 			sqlVAL.union.val = &Truncate{Tables: sqlDollar[3].union.tableNameReferences(), DropBehavior: sqlDollar[4].union.dropBehavior()}
 		}
-	case 376:
+	case 378:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2379
+//line sql.y:2437
 		{
 			sqlVAL.union.val = &CreateUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[4].union.strPtr()}
 		}
-	case 377:
+	case 379:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2385
+//line sql.y:2443
 		{
 			pwd := sqlDollar[3].str
 			sqlVAL.union.val = &pwd
 		}
-	case 378:
+	case 380:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2389
+//line sql.y:2447
 		{
 			sqlVAL.union.val = (*string)(nil)
 		}
-	case 379:
+	case 381:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2396
+//line sql.y:2454
 		{
 			sqlVAL.union.val = &CreateView{
 				Name:        sqlDollar[3].union.normalizableTableName(),
@@ -7839,9 +7925,9 @@ sqldefault:
 				AsSource:    sqlDollar[6].union.slct(),
 			}
 		}
-	case 380:
+	case 382:
 		sqlDollar = sqlS[sqlpt-11 : sqlpt+1]
-		//line sql.y:2409
+//line sql.y:2467
 		{
 			sqlVAL.union.val = &CreateIndex{
 				Name:       Name(sqlDollar[4].str),
@@ -7852,9 +7938,9 @@ sqldefault:
 				Interleave: sqlDollar[11].union.interleave(),
 			}
 		}
-	case 381:
+	case 383:
 		sqlDollar = sqlS[sqlpt-14 : sqlpt+1]
-		//line sql.y:2420
+//line sql.y:2478
 		{
 			sqlVAL.union.val = &CreateIndex{
 				Name:        Name(sqlDollar[7].str),
@@ -7866,211 +7952,211 @@ sqldefault:
 				Interleave:  sqlDollar[14].union.interleave(),
 			}
 		}
-	case 382:
+	case 384:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2434
+//line sql.y:2492
 		{
 			sqlVAL.union.val = true
 		}
-	case 383:
+	case 385:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2438
+//line sql.y:2496
 		{
 			sqlVAL.union.val = false
 		}
-	case 384:
+	case 386:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2444
+//line sql.y:2502
 		{
 			sqlVAL.union.val = IndexElemList{sqlDollar[1].union.idxElem()}
 		}
-	case 385:
+	case 387:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2448
+//line sql.y:2506
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.idxElems(), sqlDollar[3].union.idxElem())
 		}
-	case 386:
+	case 388:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2457
+//line sql.y:2515
 		{
 			sqlVAL.union.val = IndexElem{Column: Name(sqlDollar[1].str), Direction: sqlDollar[3].union.dir()}
 		}
-	case 387:
+	case 389:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2460
+//line sql.y:2518
 		{
 			return unimplemented(sqllex, "index_elem func expr")
 		}
-	case 388:
+	case 390:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2461
+//line sql.y:2519
 		{
 			return unimplemented(sqllex, "index_elem a_expr")
 		}
-	case 389:
+	case 391:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2464
+//line sql.y:2522
 		{
 			return unimplementedWithIssue(sqllex, 16619)
 		}
-	case 390:
+	case 392:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2465
+//line sql.y:2523
 		{
 		}
-	case 391:
+	case 393:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2469
+//line sql.y:2527
 		{
 			sqlVAL.union.val = Ascending
 		}
-	case 392:
+	case 394:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2473
+//line sql.y:2531
 		{
 			sqlVAL.union.val = Descending
 		}
-	case 393:
+	case 395:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2477
+//line sql.y:2535
 		{
 			sqlVAL.union.val = DefaultDirection
 		}
-	case 394:
+	case 396:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2483
+//line sql.y:2541
 		{
 			sqlVAL.union.val = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 395:
+	case 397:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2489
+//line sql.y:2547
 		{
 			sqlVAL.union.val = &RenameTable{Name: sqlDollar[3].union.normalizableTableName(), NewName: sqlDollar[6].union.normalizableTableName(), IfExists: false, IsView: false}
 		}
-	case 396:
+	case 398:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2493
+//line sql.y:2551
 		{
 			sqlVAL.union.val = &RenameTable{Name: sqlDollar[5].union.normalizableTableName(), NewName: sqlDollar[8].union.normalizableTableName(), IfExists: true, IsView: false}
 		}
-	case 397:
+	case 399:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2497
+//line sql.y:2555
 		{
 			sqlVAL.union.val = &RenameColumn{Table: sqlDollar[3].union.normalizableTableName(), Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 398:
+	case 400:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:2501
+//line sql.y:2559
 		{
 			sqlVAL.union.val = &RenameColumn{Table: sqlDollar[5].union.normalizableTableName(), Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 399:
+	case 401:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2505
+//line sql.y:2563
 		{
 			return unimplemented(sqllex, "alter table rename constraint")
 		}
-	case 400:
+	case 402:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:2507
+//line sql.y:2565
 		{
 			return unimplemented(sqllex, "alter table rename constraint")
 		}
-	case 401:
+	case 403:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2511
+//line sql.y:2569
 		{
 			sqlVAL.union.val = &RenameTable{Name: sqlDollar[3].union.normalizableTableName(), NewName: sqlDollar[6].union.normalizableTableName(), IfExists: false, IsView: true}
 		}
-	case 402:
+	case 404:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2515
+//line sql.y:2573
 		{
 			sqlVAL.union.val = &RenameTable{Name: sqlDollar[5].union.normalizableTableName(), NewName: sqlDollar[8].union.normalizableTableName(), IfExists: true, IsView: true}
 		}
-	case 403:
+	case 405:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2521
+//line sql.y:2579
 		{
 			sqlVAL.union.val = &RenameIndex{Index: sqlDollar[3].union.tableWithIdx(), NewName: Name(sqlDollar[6].str), IfExists: false}
 		}
-	case 404:
+	case 406:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2525
+//line sql.y:2583
 		{
 			sqlVAL.union.val = &RenameIndex{Index: sqlDollar[5].union.tableWithIdx(), NewName: Name(sqlDollar[8].str), IfExists: true}
 		}
-	case 405:
+	case 407:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2531
+//line sql.y:2589
 		{
 			sqlVAL.union.val = true
 		}
-	case 406:
+	case 408:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2535
+//line sql.y:2593
 		{
 			sqlVAL.union.val = false
 		}
-	case 407:
+	case 409:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2540
+//line sql.y:2598
 		{
 		}
-	case 408:
+	case 410:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2541
+//line sql.y:2599
 		{
 		}
-	case 409:
+	case 411:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2545
+//line sql.y:2603
 		{
 			sqlVAL.union.val = &ReleaseSavepoint{Savepoint: sqlDollar[2].str}
 		}
-	case 410:
+	case 412:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2552
+//line sql.y:2610
 		{
 			/* SKIP DOC */
 			sqlVAL.union.val = &ResumeJob{ID: sqlDollar[3].union.expr()}
 		}
-	case 411:
+	case 413:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2558
+//line sql.y:2617
 		{
 			sqlVAL.union.val = &Savepoint{Name: sqlDollar[2].str}
 		}
-	case 415:
+	case 417:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2570
+//line sql.y:2629
 		{
 			sqlVAL.union.val = sqlDollar[3].union.stmt()
 		}
-	case 416:
+	case 418:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2574
+//line sql.y:2633
 		{
 			sqlVAL.union.val = sqlDollar[3].union.stmt()
 		}
-	case 417:
+	case 419:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2580
+//line sql.y:2639
 		{
 			sqlVAL.union.val = &CommitTransaction{}
 		}
-	case 418:
+	case 420:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2584
+//line sql.y:2643
 		{
 			sqlVAL.union.val = &CommitTransaction{}
 		}
-	case 419:
+	case 421:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2590
+//line sql.y:2649
 		{
 			if sqlDollar[2].str != "" {
 				sqlVAL.union.val = &RollbackToSavepoint{Savepoint: sqlDollar[2].str}
@@ -8078,73 +8164,73 @@ sqldefault:
 				sqlVAL.union.val = &RollbackTransaction{}
 			}
 		}
-	case 420:
+	case 422:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2599
+//line sql.y:2658
 		{
 		}
-	case 421:
+	case 423:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2600
+//line sql.y:2659
 		{
 		}
-	case 422:
+	case 424:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2604
+//line sql.y:2663
 		{
 			sqlVAL.str = ""
 		}
-	case 423:
+	case 425:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2608
+//line sql.y:2667
 		{
 			sqlVAL.str = sqlDollar[3].str
 		}
-	case 424:
+	case 426:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2612
+//line sql.y:2671
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 425:
+	case 427:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2616
+//line sql.y:2675
 		{
 			sqlVAL.str = ""
 		}
-	case 426:
+	case 428:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2622
+//line sql.y:2681
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 427:
+	case 429:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2626
+//line sql.y:2685
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 428:
+	case 430:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2632
+//line sql.y:2691
 		{
 			sqlVAL.union.val = &BeginTransaction{Modes: sqlDollar[1].union.transactionModes()}
 		}
-	case 429:
+	case 431:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2636
+//line sql.y:2695
 		{
 			sqlVAL.union.val = &BeginTransaction{}
 		}
-	case 430:
+	case 432:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2642
+//line sql.y:2701
 		{
 			sqlVAL.union.val = sqlDollar[1].union.transactionModes()
 		}
-	case 431:
+	case 433:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2646
+//line sql.y:2705
 		{
 			a := sqlDollar[1].union.transactionModes()
 			b := sqlDollar[3].union.transactionModes()
@@ -8155,51 +8241,51 @@ sqldefault:
 			}
 			sqlVAL.union.val = a
 		}
-	case 432:
+	case 434:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2656
+//line sql.y:2715
 		{
 			sqlVAL.union.val = TransactionModes{Isolation: sqlDollar[1].union.isoLevel()}
 		}
-	case 433:
+	case 435:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2660
+//line sql.y:2719
 		{
 			sqlVAL.union.val = TransactionModes{UserPriority: sqlDollar[1].union.userPriority()}
 		}
-	case 434:
+	case 436:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2664
+//line sql.y:2723
 		{
 			sqlVAL.union.val = TransactionModes{ReadWriteMode: sqlDollar[1].union.readWriteMode()}
 		}
-	case 435:
+	case 437:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2670
+//line sql.y:2729
 		{
 			sqlVAL.union.val = sqlDollar[2].union.userPriority()
 		}
-	case 436:
+	case 438:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2676
+//line sql.y:2735
 		{
 			sqlVAL.union.val = sqlDollar[3].union.isoLevel()
 		}
-	case 437:
+	case 439:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2682
+//line sql.y:2741
 		{
 			sqlVAL.union.val = ReadOnly
 		}
-	case 438:
+	case 440:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2686
+//line sql.y:2745
 		{
 			sqlVAL.union.val = ReadWrite
 		}
-	case 439:
+	case 441:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2692
+//line sql.y:2751
 		{
 			sqlVAL.union.val = &CreateDatabase{
 				Name:     Name(sqlDollar[3].str),
@@ -8209,9 +8295,9 @@ sqldefault:
 				CType:    sqlDollar[8].str,
 			}
 		}
-	case 440:
+	case 442:
 		sqlDollar = sqlS[sqlpt-11 : sqlpt+1]
-		//line sql.y:2702
+//line sql.y:2761
 		{
 			sqlVAL.union.val = &CreateDatabase{
 				IfNotExists: true,
@@ -8222,274 +8308,280 @@ sqldefault:
 				CType:       sqlDollar[11].str,
 			}
 		}
-	case 441:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2715
-		{
-			sqlVAL.str = sqlDollar[3].str
-		}
-	case 442:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2719
-		{
-			sqlVAL.str = ""
-		}
 	case 443:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2725
+//line sql.y:2774
 		{
 			sqlVAL.str = sqlDollar[3].str
 		}
 	case 444:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2729
+//line sql.y:2778
 		{
 			sqlVAL.str = ""
 		}
 	case 445:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2735
+//line sql.y:2784
 		{
 			sqlVAL.str = sqlDollar[3].str
 		}
 	case 446:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2739
+//line sql.y:2788
 		{
 			sqlVAL.str = ""
 		}
 	case 447:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2745
+//line sql.y:2794
 		{
 			sqlVAL.str = sqlDollar[3].str
 		}
 	case 448:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2749
+//line sql.y:2798
 		{
 			sqlVAL.str = ""
 		}
 	case 449:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2754
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+//line sql.y:2804
 		{
+			sqlVAL.str = sqlDollar[3].str
 		}
 	case 450:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2755
+//line sql.y:2808
 		{
+			sqlVAL.str = ""
 		}
 	case 451:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+//line sql.y:2813
+		{
+		}
+	case 452:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+//line sql.y:2814
+		{
+		}
+	case 453:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2759
+//line sql.y:2818
 		{
 			sqlVAL.union.val = sqlDollar[5].union.stmt()
 			sqlVAL.union.val.(*Insert).Table = sqlDollar[4].union.tblExpr()
 			sqlVAL.union.val.(*Insert).Returning = sqlDollar[6].union.retClause()
 		}
-	case 452:
+	case 454:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2765
+//line sql.y:2824
 		{
 			sqlVAL.union.val = sqlDollar[5].union.stmt()
 			sqlVAL.union.val.(*Insert).Table = sqlDollar[4].union.tblExpr()
 			sqlVAL.union.val.(*Insert).OnConflict = sqlDollar[6].union.onConflict()
 			sqlVAL.union.val.(*Insert).Returning = sqlDollar[7].union.retClause()
 		}
-	case 453:
+	case 455:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2774
+//line sql.y:2833
 		{
 			sqlVAL.union.val = sqlDollar[5].union.stmt()
 			sqlVAL.union.val.(*Insert).Table = sqlDollar[4].union.tblExpr()
 			sqlVAL.union.val.(*Insert).OnConflict = &OnConflict{}
 			sqlVAL.union.val.(*Insert).Returning = sqlDollar[6].union.retClause()
 		}
-	case 454:
+	case 456:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2787
+//line sql.y:2846
 		{
 			sqlVAL.union.val = sqlDollar[1].union.newNormalizableTableName()
 		}
-	case 455:
+	case 457:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2791
+//line sql.y:2850
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: sqlDollar[1].union.newNormalizableTableName(), As: AliasClause{Alias: Name(sqlDollar[3].str)}}
 		}
-	case 456:
+	case 458:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2797
+//line sql.y:2856
 		{
 			sqlVAL.union.val = &Insert{Rows: sqlDollar[1].union.slct()}
 		}
-	case 457:
+	case 459:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2801
+//line sql.y:2860
 		{
 			sqlVAL.union.val = &Insert{Columns: sqlDollar[2].union.unresolvedNames(), Rows: sqlDollar[4].union.slct()}
 		}
-	case 458:
+	case 460:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2805
+//line sql.y:2864
 		{
 			sqlVAL.union.val = &Insert{Rows: &Select{}}
 		}
-	case 459:
+	case 461:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2811
+//line sql.y:2870
 		{
-			sqlVAL.union.val = &OnConflict{Columns: sqlDollar[3].union.nameList(), Exprs: sqlDollar[7].union.updateExprs(), Where: newWhere(astWhere, sqlDollar[8].union.expr())}
+			oc := sqlDollar[3].union.onConflict()
+			oc.Exprs = sqlDollar[7].union.updateExprs()
+			oc.Where = newWhere(astWhere, sqlDollar[8].union.expr())
+			sqlVAL.union.val = oc
 		}
-	case 460:
+	case 462:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2815
+//line sql.y:2877
 		{
-			sqlVAL.union.val = &OnConflict{Columns: sqlDollar[3].union.nameList(), DoNothing: true}
+			oc := sqlDollar[3].union.onConflict()
+			oc.DoNothing = true
+			sqlVAL.union.val = oc
 		}
-	case 461:
+	case 463:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2821
+//line sql.y:2885
 		{
 			// TODO(dan): Support the where_clause.
-			sqlVAL.union.val = sqlDollar[2].union.nameList()
+			sqlVAL.union.val = &OnConflict{Columns: sqlDollar[2].union.nameList()}
 		}
-	case 462:
+	case 464:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2825
+//line sql.y:2890
 		{
-			return unimplemented(sqllex, "on conflict on constraint")
+			sqlVAL.union.val = &OnConflict{Constraint: Name(sqlDollar[3].str)}
 		}
-	case 463:
+	case 465:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2827
+//line sql.y:2894
 		{
-			sqlVAL.union.val = NameList(nil)
+			sqlVAL.union.val = &OnConflict{}
 		}
-	case 464:
+	case 466:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2833
+//line sql.y:2900
 		{
 			ret := ReturningExprs(sqlDollar[2].union.selExprs())
 			sqlVAL.union.val = &ret
 		}
-	case 465:
+	case 467:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2838
+//line sql.y:2905
 		{
 			sqlVAL.union.val = returningNothingClause
 		}
-	case 466:
+	case 468:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2842
+//line sql.y:2909
 		{
 			sqlVAL.union.val = AbsentReturningClause
 		}
-	case 467:
+	case 469:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2849
+//line sql.y:2916
 		{
-			sqlVAL.union.val = &Update{Table: sqlDollar[3].union.tblExpr(), Exprs: sqlDollar[5].union.updateExprs(), Where: newWhere(astWhere, sqlDollar[7].union.expr()), Returning: sqlDollar[8].union.retClause()}
+			sqlVAL.union.val = &Update{Table: sqlDollar[3].union.tblExpr(), Exprs: sqlDollar[5].union.updateExprs(), From: sqlDollar[6].union.tblExprs(), Where: newWhere(astWhere, sqlDollar[7].union.expr()), Returning: sqlDollar[8].union.retClause()}
 		}
-	case 468:
+	case 470:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2855
+//line sql.y:2922
 		{
-			return unimplementedWithIssue(sqllex, 7841)
+			sqlVAL.union.val = sqlDollar[2].union.tblExprs()
 		}
-	case 469:
+	case 471:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2856
+//line sql.y:2926
 		{
+			sqlVAL.union.val = TableExprs(nil)
 		}
-	case 470:
+	case 472:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2860
+//line sql.y:2932
 		{
 			sqlVAL.union.val = UpdateExprs{sqlDollar[1].union.updateExpr()}
 		}
-	case 471:
+	case 473:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2864
+//line sql.y:2936
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.updateExprs(), sqlDollar[3].union.updateExpr())
 		}
-	case 474:
+	case 476:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2874
+//line sql.y:2946
 		{
 			sqlVAL.union.val = &UpdateExpr{Names: UnresolvedNames{sqlDollar[1].union.unresolvedName()}, Expr: sqlDollar[3].union.expr()}
 		}
-	case 475:
+	case 477:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2886
+//line sql.y:2958
 		{
 			sqlVAL.union.val = &UpdateExpr{Tuple: true, Names: sqlDollar[2].union.unresolvedNames(), Expr: &Tuple{Exprs: sqlDollar[5].union.exprs()}}
 		}
-	case 476:
+	case 478:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2890
+//line sql.y:2962
 		{
 			sqlVAL.union.val = &UpdateExpr{Tuple: true, Names: sqlDollar[2].union.unresolvedNames(), Expr: &Subquery{Select: sqlDollar[5].union.selectStmt()}}
 		}
-	case 478:
+	case 480:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2934
+//line sql.y:3006
 		{
 			sqlVAL.union.val = &Select{Select: sqlDollar[1].union.selectStmt()}
 		}
-	case 479:
+	case 481:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2940
+//line sql.y:3012
 		{
 			sqlVAL.union.val = &ParenSelect{Select: sqlDollar[2].union.slct()}
 		}
-	case 480:
+	case 482:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2944
+//line sql.y:3016
 		{
 			sqlVAL.union.val = &ParenSelect{Select: &Select{Select: sqlDollar[2].union.selectStmt()}}
 		}
-	case 481:
+	case 483:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2959
+//line sql.y:3031
 		{
 			sqlVAL.union.val = &Select{Select: sqlDollar[1].union.selectStmt()}
 		}
-	case 482:
+	case 484:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2963
+//line sql.y:3035
 		{
 			sqlVAL.union.val = &Select{Select: sqlDollar[1].union.selectStmt(), OrderBy: sqlDollar[2].union.orderBy()}
 		}
-	case 483:
+	case 485:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2967
+//line sql.y:3039
 		{
 			sqlVAL.union.val = &Select{Select: sqlDollar[1].union.selectStmt(), OrderBy: sqlDollar[2].union.orderBy(), Limit: sqlDollar[3].union.limit()}
 		}
-	case 484:
+	case 486:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2971
+//line sql.y:3043
 		{
-			sqlVAL.union.val = &Select{Select: sqlDollar[2].union.selectStmt()}
+			sqlVAL.union.val = &Select{With: sqlDollar[1].union.with(), Select: sqlDollar[2].union.selectStmt()}
 		}
-	case 485:
+	case 487:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2975
+//line sql.y:3047
 		{
-			sqlVAL.union.val = &Select{Select: sqlDollar[2].union.selectStmt(), OrderBy: sqlDollar[3].union.orderBy()}
+			sqlVAL.union.val = &Select{With: sqlDollar[1].union.with(), Select: sqlDollar[2].union.selectStmt(), OrderBy: sqlDollar[3].union.orderBy()}
 		}
-	case 486:
+	case 488:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2979
+//line sql.y:3051
 		{
-			sqlVAL.union.val = &Select{Select: sqlDollar[2].union.selectStmt(), OrderBy: sqlDollar[3].union.orderBy(), Limit: sqlDollar[4].union.limit()}
+			sqlVAL.union.val = &Select{With: sqlDollar[1].union.with(), Select: sqlDollar[2].union.selectStmt(), OrderBy: sqlDollar[3].union.orderBy(), Limit: sqlDollar[4].union.limit()}
 		}
-	case 489:
+	case 491:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:3013
+//line sql.y:3085
 		{
 			sqlVAL.union.val = &SelectClause{
 				Exprs:   sqlDollar[3].union.selExprs(),
@@ -8500,9 +8592,9 @@ sqldefault:
 				Window:  sqlDollar[8].union.window(),
 			}
 		}
-	case 490:
+	case 492:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:3026
+//line sql.y:3098
 		{
 			sqlVAL.union.val = &SelectClause{
 				Distinct: sqlDollar[2].union.bool(),
@@ -8515,8 +8607,23 @@ sqldefault:
 			}
 		}
 	case 493:
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+//line sql.y:3112
+		{
+			sqlVAL.union.val = &SelectClause{
+				Distinct:   true,
+				DistinctOn: sqlDollar[2].union.distinctOn(),
+				Exprs:      sqlDollar[3].union.selExprs(),
+				From:       sqlDollar[4].union.from(),
+				Where:      newWhere(astWhere, sqlDollar[5].union.expr()),
+				GroupBy:    sqlDollar[6].union.groupBy(),
+				Having:     newWhere(astHaving, sqlDollar[7].union.expr()),
+				Window:     sqlDollar[8].union.window(),
+			}
+		}
+	case 496:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3040
+//line sql.y:3127
 		{
 			sqlVAL.union.val = &UnionClause{
 				Type:  UnionOp,
@@ -8525,9 +8632,9 @@ sqldefault:
 				All:   sqlDollar[3].union.bool(),
 			}
 		}
-	case 494:
+	case 497:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3049
+//line sql.y:3136
 		{
 			sqlVAL.union.val = &UnionClause{
 				Type:  IntersectOp,
@@ -8536,9 +8643,9 @@ sqldefault:
 				All:   sqlDollar[3].union.bool(),
 			}
 		}
-	case 495:
+	case 498:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3058
+//line sql.y:3145
 		{
 			sqlVAL.union.val = &UnionClause{
 				Type:  ExceptOp,
@@ -8547,9 +8654,9 @@ sqldefault:
 				All:   sqlDollar[3].union.bool(),
 			}
 		}
-	case 496:
+	case 499:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3069
+//line sql.y:3156
 		{
 			sqlVAL.union.val = &SelectClause{
 				Exprs:       SelectExprs{starSelectExpr()},
@@ -8557,158 +8664,167 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 497:
+	case 500:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3086
+//line sql.y:3174
 		{
-			return unimplemented(sqllex, "with cte_list")
+			sqlVAL.union.val = &With{CTEList: sqlDollar[2].union.ctes()}
 		}
-	case 498:
+	case 501:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3087
+//line sql.y:3178
 		{
-			return unimplemented(sqllex, "with cte_list")
+			sqlVAL.union.val = &With{CTEList: sqlDollar[2].union.ctes()}
 		}
-	case 499:
+	case 502:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3088
+//line sql.y:3182
 		{
-			return unimplemented(sqllex, "with cte_list")
+			sqlVAL.union.val = &With{Recursive: true, CTEList: sqlDollar[3].union.ctes()}
 		}
-	case 500:
+	case 503:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3091
+//line sql.y:3188
 		{
-			return unimplemented(sqllex, "cte_list")
+			sqlVAL.union.val = []*CTE{sqlDollar[1].union.cte()}
 		}
-	case 501:
+	case 504:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3092
+//line sql.y:3192
 		{
-			return unimplemented(sqllex, "cte_list")
+			sqlVAL.union.val = append(sqlDollar[1].union.ctes(), sqlDollar[3].union.cte())
 		}
-	case 502:
+	case 505:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3095
+//line sql.y:3198
 		{
-			return unimplemented(sqllex, "cte")
+			sqlVAL.union.val = &CTE{
+				Name: AliasClause{Alias: Name(sqlDollar[1].str), Cols: sqlDollar[2].union.nameList()},
+				Stmt: sqlDollar[5].union.stmt(),
+			}
 		}
-	case 503:
+	case 506:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3098
+//line sql.y:3206
 		{
 		}
-	case 504:
+	case 507:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3099
+//line sql.y:3207
 		{
 		}
-	case 505:
+	case 508:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3102
+//line sql.y:3210
 		{
 			return unimplemented(sqllex, "with_clause")
 		}
-	case 506:
+	case 509:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3103
+//line sql.y:3211
 		{
 		}
-	case 507:
+	case 510:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3106
+//line sql.y:3214
 		{
 		}
-	case 508:
+	case 511:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3107
+//line sql.y:3215
 		{
 		}
-	case 509:
+	case 512:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3111
+//line sql.y:3219
 		{
 			sqlVAL.union.val = true
 		}
-	case 510:
+	case 513:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3115
+//line sql.y:3223
 		{
 			sqlVAL.union.val = false
 		}
-	case 511:
+	case 514:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3119
+//line sql.y:3227
 		{
 			sqlVAL.union.val = false
 		}
-	case 512:
+	case 515:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3125
+//line sql.y:3233
 		{
 			sqlVAL.union.val = true
 		}
-	case 513:
+	case 516:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+//line sql.y:3239
+		{
+			sqlVAL.union.val = DistinctOn(sqlDollar[4].union.exprs())
+		}
+	case 517:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3130
+//line sql.y:3244
 		{
 		}
-	case 514:
+	case 518:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3131
+//line sql.y:3245
 		{
 		}
-	case 515:
+	case 519:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3135
+//line sql.y:3249
 		{
 			sqlVAL.union.val = sqlDollar[1].union.orderBy()
 		}
-	case 516:
+	case 520:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3139
+//line sql.y:3253
 		{
 			sqlVAL.union.val = OrderBy(nil)
 		}
-	case 517:
+	case 521:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3145
+//line sql.y:3259
 		{
 			sqlVAL.union.val = OrderBy(sqlDollar[3].union.orders())
 		}
-	case 518:
+	case 522:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3151
+//line sql.y:3265
 		{
 			sqlVAL.union.val = []*Order{sqlDollar[1].union.order()}
 		}
-	case 519:
+	case 523:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3155
+//line sql.y:3269
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.orders(), sqlDollar[3].union.order())
 		}
-	case 520:
+	case 524:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3161
+//line sql.y:3275
 		{
 			sqlVAL.union.val = &Order{OrderType: OrderByColumn, Expr: sqlDollar[1].union.expr(), Direction: sqlDollar[2].union.dir()}
 		}
-	case 521:
+	case 525:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3165
+//line sql.y:3279
 		{
 			sqlVAL.union.val = &Order{OrderType: OrderByIndex, Direction: sqlDollar[4].union.dir(), Table: sqlDollar[3].union.normalizableTableName()}
 		}
-	case 522:
+	case 526:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3169
+//line sql.y:3283
 		{
 			sqlVAL.union.val = &Order{OrderType: OrderByIndex, Direction: sqlDollar[5].union.dir(), Table: sqlDollar[2].union.normalizableTableName(), Index: Name(sqlDollar[4].str)}
 		}
-	case 523:
+	case 527:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3178
+//line sql.y:3292
 		{
 			if sqlDollar[1].union.limit() == nil {
 				sqlVAL.union.val = sqlDollar[2].union.limit()
@@ -8717,18 +8833,18 @@ sqldefault:
 				sqlVAL.union.val.(*Limit).Offset = sqlDollar[2].union.limit().Offset
 			}
 		}
-	case 524:
+	case 528:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3187
+//line sql.y:3301
 		{
 			sqlVAL.union.val = sqlDollar[1].union.limit()
 			if sqlDollar[2].union.limit() != nil {
 				sqlVAL.union.val.(*Limit).Count = sqlDollar[2].union.limit().Count
 			}
 		}
-	case 527:
+	case 531:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3198
+//line sql.y:3312
 		{
 			if sqlDollar[2].union.expr() == nil {
 				sqlVAL.union.val = (*Limit)(nil)
@@ -8736,139 +8852,139 @@ sqldefault:
 				sqlVAL.union.val = &Limit{Count: sqlDollar[2].union.expr()}
 			}
 		}
-	case 528:
+	case 532:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3207
+//line sql.y:3321
 		{
 			sqlVAL.union.val = &Limit{Count: sqlDollar[3].union.expr()}
 		}
-	case 529:
+	case 533:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3213
+//line sql.y:3327
 		{
 			sqlVAL.union.val = &Limit{Offset: sqlDollar[2].union.expr()}
 		}
-	case 530:
+	case 534:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3220
+//line sql.y:3334
 		{
 			sqlVAL.union.val = &Limit{Offset: sqlDollar[2].union.expr()}
 		}
-	case 532:
+	case 536:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3227
+//line sql.y:3341
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 533:
+	case 537:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3236
+//line sql.y:3350
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 534:
+	case 538:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3240
+//line sql.y:3354
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 535:
+	case 539:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3244
+//line sql.y:3358
 		{
 			sqlVAL.union.val = &NumVal{Value: constant.MakeInt64(1)}
 		}
-	case 536:
+	case 540:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3250
+//line sql.y:3364
 		{
 		}
-	case 537:
+	case 541:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3251
+//line sql.y:3365
 		{
 		}
-	case 538:
+	case 542:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3254
+//line sql.y:3368
 		{
 		}
-	case 539:
+	case 543:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3255
+//line sql.y:3369
 		{
 		}
-	case 540:
+	case 544:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3277
+//line sql.y:3391
 		{
 			sqlVAL.union.val = GroupBy(sqlDollar[3].union.exprs())
 		}
-	case 541:
+	case 545:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3281
+//line sql.y:3395
 		{
 			sqlVAL.union.val = GroupBy(nil)
 		}
-	case 542:
+	case 546:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3287
+//line sql.y:3401
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 543:
+	case 547:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3291
+//line sql.y:3405
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 544:
+	case 548:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3305
+//line sql.y:3419
 		{
 			sqlVAL.union.val = &ValuesClause{[]*Tuple{{Exprs: sqlDollar[2].union.exprs()}}}
 		}
-	case 545:
+	case 549:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3309
+//line sql.y:3423
 		{
 			valNode := sqlDollar[1].union.selectStmt().(*ValuesClause)
 			valNode.Tuples = append(valNode.Tuples, &Tuple{Exprs: sqlDollar[3].union.exprs()})
 			sqlVAL.union.val = valNode
 		}
-	case 546:
+	case 550:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3321
+//line sql.y:3435
 		{
 			sqlVAL.union.val = &From{Tables: sqlDollar[2].union.tblExprs(), AsOf: sqlDollar[3].union.asOfClause()}
 		}
-	case 547:
+	case 551:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3325
+//line sql.y:3439
 		{
 			sqlVAL.union.val = &From{}
 		}
-	case 548:
+	case 552:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3331
+//line sql.y:3445
 		{
 			sqlVAL.union.val = TableExprs{sqlDollar[1].union.tblExpr()}
 		}
-	case 549:
+	case 553:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3335
+//line sql.y:3449
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tblExprs(), sqlDollar[3].union.tblExpr())
 		}
-	case 550:
+	case 554:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3341
+//line sql.y:3455
 		{
 			sqlVAL.union.val = &IndexHints{Index: Name(sqlDollar[3].str)}
 		}
-	case 551:
+	case 555:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3345
+//line sql.y:3459
 		{
 			/* SKIP DOC */
 			id, err := sqlDollar[4].union.numVal().AsInt64()
@@ -8878,21 +8994,21 @@ sqldefault:
 			}
 			sqlVAL.union.val = &IndexHints{IndexID: IndexID(id)}
 		}
-	case 552:
+	case 556:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3352
+//line sql.y:3467
 		{
 			sqlVAL.union.val = &IndexHints{NoIndexJoin: true}
 		}
-	case 553:
+	case 557:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3358
+//line sql.y:3473
 		{
 			sqlVAL.union.val = sqlDollar[1].union.indexHints()
 		}
-	case 554:
+	case 558:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3363
+//line sql.y:3478
 		{
 			a := sqlDollar[1].union.indexHints()
 			b := sqlDollar[3].union.indexHints()
@@ -8913,15 +9029,15 @@ sqldefault:
 			a.NoIndexJoin = a.NoIndexJoin || b.NoIndexJoin
 			sqlVAL.union.val = a
 		}
-	case 555:
+	case 559:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3386
+//line sql.y:3501
 		{
 			sqlVAL.union.val = &IndexHints{Index: Name(sqlDollar[2].str)}
 		}
-	case 556:
+	case 560:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3390
+//line sql.y:3505
 		{
 			id, err := sqlDollar[3].union.numVal().AsInt64()
 			if err != nil {
@@ -8930,21 +9046,21 @@ sqldefault:
 			}
 			sqlVAL.union.val = &IndexHints{IndexID: IndexID(id)}
 		}
-	case 557:
+	case 561:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3396
+//line sql.y:3511
 		{
 			sqlVAL.union.val = sqlDollar[3].union.indexHints()
 		}
-	case 558:
+	case 562:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3400
+//line sql.y:3515
 		{
 			sqlVAL.union.val = (*IndexHints)(nil)
 		}
-	case 559:
+	case 563:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:3407
+//line sql.y:3522
 		{
 			/* SKIP DOC */
 			id, err := sqlDollar[2].union.numVal().AsInt64()
@@ -8963,63 +9079,63 @@ sqldefault:
 				As:         sqlDollar[8].union.aliasClause(),
 			}
 		}
-	case 560:
+	case 564:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3425
+//line sql.y:3541
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: sqlDollar[1].union.newNormalizableTableName(), Hints: sqlDollar[2].union.indexHints(), Ordinality: sqlDollar[3].union.bool(), As: sqlDollar[4].union.aliasClause()}
 		}
-	case 561:
+	case 565:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3429
+//line sql.y:3545
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Exprs: sqlDollar[3].union.exprs()}, Ordinality: sqlDollar[5].union.bool(), As: sqlDollar[6].union.aliasClause()}
 		}
-	case 562:
+	case 566:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3433
+//line sql.y:3549
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].union.selectStmt()}, Ordinality: sqlDollar[2].union.bool(), As: sqlDollar[3].union.aliasClause()}
 		}
-	case 563:
+	case 567:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3437
+//line sql.y:3553
 		{
 			sqlVAL.union.val = sqlDollar[1].union.tblExpr()
 		}
-	case 564:
+	case 568:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3441
+//line sql.y:3557
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: &ParenTableExpr{sqlDollar[2].union.tblExpr()}, Ordinality: sqlDollar[4].union.bool(), As: sqlDollar[5].union.aliasClause()}
 		}
-	case 565:
+	case 569:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3461
+//line sql.y:3577
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: &StatementSource{Statement: sqlDollar[2].union.stmt()}, Ordinality: sqlDollar[4].union.bool(), As: sqlDollar[5].union.aliasClause()}
 		}
-	case 566:
+	case 570:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3466
+//line sql.y:3582
 		{
 			sqlVAL.union.val = nil
 		}
-	case 567:
+	case 571:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3467
+//line sql.y:3583
 		{
 			sqlVAL.union.val = []ColumnID{}
 		}
-	case 568:
+	case 572:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3468
+//line sql.y:3584
 		{
 			sqlVAL.union.val = sqlDollar[2].union.tableRefCols()
 		}
-	case 569:
+	case 573:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3472
+//line sql.y:3588
 		{
 			id, err := sqlDollar[1].union.numVal().AsInt64()
 			if err != nil {
@@ -9028,9 +9144,9 @@ sqldefault:
 			}
 			sqlVAL.union.val = []ColumnID{ColumnID(id)}
 		}
-	case 570:
+	case 574:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3478
+//line sql.y:3594
 		{
 			id, err := sqlDollar[3].union.numVal().AsInt64()
 			if err != nil {
@@ -9039,211 +9155,211 @@ sqldefault:
 			}
 			sqlVAL.union.val = append(sqlDollar[1].union.tableRefCols(), ColumnID(id))
 		}
-	case 571:
+	case 575:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3486
+//line sql.y:3602
 		{
 			sqlVAL.union.val = true
 		}
-	case 572:
+	case 576:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3490
+//line sql.y:3606
 		{
 			sqlVAL.union.val = false
 		}
-	case 573:
+	case 577:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3510
+//line sql.y:3626
 		{
 			sqlVAL.union.val = &ParenTableExpr{Expr: sqlDollar[2].union.tblExpr()}
 		}
-	case 574:
+	case 578:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3514
+//line sql.y:3630
 		{
 			sqlVAL.union.val = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].union.tblExpr(), Right: sqlDollar[4].union.tblExpr()}
 		}
-	case 575:
+	case 579:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3518
+//line sql.y:3634
 		{
 			sqlVAL.union.val = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].union.tblExpr(), Right: sqlDollar[4].union.tblExpr(), Cond: sqlDollar[5].union.joinCond()}
 		}
-	case 576:
+	case 580:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3522
+//line sql.y:3638
 		{
 			sqlVAL.union.val = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].union.tblExpr(), Right: sqlDollar[3].union.tblExpr(), Cond: sqlDollar[4].union.joinCond()}
 		}
-	case 577:
+	case 581:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3526
+//line sql.y:3642
 		{
 			sqlVAL.union.val = &JoinTableExpr{Join: sqlDollar[3].str, Left: sqlDollar[1].union.tblExpr(), Right: sqlDollar[5].union.tblExpr(), Cond: NaturalJoinCond{}}
 		}
-	case 578:
+	case 582:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3530
+//line sql.y:3646
 		{
 			sqlVAL.union.val = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].union.tblExpr(), Right: sqlDollar[4].union.tblExpr(), Cond: NaturalJoinCond{}}
 		}
-	case 579:
+	case 583:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3536
+//line sql.y:3652
 		{
 			sqlVAL.union.val = AliasClause{Alias: Name(sqlDollar[2].str), Cols: sqlDollar[4].union.nameList()}
 		}
-	case 580:
+	case 584:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3540
+//line sql.y:3656
 		{
 			sqlVAL.union.val = AliasClause{Alias: Name(sqlDollar[2].str)}
 		}
-	case 581:
+	case 585:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3544
+//line sql.y:3660
 		{
 			sqlVAL.union.val = AliasClause{Alias: Name(sqlDollar[1].str), Cols: sqlDollar[3].union.nameList()}
 		}
-	case 582:
+	case 586:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3548
+//line sql.y:3664
 		{
 			sqlVAL.union.val = AliasClause{Alias: Name(sqlDollar[1].str)}
 		}
-	case 584:
+	case 588:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3555
+//line sql.y:3671
 		{
 			sqlVAL.union.val = AliasClause{}
 		}
-	case 585:
+	case 589:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3561
+//line sql.y:3677
 		{
 			sqlVAL.union.val = AsOfClause{Expr: sqlDollar[5].union.expr()}
 		}
-	case 586:
+	case 590:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3565
+//line sql.y:3681
 		{
 			sqlVAL.union.val = AsOfClause{}
 		}
-	case 587:
+	case 591:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3571
+//line sql.y:3687
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 588:
+	case 592:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3575
+//line sql.y:3691
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 589:
+	case 593:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3579
+//line sql.y:3695
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 590:
+	case 594:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3583
+//line sql.y:3699
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 591:
+	case 595:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3589
+//line sql.y:3705
 		{
 		}
-	case 592:
+	case 596:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3590
+//line sql.y:3706
 		{
 		}
-	case 593:
+	case 597:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3601
+//line sql.y:3717
 		{
 			sqlVAL.union.val = &UsingJoinCond{Cols: sqlDollar[3].union.nameList()}
 		}
-	case 594:
+	case 598:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3605
+//line sql.y:3721
 		{
 			sqlVAL.union.val = &OnJoinCond{Expr: sqlDollar[2].union.expr()}
 		}
-	case 595:
+	case 599:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3611
+//line sql.y:3727
 		{
 			sqlVAL.union.val = sqlDollar[1].union.unresolvedName()
 		}
-	case 596:
+	case 600:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3615
+//line sql.y:3731
 		{
 			sqlVAL.union.val = sqlDollar[1].union.unresolvedName()
 		}
-	case 597:
+	case 601:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3619
+//line sql.y:3735
 		{
 			sqlVAL.union.val = sqlDollar[2].union.unresolvedName()
 		}
-	case 598:
+	case 602:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3623
+//line sql.y:3739
 		{
 			sqlVAL.union.val = sqlDollar[3].union.unresolvedName()
 		}
-	case 599:
+	case 603:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3629
+//line sql.y:3745
 		{
 			sqlVAL.union.val = TableNameReferences{sqlDollar[1].union.unresolvedName()}
 		}
-	case 600:
+	case 604:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3633
+//line sql.y:3749
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tableNameReferences(), sqlDollar[3].union.unresolvedName())
 		}
-	case 601:
+	case 605:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3646
+//line sql.y:3762
 		{
 			sqlVAL.union.val = sqlDollar[1].union.newNormalizableTableName()
 		}
-	case 602:
+	case 606:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3650
+//line sql.y:3766
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: sqlDollar[1].union.newNormalizableTableName(), As: AliasClause{Alias: Name(sqlDollar[2].str)}}
 		}
-	case 603:
+	case 607:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3654
+//line sql.y:3770
 		{
 			sqlVAL.union.val = &AliasedTableExpr{Expr: sqlDollar[1].union.newNormalizableTableName(), As: AliasClause{Alias: Name(sqlDollar[3].str)}}
 		}
-	case 604:
+	case 608:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3660
+//line sql.y:3776
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 605:
+	case 609:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3664
+//line sql.y:3780
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 606:
+	case 610:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3676
+//line sql.y:3792
 		{
 			if exprs := sqlDollar[2].union.exprs(); exprs != nil {
 				var err error
@@ -9256,123 +9372,123 @@ sqldefault:
 				sqlVAL.union.val = sqlDollar[1].union.colType()
 			}
 		}
-	case 607:
+	case 611:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3689
+//line sql.y:3805
 		{
 			return unimplementedWithIssue(sqllex, 2115)
 		}
-	case 608:
+	case 612:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3690
+//line sql.y:3806
 		{
 			return unimplementedWithIssue(sqllex, 2115)
 		}
-	case 609:
+	case 613:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3694
+//line sql.y:3810
 		{
 			sqlVAL.union.val = sqlDollar[1].union.colType()
 		}
-	case 610:
+	case 614:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3698
+//line sql.y:3814
 		{
 			sqlVAL.union.val = sqlDollar[1].union.castTargetType()
 		}
-	case 611:
+	case 615:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3703
+//line sql.y:3819
 		{
 			sqlVAL.union.val = Exprs{NewDInt(DInt(-1))}
 		}
-	case 612:
+	case 616:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3704
+//line sql.y:3820
 		{
 			return unimplementedWithIssue(sqllex, 2115)
 		}
-	case 613:
+	case 617:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3705
+//line sql.y:3821
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
-	case 619:
+	case 623:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3713
+//line sql.y:3829
 		{
 			return unimplemented(sqllex, "simple_type const_interval")
 		}
-	case 620:
+	case 624:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3715
+//line sql.y:3831
 		{
 			sqlVAL.union.val = bytesColTypeBlob
 		}
-	case 621:
+	case 625:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3719
+//line sql.y:3835
 		{
 			sqlVAL.union.val = bytesColTypeBytes
 		}
-	case 622:
+	case 626:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3723
+//line sql.y:3839
 		{
 			sqlVAL.union.val = bytesColTypeBytea
 		}
-	case 623:
+	case 627:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3727
+//line sql.y:3843
 		{
 			sqlVAL.union.val = stringColTypeText
 		}
-	case 624:
+	case 628:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3731
+//line sql.y:3847
 		{
 			sqlVAL.union.val = nameColTypeName
 		}
-	case 625:
+	case 629:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3735
+//line sql.y:3851
 		{
 			sqlVAL.union.val = intColTypeSerial
 		}
-	case 626:
+	case 630:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3739
+//line sql.y:3855
 		{
 			sqlVAL.union.val = intColTypeSmallSerial
 		}
-	case 627:
+	case 631:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3743
+//line sql.y:3859
 		{
 			sqlVAL.union.val = uuidColTypeUUID
 		}
-	case 628:
+	case 632:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3747
+//line sql.y:3863
 		{
 			sqlVAL.union.val = intColTypeBigSerial
 		}
-	case 629:
+	case 633:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3751
+//line sql.y:3867
 		{
 			sqlVAL.union.val = oidColTypeOid
 		}
-	case 630:
+	case 634:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3755
+//line sql.y:3871
 		{
 			sqlVAL.union.val = int2vectorColType
 		}
-	case 635:
+	case 639:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3776
+//line sql.y:3892
 		{
 			prec, err := sqlDollar[2].union.numVal().AsInt64()
 			if err != nil {
@@ -9381,9 +9497,9 @@ sqldefault:
 			}
 			sqlVAL.union.val = &DecimalColType{Prec: int(prec)}
 		}
-	case 636:
+	case 640:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3785
+//line sql.y:3901
 		{
 			prec, err := sqlDollar[2].union.numVal().AsInt64()
 			if err != nil {
@@ -9397,81 +9513,81 @@ sqldefault:
 			}
 			sqlVAL.union.val = &DecimalColType{Prec: int(prec), Scale: int(scale)}
 		}
-	case 637:
+	case 641:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3799
+//line sql.y:3915
 		{
 			sqlVAL.union.val = nil
 		}
-	case 638:
+	case 642:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3806
+//line sql.y:3922
 		{
 			sqlVAL.union.val = intColTypeInt
 		}
-	case 639:
+	case 643:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3810
+//line sql.y:3926
 		{
 			sqlVAL.union.val = intColTypeInt2
 		}
-	case 640:
+	case 644:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3814
+//line sql.y:3930
 		{
 			sqlVAL.union.val = intColTypeInt4
 		}
-	case 641:
+	case 645:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3818
+//line sql.y:3934
 		{
 			sqlVAL.union.val = intColTypeInt8
 		}
-	case 642:
+	case 646:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3822
+//line sql.y:3938
 		{
 			sqlVAL.union.val = intColTypeInt64
 		}
-	case 643:
+	case 647:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3826
+//line sql.y:3942
 		{
 			sqlVAL.union.val = intColTypeInteger
 		}
-	case 644:
+	case 648:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3830
+//line sql.y:3946
 		{
 			sqlVAL.union.val = intColTypeSmallInt
 		}
-	case 645:
+	case 649:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3834
+//line sql.y:3950
 		{
 			sqlVAL.union.val = intColTypeBigInt
 		}
-	case 646:
+	case 650:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3838
+//line sql.y:3954
 		{
 			sqlVAL.union.val = floatColTypeReal
 		}
-	case 647:
+	case 651:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3842
+//line sql.y:3958
 		{
 			sqlVAL.union.val = floatColTypeFloat4
 		}
-	case 648:
+	case 652:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3846
+//line sql.y:3962
 		{
 			sqlVAL.union.val = floatColTypeFloat8
 		}
-	case 649:
+	case 653:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3850
+//line sql.y:3966
 		{
 			nv := sqlDollar[2].union.numVal()
 			prec, err := nv.AsInt64()
@@ -9481,15 +9597,15 @@ sqldefault:
 			}
 			sqlVAL.union.val = NewFloatColType(int(prec), len(nv.OrigString) > 0)
 		}
-	case 650:
+	case 654:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3860
+//line sql.y:3976
 		{
 			sqlVAL.union.val = floatColTypeDouble
 		}
-	case 651:
+	case 655:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3864
+//line sql.y:3980
 		{
 			sqlVAL.union.val = sqlDollar[2].union.colType()
 			if sqlVAL.union.val == nil {
@@ -9498,9 +9614,9 @@ sqldefault:
 				sqlVAL.union.val.(*DecimalColType).Name = "DECIMAL"
 			}
 		}
-	case 652:
+	case 656:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3873
+//line sql.y:3989
 		{
 			sqlVAL.union.val = sqlDollar[2].union.colType()
 			if sqlVAL.union.val == nil {
@@ -9509,9 +9625,9 @@ sqldefault:
 				sqlVAL.union.val.(*DecimalColType).Name = "DEC"
 			}
 		}
-	case 653:
+	case 657:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3882
+//line sql.y:3998
 		{
 			sqlVAL.union.val = sqlDollar[2].union.colType()
 			if sqlVAL.union.val == nil {
@@ -9520,63 +9636,63 @@ sqldefault:
 				sqlVAL.union.val.(*DecimalColType).Name = "NUMERIC"
 			}
 		}
-	case 654:
+	case 658:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3891
+//line sql.y:4007
 		{
 			sqlVAL.union.val = boolColTypeBoolean
 		}
-	case 655:
+	case 659:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3895
+//line sql.y:4011
 		{
 			sqlVAL.union.val = boolColTypeBool
 		}
-	case 656:
+	case 660:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3902
+//line sql.y:4018
 		{
 			sqlVAL.union.val = oidColTypeRegProc
 		}
-	case 657:
+	case 661:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3906
+//line sql.y:4022
 		{
 			sqlVAL.union.val = oidColTypeRegProcedure
 		}
-	case 658:
+	case 662:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3910
+//line sql.y:4026
 		{
 			sqlVAL.union.val = oidColTypeRegClass
 		}
-	case 659:
+	case 663:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3914
+//line sql.y:4030
 		{
 			sqlVAL.union.val = oidColTypeRegType
 		}
-	case 660:
+	case 664:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3918
+//line sql.y:4034
 		{
 			sqlVAL.union.val = oidColTypeRegNamespace
 		}
-	case 661:
+	case 665:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3924
+//line sql.y:4040
 		{
 			sqlVAL.union.val = sqlDollar[2].union.numVal()
 		}
-	case 662:
+	case 666:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3928
+//line sql.y:4044
 		{
 			sqlVAL.union.val = &NumVal{Value: constant.MakeInt64(0)}
 		}
-	case 667:
+	case 671:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3946
+//line sql.y:4062
 		{
 			n, err := sqlDollar[4].union.numVal().AsInt64()
 			if err != nil {
@@ -9590,15 +9706,15 @@ sqldefault:
 			}
 			sqlVAL.union.val = bit
 		}
-	case 668:
+	case 672:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3962
+//line sql.y:4078
 		{
 			sqlVAL.union.val = intColTypeBit
 		}
-	case 673:
+	case 677:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3978
+//line sql.y:4094
 		{
 			n, err := sqlDollar[3].union.numVal().AsInt64()
 			if err != nil {
@@ -9612,547 +9728,547 @@ sqldefault:
 				sqlVAL.union.val = strType
 			}
 		}
-	case 674:
+	case 678:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3994
+//line sql.y:4110
 		{
 			sqlVAL.union.val = sqlDollar[1].union.colType()
 		}
-	case 675:
+	case 679:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4000
+//line sql.y:4116
 		{
 			sqlVAL.union.val = stringColTypeChar
 		}
-	case 676:
+	case 680:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4004
+//line sql.y:4120
 		{
 			sqlVAL.union.val = stringColTypeChar
 		}
-	case 677:
+	case 681:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4008
+//line sql.y:4124
 		{
 			sqlVAL.union.val = stringColTypeVarChar
 		}
-	case 678:
+	case 682:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4012
+//line sql.y:4128
 		{
 			sqlVAL.union.val = stringColTypeString
 		}
-	case 679:
+	case 683:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4017
+//line sql.y:4133
 		{
 		}
-	case 680:
+	case 684:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4018
+//line sql.y:4134
 		{
 		}
-	case 681:
+	case 685:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4023
+//line sql.y:4139
 		{
 			sqlVAL.union.val = dateColTypeDate
 		}
-	case 682:
+	case 686:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4027
+//line sql.y:4143
 		{
 			sqlVAL.union.val = timestampColTypeTimestamp
 		}
-	case 683:
+	case 687:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4031
+//line sql.y:4147
 		{
 			sqlVAL.union.val = timestampColTypeTimestamp
 		}
-	case 684:
+	case 688:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4035
+//line sql.y:4151
 		{
 			sqlVAL.union.val = timestampTzColTypeTimestampWithTZ
 		}
-	case 685:
+	case 689:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4039
+//line sql.y:4155
 		{
 			sqlVAL.union.val = timestampTzColTypeTimestampWithTZ
 		}
-	case 686:
+	case 690:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4044
+//line sql.y:4160
 		{
 			sqlVAL.union.val = intervalColTypeInterval
 		}
-	case 687:
+	case 691:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4050
+//line sql.y:4166
 		{
 			sqlVAL.union.val = year
 		}
-	case 688:
+	case 692:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4054
+//line sql.y:4170
 		{
 			sqlVAL.union.val = month
 		}
-	case 689:
+	case 693:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4058
+//line sql.y:4174
 		{
 			sqlVAL.union.val = day
 		}
-	case 690:
+	case 694:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4062
+//line sql.y:4178
 		{
 			sqlVAL.union.val = hour
 		}
-	case 691:
+	case 695:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4066
+//line sql.y:4182
 		{
 			sqlVAL.union.val = minute
 		}
-	case 692:
+	case 696:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4070
+//line sql.y:4186
 		{
 			sqlVAL.union.val = sqlDollar[1].union.durationField()
 		}
-	case 693:
+	case 697:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4076
+//line sql.y:4192
 		{
 			sqlVAL.union.val = month
 		}
-	case 694:
+	case 698:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4080
+//line sql.y:4196
 		{
 			sqlVAL.union.val = hour
 		}
-	case 695:
+	case 699:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4084
+//line sql.y:4200
 		{
 			sqlVAL.union.val = minute
 		}
-	case 696:
+	case 700:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4088
+//line sql.y:4204
 		{
 			sqlVAL.union.val = sqlDollar[3].union.durationField()
 		}
-	case 697:
+	case 701:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4092
+//line sql.y:4208
 		{
 			sqlVAL.union.val = minute
 		}
-	case 698:
+	case 702:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4096
+//line sql.y:4212
 		{
 			sqlVAL.union.val = sqlDollar[3].union.durationField()
 		}
-	case 699:
+	case 703:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4100
+//line sql.y:4216
 		{
 			sqlVAL.union.val = sqlDollar[3].union.durationField()
 		}
-	case 700:
+	case 704:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4104
+//line sql.y:4220
 		{
 			sqlVAL.union.val = nil
 		}
-	case 701:
+	case 705:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4110
+//line sql.y:4226
 		{
 			sqlVAL.union.val = second
 		}
-	case 702:
+	case 706:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4113
+//line sql.y:4229
 		{
 			return unimplemented(sqllex, "interval_second")
 		}
-	case 704:
+	case 708:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4137
+//line sql.y:4253
 		{
 			sqlVAL.union.val = &CastExpr{Expr: sqlDollar[1].union.expr(), Type: sqlDollar[3].union.castTargetType(), syntaxMode: castShort}
 		}
-	case 705:
+	case 709:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4141
+//line sql.y:4257
 		{
 			sqlVAL.union.val = &AnnotateTypeExpr{Expr: sqlDollar[1].union.expr(), Type: sqlDollar[3].union.colType(), syntaxMode: annotateShort}
 		}
-	case 706:
+	case 710:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4145
+//line sql.y:4261
 		{
 			sqlVAL.union.val = &CollateExpr{Expr: sqlDollar[1].union.expr(), Locale: sqlDollar[3].str}
 		}
-	case 707:
+	case 711:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4148
+//line sql.y:4264
 		{
 			return unimplemented(sqllex, "at tz")
 		}
-	case 708:
+	case 712:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4157
+//line sql.y:4273
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].union.expr()}
 		}
-	case 709:
+	case 713:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4161
+//line sql.y:4277
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].union.expr()}
 		}
-	case 710:
+	case 714:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4165
+//line sql.y:4281
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].union.expr()}
 		}
-	case 711:
+	case 715:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4169
+//line sql.y:4285
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 712:
+	case 716:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4173
+//line sql.y:4289
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 713:
+	case 717:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4177
+//line sql.y:4293
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 714:
+	case 718:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4181
+//line sql.y:4297
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Div, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 715:
+	case 719:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4185
+//line sql.y:4301
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: FloorDiv, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 716:
+	case 720:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4189
+//line sql.y:4305
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 717:
+	case 721:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4193
+//line sql.y:4309
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Pow, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 718:
+	case 722:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4197
+//line sql.y:4313
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 719:
+	case 723:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4201
+//line sql.y:4317
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 720:
+	case 724:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4205
+//line sql.y:4321
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 721:
+	case 725:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4209
+//line sql.y:4325
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 722:
+	case 726:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4213
+//line sql.y:4329
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 723:
+	case 727:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4217
+//line sql.y:4333
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 724:
+	case 728:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4221
+//line sql.y:4337
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 725:
+	case 729:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4225
+//line sql.y:4341
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 726:
+	case 730:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4229
+//line sql.y:4345
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 727:
+	case 731:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4233
+//line sql.y:4349
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 728:
+	case 732:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4237
+//line sql.y:4353
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 729:
+	case 733:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4241
+//line sql.y:4357
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 730:
+	case 734:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4245
+//line sql.y:4361
 		{
 			sqlVAL.union.val = &AndExpr{Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 731:
+	case 735:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4249
+//line sql.y:4365
 		{
 			sqlVAL.union.val = &OrExpr{Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 732:
+	case 736:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4253
+//line sql.y:4369
 		{
 			sqlVAL.union.val = &NotExpr{Expr: sqlDollar[2].union.expr()}
 		}
-	case 733:
+	case 737:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4257
+//line sql.y:4373
 		{
 			sqlVAL.union.val = &NotExpr{Expr: sqlDollar[2].union.expr()}
 		}
-	case 734:
+	case 738:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4261
+//line sql.y:4377
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 735:
+	case 739:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4265
+//line sql.y:4381
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].union.expr(), Right: sqlDollar[4].union.expr()}
 		}
-	case 736:
+	case 740:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4269
+//line sql.y:4385
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: ILike, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 737:
+	case 741:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4273
+//line sql.y:4389
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotILike, Left: sqlDollar[1].union.expr(), Right: sqlDollar[4].union.expr()}
 		}
-	case 738:
+	case 742:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4277
+//line sql.y:4393
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].union.expr(), Right: sqlDollar[4].union.expr()}
 		}
-	case 739:
+	case 743:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4281
+//line sql.y:4397
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].union.expr(), Right: sqlDollar[5].union.expr()}
 		}
-	case 740:
+	case 744:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4285
+//line sql.y:4401
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: RegMatch, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 741:
+	case 745:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4289
+//line sql.y:4405
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotRegMatch, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 742:
+	case 746:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4293
+//line sql.y:4409
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: RegIMatch, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 743:
+	case 747:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4297
+//line sql.y:4413
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotRegIMatch, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 744:
+	case 748:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4301
+//line sql.y:4417
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("ISNAN"), Exprs: Exprs{sqlDollar[1].union.expr()}}
 		}
-	case 745:
+	case 749:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4305
+//line sql.y:4421
 		{
 			sqlVAL.union.val = &NotExpr{Expr: &FuncExpr{Func: wrapFunction("ISNAN"), Exprs: Exprs{sqlDollar[1].union.expr()}}}
 		}
-	case 746:
+	case 750:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4309
+//line sql.y:4425
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].union.expr(), Right: DNull}
 		}
-	case 747:
+	case 751:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4313
+//line sql.y:4429
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].union.expr(), Right: DNull}
 		}
-	case 748:
+	case 752:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4316
+//line sql.y:4432
 		{
 			return unimplemented(sqllex, "overlaps")
 		}
-	case 749:
+	case 753:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4318
+//line sql.y:4434
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].union.expr(), Right: MakeDBool(true)}
 		}
-	case 750:
+	case 754:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4322
+//line sql.y:4438
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].union.expr(), Right: MakeDBool(true)}
 		}
-	case 751:
+	case 755:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4326
+//line sql.y:4442
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].union.expr(), Right: MakeDBool(false)}
 		}
-	case 752:
+	case 756:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4330
+//line sql.y:4446
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].union.expr(), Right: MakeDBool(false)}
 		}
-	case 753:
+	case 757:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4334
+//line sql.y:4450
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].union.expr(), Right: DNull}
 		}
-	case 754:
+	case 758:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4338
+//line sql.y:4454
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].union.expr(), Right: DNull}
 		}
-	case 755:
+	case 759:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4342
+//line sql.y:4458
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].union.expr(), Right: sqlDollar[5].union.expr()}
 		}
-	case 756:
+	case 760:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4346
+//line sql.y:4462
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].union.expr(), Right: sqlDollar[6].union.expr()}
 		}
-	case 757:
+	case 761:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4350
+//line sql.y:4466
 		{
 			sqlVAL.union.val = &IsOfTypeExpr{Expr: sqlDollar[1].union.expr(), Types: sqlDollar[5].union.colTypes()}
 		}
-	case 758:
+	case 762:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:4354
+//line sql.y:4470
 		{
 			sqlVAL.union.val = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].union.expr(), Types: sqlDollar[6].union.colTypes()}
 		}
-	case 759:
+	case 763:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4358
+//line sql.y:4474
 		{
 			sqlVAL.union.val = &RangeCond{Left: sqlDollar[1].union.expr(), From: sqlDollar[4].union.expr(), To: sqlDollar[6].union.expr()}
 		}
-	case 760:
+	case 764:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:4362
+//line sql.y:4478
 		{
 			sqlVAL.union.val = &RangeCond{Not: true, Left: sqlDollar[1].union.expr(), From: sqlDollar[5].union.expr(), To: sqlDollar[7].union.expr()}
 		}
-	case 761:
+	case 765:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4366
+//line sql.y:4482
 		{
 			sqlVAL.union.val = &RangeCond{Left: sqlDollar[1].union.expr(), From: sqlDollar[4].union.expr(), To: sqlDollar[6].union.expr()}
 		}
-	case 762:
+	case 766:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:4370
+//line sql.y:4486
 		{
 			sqlVAL.union.val = &RangeCond{Not: true, Left: sqlDollar[1].union.expr(), From: sqlDollar[5].union.expr(), To: sqlDollar[7].union.expr()}
 		}
-	case 763:
+	case 767:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4374
+//line sql.y:4490
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: In, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 764:
+	case 768:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4378
+//line sql.y:4494
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].union.expr(), Right: sqlDollar[4].union.expr()}
 		}
-	case 765:
+	case 769:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4382
+//line sql.y:4498
 		{
 			op := sqlDollar[3].union.cmpOp()
 			subOp := sqlDollar[2].union.op()
@@ -10169,198 +10285,198 @@ sqldefault:
 				Right:       sqlDollar[4].union.expr(),
 			}
 		}
-	case 767:
+	case 771:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4410
+//line sql.y:4526
 		{
 			sqlVAL.union.val = &CastExpr{Expr: sqlDollar[1].union.expr(), Type: sqlDollar[3].union.castTargetType(), syntaxMode: castShort}
 		}
-	case 768:
+	case 772:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4414
+//line sql.y:4530
 		{
 			sqlVAL.union.val = &AnnotateTypeExpr{Expr: sqlDollar[1].union.expr(), Type: sqlDollar[3].union.colType(), syntaxMode: annotateShort}
 		}
-	case 769:
+	case 773:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4418
+//line sql.y:4534
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].union.expr()}
 		}
-	case 770:
+	case 774:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4422
+//line sql.y:4538
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].union.expr()}
 		}
-	case 771:
+	case 775:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4426
+//line sql.y:4542
 		{
 			sqlVAL.union.val = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].union.expr()}
 		}
-	case 772:
+	case 776:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4430
+//line sql.y:4546
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 773:
+	case 777:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4434
+//line sql.y:4550
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 774:
+	case 778:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4438
+//line sql.y:4554
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 775:
+	case 779:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4442
+//line sql.y:4558
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Div, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 776:
+	case 780:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4446
+//line sql.y:4562
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: FloorDiv, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 777:
+	case 781:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4450
+//line sql.y:4566
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 778:
+	case 782:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4454
+//line sql.y:4570
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Pow, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 779:
+	case 783:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4458
+//line sql.y:4574
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 780:
+	case 784:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4462
+//line sql.y:4578
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 781:
+	case 785:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4466
+//line sql.y:4582
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 782:
+	case 786:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4470
+//line sql.y:4586
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 783:
+	case 787:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4474
+//line sql.y:4590
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 784:
+	case 788:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4478
+//line sql.y:4594
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 785:
+	case 789:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4482
+//line sql.y:4598
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 786:
+	case 790:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4486
+//line sql.y:4602
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 787:
+	case 791:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4490
+//line sql.y:4606
 		{
 			sqlVAL.union.val = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 788:
+	case 792:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4494
+//line sql.y:4610
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 789:
+	case 793:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4498
+//line sql.y:4614
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 790:
+	case 794:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4502
+//line sql.y:4618
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].union.expr(), Right: sqlDollar[3].union.expr()}
 		}
-	case 791:
+	case 795:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4506
+//line sql.y:4622
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].union.expr(), Right: sqlDollar[5].union.expr()}
 		}
-	case 792:
+	case 796:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4510
+//line sql.y:4626
 		{
 			sqlVAL.union.val = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].union.expr(), Right: sqlDollar[6].union.expr()}
 		}
-	case 793:
+	case 797:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4514
+//line sql.y:4630
 		{
 			sqlVAL.union.val = &IsOfTypeExpr{Expr: sqlDollar[1].union.expr(), Types: sqlDollar[5].union.colTypes()}
 		}
-	case 794:
+	case 798:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:4518
+//line sql.y:4634
 		{
 			sqlVAL.union.val = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].union.expr(), Types: sqlDollar[6].union.colTypes()}
 		}
-	case 796:
+	case 800:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4531
+//line sql.y:4647
 		{
 			sqlVAL.union.val = &IndirectionExpr{
 				Expr:        sqlDollar[1].union.expr(),
 				Indirection: sqlDollar[2].union.arraySubscripts(),
 			}
 		}
-	case 798:
+	case 802:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4539
+//line sql.y:4655
 		{
 			sqlVAL.union.val = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].union.selectStmt()}}
 		}
-	case 799:
+	case 803:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4550
+//line sql.y:4666
 		{
 			sqlVAL.union.val = sqlDollar[1].union.unresolvedName()
 		}
-	case 801:
+	case 805:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4555
+//line sql.y:4671
 		{
 			/* SKIP DOC */
 			colNum, err := sqlDollar[2].union.numVal().AsInt64()
@@ -10374,1185 +10490,1219 @@ sqldefault:
 			}
 			sqlVAL.union.val = NewOrdinalReference(int(colNum - 1))
 		}
-	case 802:
+	case 806:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4568
+//line sql.y:4685
 		{
 			sqlVAL.union.val = NewPlaceholder(sqlDollar[1].str)
 		}
-	case 803:
+	case 807:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4572
+//line sql.y:4689
 		{
 			sqlVAL.union.val = &ParenExpr{Expr: sqlDollar[2].union.expr()}
 		}
-	case 805:
+	case 809:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4577
+//line sql.y:4694
 		{
 			sqlVAL.union.val = &Subquery{Select: sqlDollar[1].union.selectStmt()}
 		}
-	case 806:
+	case 810:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4581
+//line sql.y:4698
 		{
 			sqlVAL.union.val = &ArrayFlatten{Subquery: &Subquery{Select: sqlDollar[2].union.selectStmt()}}
 		}
-	case 807:
+	case 811:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4585
+//line sql.y:4702
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 808:
+	case 812:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4589
+//line sql.y:4706
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 809:
+	case 813:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4593
+//line sql.y:4710
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 810:
+	case 814:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4601
+//line sql.y:4718
 		{
 			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference()}
 		}
-	case 811:
+	case 815:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4605
+//line sql.y:4722
 		{
-			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Exprs: sqlDollar[3].union.exprs()}
+			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Exprs: sqlDollar[3].union.exprs(), OrderBy: sqlDollar[4].union.orderBy()}
 		}
-	case 812:
+	case 816:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4608
+//line sql.y:4725
 		{
 			return unimplemented(sqllex, "variadic")
 		}
-	case 813:
+	case 817:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:4609
+//line sql.y:4726
 		{
 			return unimplemented(sqllex, "variadic")
 		}
-	case 814:
+	case 818:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4611
+//line sql.y:4728
 		{
-			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Type: AllFuncType, Exprs: sqlDollar[4].union.exprs()}
+			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Type: AllFuncType, Exprs: sqlDollar[4].union.exprs(), OrderBy: sqlDollar[5].union.orderBy()}
 		}
-	case 815:
+	case 819:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4615
+//line sql.y:4732
 		{
-			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Type: DistinctFuncType, Exprs: sqlDollar[4].union.exprs()}
+			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Type: DistinctFuncType, Exprs: sqlDollar[4].union.exprs(), OrderBy: sqlDollar[5].union.orderBy()}
 		}
-	case 816:
+	case 820:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4619
+//line sql.y:4736
 		{
 			sqlVAL.union.val = &FuncExpr{Func: sqlDollar[1].union.resolvableFunctionReference(), Exprs: Exprs{StarExpr()}}
 		}
-	case 817:
+	case 821:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4632
+//line sql.y:4749
 		{
 			f := sqlDollar[1].union.expr().(*FuncExpr)
 			f.Filter = sqlDollar[3].union.expr()
 			f.WindowDef = sqlDollar[4].union.windowDef()
 			sqlVAL.union.val = f
 		}
-	case 818:
+	case 822:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4639
+//line sql.y:4756
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 819:
+	case 823:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4648
+//line sql.y:4765
 		{
 			return unimplemented(sqllex, "func_application")
 		}
-	case 820:
+	case 824:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4649
+//line sql.y:4766
 		{
 			return unimplemented(sqllex, "func_expr_common_subexpr")
 		}
-	case 821:
+	case 825:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4653
+//line sql.y:4770
 		{
 			return unimplemented(sqllex, "func_expr_common_subexpr collation")
 		}
-	case 822:
+	case 826:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4655
+//line sql.y:4772
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 823:
+	case 827:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4659
+//line sql.y:4776
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 824:
+	case 828:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4663
+//line sql.y:4780
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 825:
+	case 829:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4667
+//line sql.y:4784
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 826:
+	case 830:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4671
+//line sql.y:4788
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 827:
+	case 831:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4675
+//line sql.y:4792
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
 		}
-	case 828:
+	case 832:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+//line sql.y:4796
+		{
+			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
+		}
+	case 833:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+//line sql.y:4800
+		{
+			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str)}
+		}
+	case 834:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4678
+//line sql.y:4803
 		{
 			return unimplemented(sqllex, "current role")
 		}
-	case 829:
+	case 835:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4679
+//line sql.y:4804
 		{
 			return unimplemented(sqllex, "current user")
 		}
-	case 830:
+	case 836:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4680
+//line sql.y:4805
 		{
 			return unimplemented(sqllex, "session user")
 		}
-	case 831:
+	case 837:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4681
+//line sql.y:4806
 		{
 			return unimplemented(sqllex, "user")
 		}
-	case 832:
+	case 838:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4683
+//line sql.y:4808
 		{
 			sqlVAL.union.val = &CastExpr{Expr: sqlDollar[3].union.expr(), Type: sqlDollar[5].union.castTargetType(), syntaxMode: castExplicit}
 		}
-	case 833:
+	case 839:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4687
+//line sql.y:4812
 		{
 			sqlVAL.union.val = &AnnotateTypeExpr{Expr: sqlDollar[3].union.expr(), Type: sqlDollar[5].union.colType(), syntaxMode: annotateExplicit}
 		}
-	case 834:
+	case 840:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4691
+//line sql.y:4816
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 835:
+	case 841:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4695
+//line sql.y:4820
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 836:
+	case 842:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4699
+//line sql.y:4824
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 837:
+	case 843:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4703
+//line sql.y:4828
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("STRPOS"), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 838:
+	case 844:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4707
+//line sql.y:4832
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 839:
+	case 845:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4710
+//line sql.y:4835
 		{
 			return unimplemented(sqllex, "treat")
 		}
-	case 840:
+	case 846:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4712
+//line sql.y:4837
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("BTRIM"), Exprs: sqlDollar[4].union.exprs()}
 		}
-	case 841:
+	case 847:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4716
+//line sql.y:4841
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("LTRIM"), Exprs: sqlDollar[4].union.exprs()}
 		}
-	case 842:
+	case 848:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4720
+//line sql.y:4845
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("RTRIM"), Exprs: sqlDollar[4].union.exprs()}
 		}
-	case 843:
+	case 849:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4724
+//line sql.y:4849
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction("BTRIM"), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 844:
+	case 850:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:4728
+//line sql.y:4853
 		{
 			sqlVAL.union.val = &IfExpr{Cond: sqlDollar[3].union.expr(), True: sqlDollar[5].union.expr(), Else: sqlDollar[7].union.expr()}
 		}
-	case 845:
+	case 851:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4732
+//line sql.y:4857
 		{
 			sqlVAL.union.val = &NullIfExpr{Expr1: sqlDollar[3].union.expr(), Expr2: sqlDollar[5].union.expr()}
 		}
-	case 846:
+	case 852:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4736
+//line sql.y:4861
 		{
 			sqlVAL.union.val = &CoalesceExpr{Name: "IFNULL", Exprs: Exprs{sqlDollar[3].union.expr(), sqlDollar[5].union.expr()}}
 		}
-	case 847:
+	case 853:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4740
+//line sql.y:4865
 		{
 			sqlVAL.union.val = &CoalesceExpr{Name: "COALESCE", Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 848:
+	case 854:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4744
+//line sql.y:4869
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 849:
+	case 855:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4748
+//line sql.y:4873
 		{
 			sqlVAL.union.val = &FuncExpr{Func: wrapFunction(sqlDollar[1].str), Exprs: sqlDollar[3].union.exprs()}
 		}
-	case 850:
+	case 856:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4754
+//line sql.y:4879
 		{
 			return unimplemented(sqllex, "within group")
 		}
-	case 851:
+	case 857:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4755
+//line sql.y:4880
 		{
 		}
-	case 852:
+	case 858:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4759
+//line sql.y:4884
 		{
 			sqlVAL.union.val = sqlDollar[4].union.expr()
 		}
-	case 853:
+	case 859:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4763
+//line sql.y:4888
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 854:
+	case 860:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4770
+//line sql.y:4895
 		{
 			sqlVAL.union.val = sqlDollar[2].union.window()
 		}
-	case 855:
+	case 861:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4774
+//line sql.y:4899
 		{
 			sqlVAL.union.val = Window(nil)
 		}
-	case 856:
+	case 862:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4780
+//line sql.y:4905
 		{
 			sqlVAL.union.val = Window{sqlDollar[1].union.windowDef()}
 		}
-	case 857:
+	case 863:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4784
+//line sql.y:4909
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.window(), sqlDollar[3].union.windowDef())
 		}
-	case 858:
+	case 864:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4790
+//line sql.y:4915
 		{
 			n := sqlDollar[3].union.windowDef()
 			n.Name = Name(sqlDollar[1].str)
 			sqlVAL.union.val = n
 		}
-	case 859:
+	case 865:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4798
+//line sql.y:4923
 		{
 			sqlVAL.union.val = sqlDollar[2].union.windowDef()
 		}
-	case 860:
+	case 866:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4802
+//line sql.y:4927
 		{
 			sqlVAL.union.val = &WindowDef{Name: Name(sqlDollar[2].str)}
 		}
-	case 861:
+	case 867:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4806
+//line sql.y:4931
 		{
 			sqlVAL.union.val = (*WindowDef)(nil)
 		}
-	case 862:
+	case 868:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:4813
+//line sql.y:4938
 		{
 			sqlVAL.union.val = &WindowDef{
 				RefName:    Name(sqlDollar[2].str),
 				Partitions: sqlDollar[3].union.exprs(),
 				OrderBy:    sqlDollar[4].union.orderBy(),
+				Frame:      sqlDollar[5].union.frameSpec(),
 			}
 		}
-	case 864:
+	case 870:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4832
+//line sql.y:4958
 		{
 			sqlVAL.str = ""
 		}
-	case 865:
+	case 871:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4838
+//line sql.y:4964
 		{
 			sqlVAL.union.val = sqlDollar[3].union.exprs()
 		}
-	case 866:
+	case 872:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4842
+//line sql.y:4968
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
-	case 867:
+	case 873:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4852
+//line sql.y:4976
 		{
-			return unimplemented(sqllex, "frame range")
+			sqlVAL.union.val = &FrameSpec{Mode: RangeMode, Bounds: sqlDollar[2].union.frameBounds()}
 		}
-	case 868:
+	case 874:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4853
+//line sql.y:4980
 		{
-			return unimplemented(sqllex, "frame rows")
+			sqlVAL.union.val = &FrameSpec{Mode: RowsMode, Bounds: sqlDollar[2].union.frameBounds()}
 		}
-	case 869:
+	case 875:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:4854
+//line sql.y:4984
 		{
+			sqlVAL.union.val = (*FrameSpec)(nil)
 		}
-	case 870:
+	case 876:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4857
+//line sql.y:4990
 		{
-			return unimplemented(sqllex, "frame_extent")
+			startBound := sqlDollar[1].union.frameBound()
+			switch startBound.BoundType {
+			case UnboundedFollowing:
+				sqllex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case OffsetFollowing:
+				sqllex.Error("frame starting from following row cannot end with current row")
+				return 1
+			}
+			sqlVAL.union.val = FrameBounds{StartBound: startBound}
 		}
-	case 871:
+	case 877:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4858
+//line sql.y:5003
 		{
-			return unimplemented(sqllex, "frame_extent")
+			startBound := sqlDollar[2].union.frameBound()
+			endBound := sqlDollar[4].union.frameBound()
+			if startBound.BoundType == UnboundedFollowing {
+				sqllex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			}
+			if endBound.BoundType == UnboundedPreceding {
+				sqllex.Error("frame end cannot be UNBOUNDED PRECEDING")
+				return 1
+			}
+			sqlVAL.union.val = FrameBounds{StartBound: startBound, EndBound: endBound}
 		}
-	case 872:
+	case 878:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4864
+//line sql.y:5022
 		{
-			return unimplemented(sqllex, "frame_bound")
+			sqlVAL.union.val = &FrameBound{BoundType: UnboundedPreceding}
 		}
-	case 873:
+	case 879:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4865
+//line sql.y:5026
 		{
-			return unimplemented(sqllex, "frame_bound")
+			sqlVAL.union.val = &FrameBound{BoundType: UnboundedFollowing}
 		}
-	case 874:
+	case 880:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4866
+//line sql.y:5030
 		{
-			return unimplemented(sqllex, "frame_bound")
+			sqlVAL.union.val = &FrameBound{BoundType: CurrentRow}
 		}
-	case 875:
+	case 881:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4867
+//line sql.y:5034
 		{
-			return unimplemented(sqllex, "frame_bound")
+			sqlVAL.union.val = &FrameBound{BoundType: OffsetPreceding, OffsetExpr: sqlDollar[1].union.expr()}
 		}
-	case 876:
+	case 882:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4868
+//line sql.y:5038
 		{
-			return unimplemented(sqllex, "frame_bound")
+			sqlVAL.union.val = &FrameBound{BoundType: OffsetFollowing, OffsetExpr: sqlDollar[1].union.expr()}
 		}
-	case 877:
+	case 883:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4879
+//line sql.y:5051
 		{
 			sqlVAL.union.val = &Tuple{Exprs: sqlDollar[3].union.exprs(), row: true}
 		}
-	case 878:
+	case 884:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4883
+//line sql.y:5055
 		{
 			sqlVAL.union.val = &Tuple{Exprs: nil, row: true}
 		}
-	case 879:
+	case 885:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4887
+//line sql.y:5059
 		{
 			sqlVAL.union.val = &Tuple{Exprs: append(sqlDollar[2].union.exprs(), sqlDollar[4].union.expr())}
 		}
-	case 880:
+	case 886:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:4893
+//line sql.y:5065
 		{
 			sqlVAL.union.val = &Tuple{Exprs: sqlDollar[3].union.exprs(), row: true}
 		}
-	case 881:
+	case 887:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4897
+//line sql.y:5069
 		{
 			sqlVAL.union.val = &Tuple{Exprs: nil, row: true}
 		}
-	case 882:
+	case 888:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:4903
+//line sql.y:5075
 		{
 			sqlVAL.union.val = &Tuple{Exprs: append(sqlDollar[2].union.exprs(), sqlDollar[4].union.expr())}
 		}
-	case 883:
+	case 889:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4909
+//line sql.y:5081
 		{
 			sqlVAL.union.val = Any
 		}
-	case 884:
+	case 890:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4913
+//line sql.y:5085
 		{
 			sqlVAL.union.val = Some
 		}
-	case 885:
+	case 891:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4917
+//line sql.y:5089
 		{
 			sqlVAL.union.val = All
 		}
-	case 886:
+	case 892:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4922
+//line sql.y:5094
 		{
 			sqlVAL.union.val = Plus
 		}
-	case 887:
+	case 893:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4923
+//line sql.y:5095
 		{
 			sqlVAL.union.val = Minus
 		}
-	case 888:
+	case 894:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4924
+//line sql.y:5096
 		{
 			sqlVAL.union.val = Mult
 		}
-	case 889:
+	case 895:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4925
+//line sql.y:5097
 		{
 			sqlVAL.union.val = Div
 		}
-	case 890:
+	case 896:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4926
+//line sql.y:5098
 		{
 			sqlVAL.union.val = FloorDiv
 		}
-	case 891:
+	case 897:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4927
+//line sql.y:5099
 		{
 			sqlVAL.union.val = Mod
 		}
-	case 892:
+	case 898:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4928
+//line sql.y:5100
 		{
 			sqlVAL.union.val = Bitand
 		}
-	case 893:
+	case 899:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4929
+//line sql.y:5101
 		{
 			sqlVAL.union.val = Bitor
 		}
-	case 894:
+	case 900:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4930
+//line sql.y:5102
 		{
 			sqlVAL.union.val = Pow
 		}
-	case 895:
+	case 901:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4931
+//line sql.y:5103
 		{
 			sqlVAL.union.val = Bitxor
 		}
-	case 896:
+	case 902:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4932
+//line sql.y:5104
 		{
 			sqlVAL.union.val = LT
 		}
-	case 897:
+	case 903:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4933
+//line sql.y:5105
 		{
 			sqlVAL.union.val = GT
 		}
-	case 898:
+	case 904:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4934
+//line sql.y:5106
 		{
 			sqlVAL.union.val = EQ
 		}
-	case 899:
+	case 905:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4935
+//line sql.y:5107
 		{
 			sqlVAL.union.val = LE
 		}
-	case 900:
+	case 906:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4936
+//line sql.y:5108
 		{
 			sqlVAL.union.val = GE
 		}
-	case 901:
+	case 907:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4937
+//line sql.y:5109
 		{
 			sqlVAL.union.val = NE
 		}
-	case 903:
+	case 909:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4941
+//line sql.y:5113
 		{
 			sqlVAL.union.val = Like
 		}
-	case 904:
+	case 910:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4942
+//line sql.y:5114
 		{
 			sqlVAL.union.val = NotLike
 		}
-	case 905:
+	case 911:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4943
+//line sql.y:5115
 		{
 			sqlVAL.union.val = ILike
 		}
-	case 906:
+	case 912:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4944
+//line sql.y:5116
 		{
 			sqlVAL.union.val = NotILike
 		}
-	case 907:
+	case 913:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4955
+//line sql.y:5127
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr()}
 		}
-	case 908:
+	case 914:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4959
+//line sql.y:5131
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.exprs(), sqlDollar[3].union.expr())
 		}
-	case 909:
+	case 915:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4965
+//line sql.y:5137
 		{
 			sqlVAL.union.val = []ColumnType{sqlDollar[1].union.colType()}
 		}
-	case 910:
+	case 916:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4969
+//line sql.y:5141
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.colTypes(), sqlDollar[3].union.colType())
 		}
-	case 911:
+	case 917:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4975
+//line sql.y:5147
 		{
 			sqlVAL.union.val = &Array{Exprs: sqlDollar[2].union.exprs()}
 		}
-	case 912:
+	case 918:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4979
+//line sql.y:5151
 		{
 			sqlVAL.union.val = &Array{Exprs: sqlDollar[2].union.exprs()}
 		}
-	case 913:
+	case 919:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:4983
+//line sql.y:5155
 		{
 			sqlVAL.union.val = &Array{Exprs: nil}
 		}
-	case 914:
+	case 920:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:4989
+//line sql.y:5161
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr()}
 		}
-	case 915:
+	case 921:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4993
+//line sql.y:5165
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.exprs(), sqlDollar[3].union.expr())
 		}
-	case 916:
+	case 922:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:4999
+//line sql.y:5171
 		{
 			sqlVAL.union.val = Exprs{&StrVal{s: sqlDollar[1].str}, sqlDollar[3].union.expr()}
 		}
-	case 917:
+	case 923:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5003
+//line sql.y:5175
 		{
 			sqlVAL.union.val = sqlDollar[1].union.exprs()
 		}
-	case 925:
+	case 931:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:5025
+//line sql.y:5197
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), sqlDollar[2].union.expr(), sqlDollar[3].union.expr(), sqlDollar[4].union.expr()}
 		}
-	case 926:
+	case 932:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5029
+//line sql.y:5201
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), sqlDollar[2].union.expr(), sqlDollar[3].union.expr()}
 		}
-	case 927:
+	case 933:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5033
+//line sql.y:5205
 		{
 			sqlVAL.union.val = sqlDollar[1].union.exprs()
 		}
-	case 928:
+	case 934:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5039
+//line sql.y:5211
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 929:
+	case 935:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5046
+//line sql.y:5218
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[3].union.expr(), sqlDollar[1].union.expr()}
 		}
-	case 930:
+	case 936:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5050
+//line sql.y:5222
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
-	case 931:
+	case 937:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5067
+//line sql.y:5239
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), sqlDollar[2].union.expr(), sqlDollar[3].union.expr()}
 		}
-	case 932:
+	case 938:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5071
+//line sql.y:5243
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), sqlDollar[3].union.expr(), sqlDollar[2].union.expr()}
 		}
-	case 933:
+	case 939:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5075
+//line sql.y:5247
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), sqlDollar[2].union.expr()}
 		}
-	case 934:
+	case 940:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5079
+//line sql.y:5251
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr(), NewDInt(1), sqlDollar[2].union.expr()}
 		}
-	case 935:
+	case 941:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5083
+//line sql.y:5255
 		{
 			sqlVAL.union.val = sqlDollar[1].union.exprs()
 		}
-	case 936:
+	case 942:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5087
+//line sql.y:5259
 		{
 			sqlVAL.union.val = Exprs(nil)
 		}
-	case 937:
+	case 943:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5093
+//line sql.y:5265
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 938:
+	case 944:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5099
+//line sql.y:5271
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 939:
+	case 945:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5105
+//line sql.y:5277
 		{
 			sqlVAL.union.val = append(sqlDollar[3].union.exprs(), sqlDollar[1].union.expr())
 		}
-	case 940:
+	case 946:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5109
+//line sql.y:5281
 		{
 			sqlVAL.union.val = sqlDollar[2].union.exprs()
 		}
-	case 941:
+	case 947:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5113
+//line sql.y:5285
 		{
 			sqlVAL.union.val = sqlDollar[1].union.exprs()
 		}
-	case 942:
+	case 948:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5119
+//line sql.y:5291
 		{
 			sqlVAL.union.val = &Subquery{Select: sqlDollar[1].union.selectStmt()}
 		}
-	case 943:
+	case 949:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5123
+//line sql.y:5295
 		{
 			sqlVAL.union.val = &Tuple{Exprs: sqlDollar[2].union.exprs()}
 		}
-	case 944:
+	case 950:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:5134
+//line sql.y:5306
 		{
 			sqlVAL.union.val = &CaseExpr{Expr: sqlDollar[2].union.expr(), Whens: sqlDollar[3].union.whens(), Else: sqlDollar[4].union.expr()}
 		}
-	case 945:
+	case 951:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5141
+//line sql.y:5313
 		{
 			sqlVAL.union.val = []*When{sqlDollar[1].union.when()}
 		}
-	case 946:
+	case 952:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5145
+//line sql.y:5317
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.whens(), sqlDollar[2].union.when())
 		}
-	case 947:
+	case 953:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:5151
+//line sql.y:5323
 		{
 			sqlVAL.union.val = &When{Cond: sqlDollar[2].union.expr(), Val: sqlDollar[4].union.expr()}
 		}
-	case 948:
+	case 954:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5157
+//line sql.y:5329
 		{
 			sqlVAL.union.val = sqlDollar[2].union.expr()
 		}
-	case 949:
+	case 955:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5161
+//line sql.y:5333
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 951:
+	case 957:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5168
+//line sql.y:5340
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 952:
+	case 958:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5174
+//line sql.y:5346
 		{
 			sqlVAL.union.val = &ArraySubscript{Begin: sqlDollar[2].union.expr()}
 		}
-	case 953:
+	case 959:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:5178
+//line sql.y:5350
 		{
 			sqlVAL.union.val = &ArraySubscript{Begin: sqlDollar[2].union.expr(), End: sqlDollar[4].union.expr(), Slice: true}
 		}
-	case 955:
+	case 961:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5185
+//line sql.y:5357
 		{
 			sqlVAL.union.val = Expr(nil)
 		}
-	case 956:
+	case 962:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5191
+//line sql.y:5363
 		{
 			sqlVAL.union.val = Name(sqlDollar[2].str)
 		}
-	case 957:
+	case 963:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5197
+//line sql.y:5369
 		{
 			sqlVAL.union.val = UnqualifiedStar{}
 		}
-	case 958:
+	case 964:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5203
+//line sql.y:5375
 		{
 			sqlVAL.union.val = sqlDollar[1].union.namePart()
 		}
-	case 959:
+	case 965:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5207
+//line sql.y:5379
 		{
 			sqlVAL.union.val = sqlDollar[1].union.namePart()
 		}
-	case 960:
+	case 966:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5213
+//line sql.y:5385
 		{
 			sqlVAL.union.val = UnresolvedName{sqlDollar[1].union.namePart()}
 		}
-	case 961:
+	case 967:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5217
+//line sql.y:5389
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.unresolvedName(), sqlDollar[2].union.namePart())
 		}
-	case 962:
+	case 968:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5223
+//line sql.y:5395
 		{
 			sqlVAL.union.val = ArraySubscripts{sqlDollar[1].union.arraySubscript()}
 		}
-	case 963:
+	case 969:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5227
+//line sql.y:5399
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.arraySubscripts(), sqlDollar[2].union.arraySubscript())
 		}
-	case 964:
+	case 970:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5232
+//line sql.y:5404
 		{
 		}
-	case 965:
+	case 971:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5233
+//line sql.y:5405
 		{
 		}
-	case 967:
+	case 973:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5242
+//line sql.y:5414
 		{
 			sqlVAL.union.val = DefaultVal{}
 		}
-	case 968:
+	case 974:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5248
+//line sql.y:5420
 		{
 			sqlVAL.union.val = Exprs{sqlDollar[1].union.expr()}
 		}
-	case 969:
+	case 975:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5252
+//line sql.y:5424
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.exprs(), sqlDollar[3].union.expr())
 		}
-	case 970:
+	case 976:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5261
+//line sql.y:5433
 		{
 			sqlVAL.union.val = sqlDollar[2].union.exprs()
 		}
-	case 971:
+	case 977:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5267
+//line sql.y:5439
 		{
 			sqlVAL.union.val = SelectExprs{sqlDollar[1].union.selExpr()}
 		}
-	case 972:
+	case 978:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5271
+//line sql.y:5443
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.selExprs(), sqlDollar[3].union.selExpr())
 		}
-	case 973:
+	case 979:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5277
+//line sql.y:5449
 		{
 			sqlVAL.union.val = SelectExpr{Expr: sqlDollar[1].union.expr(), As: Name(sqlDollar[3].str)}
 		}
-	case 974:
+	case 980:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5286
+//line sql.y:5458
 		{
 			sqlVAL.union.val = SelectExpr{Expr: sqlDollar[1].union.expr(), As: Name(sqlDollar[2].str)}
 		}
-	case 975:
+	case 981:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5290
+//line sql.y:5462
 		{
 			sqlVAL.union.val = SelectExpr{Expr: sqlDollar[1].union.expr()}
 		}
-	case 976:
+	case 982:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5294
+//line sql.y:5466
 		{
 			sqlVAL.union.val = starSelectExpr()
 		}
-	case 977:
+	case 983:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5302
+//line sql.y:5474
 		{
 			sqlVAL.union.val = UnresolvedNames{sqlDollar[1].union.unresolvedName()}
 		}
-	case 978:
+	case 984:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5306
+//line sql.y:5478
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.unresolvedNames(), sqlDollar[3].union.unresolvedName())
 		}
-	case 979:
+	case 985:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5312
+//line sql.y:5484
 		{
 			sqlVAL.union.val = TableNameWithIndexList{sqlDollar[1].union.tableWithIdx()}
 		}
-	case 980:
+	case 986:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5316
+//line sql.y:5488
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tableWithIdxList(), sqlDollar[3].union.tableWithIdx())
 		}
-	case 981:
+	case 987:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5322
+//line sql.y:5494
 		{
 			sqlVAL.union.val = TablePatterns{sqlDollar[1].union.unresolvedName()}
 		}
-	case 982:
+	case 988:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5326
+//line sql.y:5498
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.tablePatterns(), sqlDollar[3].union.unresolvedName())
 		}
-	case 983:
+	case 989:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5337
+//line sql.y:5509
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str)}
 		}
-	case 984:
+	case 990:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5341
+//line sql.y:5513
 		{
 			sqlVAL.union.val = append(UnresolvedName{Name(sqlDollar[1].str)}, sqlDollar[2].union.unresolvedName()...)
 		}
-	case 985:
+	case 991:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5347
+//line sql.y:5519
 		{
 			sqlVAL.union.val = &TableNameWithIndex{Table: sqlDollar[1].union.normalizableTableName(), Index: Name(sqlDollar[3].str)}
 		}
-	case 986:
+	case 992:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5351
+//line sql.y:5523
 		{
 			// This case allows specifying just an index name (potentially schema-qualified).
 			// We temporarily store the index name in Table (see TableNameWithIndex).
 			sqlVAL.union.val = &TableNameWithIndex{Table: sqlDollar[1].union.normalizableTableName(), SearchTable: true}
 		}
-	case 987:
+	case 993:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5364
+//line sql.y:5536
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str)}
 		}
-	case 988:
+	case 994:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5368
+//line sql.y:5540
 		{
 			sqlVAL.union.val = UnresolvedName{UnqualifiedStar{}}
 		}
-	case 989:
+	case 995:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5372
+//line sql.y:5544
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str), sqlDollar[2].union.namePart()}
 		}
-	case 990:
+	case 996:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5376
+//line sql.y:5548
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str), sqlDollar[2].union.namePart()}
 		}
-	case 991:
+	case 997:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5382
+//line sql.y:5554
 		{
 			sqlVAL.union.val = NameList{Name(sqlDollar[1].str)}
 		}
-	case 992:
+	case 998:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5386
+//line sql.y:5558
 		{
 			sqlVAL.union.val = append(sqlDollar[1].union.nameList(), Name(sqlDollar[3].str))
 		}
-	case 993:
+	case 999:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5392
+//line sql.y:5564
 		{
 			sqlVAL.union.val = sqlDollar[2].union.nameList()
 		}
-	case 994:
+	case 1000:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5395
+//line sql.y:5568
 		{
+			sqlVAL.union.val = NameList(nil)
 		}
-	case 995:
+	case 1001:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5405
+//line sql.y:5580
 		{
 			sqlVAL.union.val = UnresolvedName{Name(sqlDollar[1].str)}
 		}
-	case 996:
+	case 1002:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5409
+//line sql.y:5584
 		{
 			sqlVAL.union.val = append(UnresolvedName{Name(sqlDollar[1].str)}, sqlDollar[2].union.unresolvedName()...)
 		}
-	case 997:
+	case 1003:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5416
+//line sql.y:5591
 		{
 			sqlVAL.union.val = sqlDollar[1].union.numVal()
 		}
-	case 998:
+	case 1004:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5420
+//line sql.y:5595
 		{
 			sqlVAL.union.val = sqlDollar[1].union.numVal()
 		}
-	case 999:
+	case 1005:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5424
+//line sql.y:5599
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str}
 		}
-	case 1000:
+	case 1006:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5428
+//line sql.y:5603
 		{
 			sqlVAL.union.val = &StrVal{s: sqlDollar[1].str, bytesEsc: true}
 		}
-	case 1001:
+	case 1007:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:5431
+//line sql.y:5606
 		{
 			return unimplemented(sqllex, "func const")
 		}
-	case 1002:
+	case 1008:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5433
+//line sql.y:5608
 		{
 			sqlVAL.union.val = &CastExpr{Expr: &StrVal{s: sqlDollar[2].str}, Type: sqlDollar[1].union.colType(), syntaxMode: castPrepend}
 		}
-	case 1003:
+	case 1009:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5437
+//line sql.y:5612
 		{
 			sqlVAL.union.val = sqlDollar[1].union.expr()
 		}
-	case 1004:
+	case 1010:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:5440
+//line sql.y:5615
 		{
 			return unimplemented(sqllex, "expr_const const_interval")
 		}
-	case 1005:
+	case 1011:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5442
+//line sql.y:5617
 		{
 			sqlVAL.union.val = MakeDBool(true)
 		}
-	case 1006:
+	case 1012:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5446
+//line sql.y:5621
 		{
 			sqlVAL.union.val = MakeDBool(false)
 		}
-	case 1007:
+	case 1013:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:5450
+//line sql.y:5625
 		{
 			sqlVAL.union.val = DNull
 		}
-	case 1009:
+	case 1015:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5457
+//line sql.y:5632
 		{
 			sqlVAL.union.val = sqlDollar[2].union.numVal()
 		}
-	case 1010:
+	case 1016:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:5461
+//line sql.y:5636
 		{
 			sqlVAL.union.val = &NumVal{Value: constant.UnaryOp(token.SUB, sqlDollar[2].union.numVal().Value, 0)}
 		}
-	case 1011:
+	case 1017:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5467
+//line sql.y:5642
 		{
 			// We don't carry opt_interval information into the column type, so we need
 			// to parse the interval directly.
@@ -11569,21 +11719,21 @@ sqldefault:
 			}
 			sqlVAL.union.val = d
 		}
-	case 1016:
+	case 1022:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5502
+//line sql.y:5677
 		{
 			sqlVAL.str = ""
 		}
-	case 1017:
+	case 1023:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:5508
+//line sql.y:5683
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 1018:
+	case 1024:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:5512
+//line sql.y:5687
 		{
 			sqlVAL.str = ""
 		}
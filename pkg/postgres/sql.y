@@ -327,6 +327,18 @@ func (u *sqlSymUnion) windowDef() *WindowDef {
 func (u *sqlSymUnion) window() Window {
     return u.val.(Window)
 }
+func (u *sqlSymUnion) distinctOn() DistinctOn {
+    return u.val.(DistinctOn)
+}
+func (u *sqlSymUnion) frameSpec() *FrameSpec {
+    return u.val.(*FrameSpec)
+}
+func (u *sqlSymUnion) frameBounds() FrameBounds {
+    return u.val.(FrameBounds)
+}
+func (u *sqlSymUnion) frameBound() *FrameBound {
+    return u.val.(*FrameBound)
+}
 func (u *sqlSymUnion) op() operator {
     return u.val.(operator)
 }
@@ -348,6 +360,15 @@ func (u *sqlSymUnion) kvOptions() []KVOption {
 func (u *sqlSymUnion) transactionModes() TransactionModes {
     return u.val.(TransactionModes)
 }
+func (u *sqlSymUnion) with() *With {
+    return u.val.(*With)
+}
+func (u *sqlSymUnion) cte() *CTE {
+    return u.val.(*CTE)
+}
+func (u *sqlSymUnion) ctes() []*CTE {
+    return u.val.([]*CTE)
+}
 
 %}
 
@@ -635,14 +656,15 @@ func (u *sqlSymUnion) transactionModes() TransactionModes {
 %type <*InterleaveDef> opt_interleave
 %type <empty> opt_all_clause
 %type <bool> distinct_clause
+%type <DistinctOn> distinct_on_clause
 %type <NameList> opt_column_list
 %type <OrderBy> sort_clause opt_sort_clause
 %type <[]*Order> sortby_list
 %type <IndexElemList> index_params
 %type <NameList> name_list opt_name_list
 %type <Exprs> opt_array_bounds
-%type <*From> from_clause update_from_clause
-%type <TableExprs> from_list
+%type <*From> from_clause
+%type <TableExprs> from_list update_from_clause opt_using_clause
 %type <UnresolvedNames> qualified_name_list
 %type <TablePatterns> table_pattern_list
 %type <UnresolvedName> any_name
@@ -686,7 +708,7 @@ func (u *sqlSymUnion) transactionModes() TransactionModes {
 %type <empty> first_or_next
 
 %type <Statement>  insert_rest
-%type <NameList> opt_conf_expr
+%type <*OnConflict> opt_conf_expr
 %type <*OnConflict> on_conflict
 
 %type <Statement>  begin_transaction
@@ -771,9 +793,10 @@ func (u *sqlSymUnion) transactionModes() TransactionModes {
 
 %type <Expr>  func_application func_expr_common_subexpr
 %type <Expr>  func_expr func_expr_windowless
-%type <empty> common_table_expr
-%type <empty> with_clause opt_with opt_with_clause
-%type <empty> cte_list
+%type <*CTE> common_table_expr
+%type <*With> with_clause
+%type <empty> opt_with opt_with_clause
+%type <[]*CTE> cte_list
 
 %type <empty> within_group_clause
 %type <Expr> filter_clause
@@ -781,7 +804,9 @@ func (u *sqlSymUnion) transactionModes() TransactionModes {
 %type <Window> window_clause window_definition_list
 %type <*WindowDef> window_definition over_clause window_specification
 %type <str> opt_existing_window_name
-%type <empty> opt_frame_clause frame_extent frame_bound
+%type <*FrameSpec> opt_frame_clause
+%type <FrameBounds> frame_extent
+%type <*FrameBound> frame_bound
 
 %type <[]ColumnID> opt_tableref_col_list tableref_col_list
 
@@ -1266,9 +1291,19 @@ create_stmt:
 
 // DELETE FROM query
 delete_stmt:
-  opt_with_clause DELETE FROM relation_expr_opt_alias where_clause returning_clause
+  opt_with_clause DELETE FROM relation_expr_opt_alias opt_using_clause where_clause returning_clause
+  {
+    $$.val = &Delete{Table: $4.tblExpr(), Using: $5.tblExprs(), Where: newWhere(astWhere, $6.expr()), Returning: $7.retClause()}
+  }
+
+opt_using_clause:
+  USING from_list
+  {
+    $$.val = $2.tblExprs()
+  }
+| /* EMPTY */
   {
-    $$.val = &Delete{Table: $4.tblExpr(), Where: newWhere(astWhere, $5.expr()), Returning: $6.retClause()}
+    $$.val = TableExprs(nil)
   }
 
 // DISCARD [ ALL | PLANS | SEQUENCES | TEMP | TEMPORARY ]
@@ -2833,23 +2868,31 @@ insert_rest:
 on_conflict:
   ON CONFLICT opt_conf_expr DO UPDATE SET set_clause_list where_clause
   {
-    $$.val = &OnConflict{Columns: $3.nameList(), Exprs: $7.updateExprs(), Where: newWhere(astWhere, $8.expr())}
+    oc := $3.onConflict()
+    oc.Exprs = $7.updateExprs()
+    oc.Where = newWhere(astWhere, $8.expr())
+    $$.val = oc
   }
 | ON CONFLICT opt_conf_expr DO NOTHING
   {
-    $$.val = &OnConflict{Columns: $3.nameList(), DoNothing: true}
+    oc := $3.onConflict()
+    oc.DoNothing = true
+    $$.val = oc
   }
 
 opt_conf_expr:
   '(' name_list ')' where_clause
   {
     // TODO(dan): Support the where_clause.
-    $$.val = $2.nameList()
+    $$.val = &OnConflict{Columns: $2.nameList()}
+  }
+| ON CONSTRAINT name
+  {
+    $$.val = &OnConflict{Constraint: Name($3)}
   }
-| ON CONSTRAINT name { return unimplemented(sqllex, "on conflict on constraint") }
 | /* EMPTY */
   {
-    $$.val = NameList(nil)
+    $$.val = &OnConflict{}
   }
 
 returning_clause:
@@ -2871,13 +2914,18 @@ update_stmt:
   opt_with_clause UPDATE relation_expr_opt_alias
     SET set_clause_list update_from_clause where_clause returning_clause
   {
-    $$.val = &Update{Table: $3.tblExpr(), Exprs: $5.updateExprs(), Where: newWhere(astWhere, $7.expr()), Returning: $8.retClause()}
+    $$.val = &Update{Table: $3.tblExpr(), Exprs: $5.updateExprs(), From: $6.tblExprs(), Where: newWhere(astWhere, $7.expr()), Returning: $8.retClause()}
   }
 
-// Mark this as unimplemented until the normal from_clause is supported here.
 update_from_clause:
-  FROM from_list { return unimplementedWithIssue(sqllex, 7841) }
-| /* EMPTY */ {}
+  FROM from_list
+  {
+    $$.val = $2.tblExprs()
+  }
+| /* EMPTY */
+  {
+    $$.val = TableExprs(nil)
+  }
 
 set_clause_list:
   set_clause
@@ -2993,15 +3041,15 @@ select_no_parens:
   }
 | with_clause select_clause
   {
-    $$.val = &Select{Select: $2.selectStmt()}
+    $$.val = &Select{With: $1.with(), Select: $2.selectStmt()}
   }
 | with_clause select_clause sort_clause
   {
-    $$.val = &Select{Select: $2.selectStmt(), OrderBy: $3.orderBy()}
+    $$.val = &Select{With: $1.with(), Select: $2.selectStmt(), OrderBy: $3.orderBy()}
   }
 | with_clause select_clause opt_sort_clause select_limit
   {
-    $$.val = &Select{Select: $2.selectStmt(), OrderBy: $3.orderBy(), Limit: $4.limit()}
+    $$.val = &Select{With: $1.with(), Select: $2.selectStmt(), OrderBy: $3.orderBy(), Limit: $4.limit()}
   }
 
 select_clause:
@@ -3058,6 +3106,21 @@ simple_select:
       Window:   $8.window(),
     }
   }
+| SELECT distinct_on_clause target_list
+    from_clause where_clause
+    group_clause having_clause window_clause
+  {
+    $$.val = &SelectClause{
+      Distinct:   true,
+      DistinctOn: $2.distinctOn(),
+      Exprs:      $3.selExprs(),
+      From:       $4.from(),
+      Where:      newWhere(astWhere, $5.expr()),
+      GroupBy:    $6.groupBy(),
+      Having:     newWhere(astHaving, $7.expr()),
+      Window:     $8.window(),
+    }
+  }
 | values_clause
 | table_clause
 | select_clause UNION all_or_distinct select_clause
@@ -3107,16 +3170,37 @@ table_clause:
 //
 // Recognizing WITH_LA here allows a CTE to be named TIME or ORDINALITY.
 with_clause:
-  WITH cte_list { return unimplemented(sqllex, "with cte_list") }
-| WITH_LA cte_list { return unimplemented(sqllex, "with cte_list") }
-| WITH RECURSIVE cte_list { return unimplemented(sqllex, "with cte_list") }
+  WITH cte_list
+  {
+    $$.val = &With{CTEList: $2.ctes()}
+  }
+| WITH_LA cte_list
+  {
+    $$.val = &With{CTEList: $2.ctes()}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$.val = &With{Recursive: true, CTEList: $3.ctes()}
+  }
 
 cte_list:
-  common_table_expr { return unimplemented(sqllex, "cte_list") }
-| cte_list ',' common_table_expr { return unimplemented(sqllex, "cte_list") }
+  common_table_expr
+  {
+    $$.val = []*CTE{$1.cte()}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$.val = append($1.ctes(), $3.cte())
+  }
 
 common_table_expr:
-  name opt_name_list AS '(' preparable_stmt ')' { return unimplemented(sqllex, "cte") }
+  name opt_name_list AS '(' preparable_stmt ')'
+  {
+    $$.val = &CTE{
+      Name: AliasClause{Alias: Name($1), Cols: $2.nameList()},
+      Stmt: $5.stmt(),
+    }
+  }
 
 opt_with:
   WITH {}
@@ -3150,6 +3234,12 @@ distinct_clause:
     $$.val = true
   }
 
+distinct_on_clause:
+  DISTINCT ON '(' expr_list ')'
+  {
+    $$.val = DistinctOn($4.exprs())
+  }
+
 opt_all_clause:
   ALL {}
 | /* EMPTY */ {}
@@ -4630,17 +4720,17 @@ func_application:
   }
 | func_name '(' expr_list opt_sort_clause ')'
   {
-    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Exprs: $3.exprs()}
+    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Exprs: $3.exprs(), OrderBy: $4.orderBy()}
   }
 | func_name '(' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
 | func_name '(' expr_list ',' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
 | func_name '(' ALL expr_list opt_sort_clause ')'
   {
-    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Type: AllFuncType, Exprs: $4.exprs()}
+    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Type: AllFuncType, Exprs: $4.exprs(), OrderBy: $5.orderBy()}
   }
 | func_name '(' DISTINCT expr_list opt_sort_clause ')'
   {
-    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Type: DistinctFuncType, Exprs: $4.exprs()}
+    $$.val = &FuncExpr{Func: $1.resolvableFunctionReference(), Type: DistinctFuncType, Exprs: $4.exprs(), OrderBy: $5.orderBy()}
   }
 | func_name '(' '*' ')'
   {
@@ -4702,6 +4792,14 @@ func_expr_common_subexpr:
   {
     $$.val = &FuncExpr{Func: wrapFunction($1)}
   }
+| LOCALTIMESTAMP
+  {
+    $$.val = &FuncExpr{Func: wrapFunction($1)}
+  }
+| LOCALTIMESTAMP '(' ')'
+  {
+    $$.val = &FuncExpr{Func: wrapFunction($1)}
+  }
 | CURRENT_ROLE { return unimplemented(sqllex, "current role") }
 | CURRENT_USER { return unimplemented(sqllex, "current user") }
 | SESSION_USER { return unimplemented(sqllex, "session user") }
@@ -4842,6 +4940,7 @@ window_specification:
       RefName: Name($2),
       Partitions: $3.exprs(),
       OrderBy: $4.orderBy(),
+      Frame: $5.frameSpec(),
     }
   }
 
@@ -4870,29 +4969,75 @@ opt_partition_clause:
     $$.val = Exprs(nil)
   }
 
-// For frame clauses, we return a WindowDef, but only some fields are used:
-// frameOptions, startOffset, and endOffset.
-//
 // This is only a subset of the full SQL:2008 frame_clause grammar. We don't
 // support <window frame exclusion> yet.
 opt_frame_clause:
-  RANGE frame_extent { return unimplemented(sqllex, "frame range") }
-| ROWS frame_extent { return unimplemented(sqllex, "frame rows") }
-| /* EMPTY */ {}
+  RANGE frame_extent
+  {
+    $$.val = &FrameSpec{Mode: RangeMode, Bounds: $2.frameBounds()}
+  }
+| ROWS frame_extent
+  {
+    $$.val = &FrameSpec{Mode: RowsMode, Bounds: $2.frameBounds()}
+  }
+| /* EMPTY */
+  {
+    $$.val = (*FrameSpec)(nil)
+  }
 
 frame_extent:
-  frame_bound { return unimplemented(sqllex, "frame_extent") }
-| BETWEEN frame_bound AND frame_bound { return unimplemented(sqllex, "frame_extent") }
+  frame_bound
+  {
+    startBound := $1.frameBound()
+    switch startBound.BoundType {
+    case UnboundedFollowing:
+      sqllex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+      return 1
+    case OffsetFollowing:
+      sqllex.Error("frame starting from following row cannot end with current row")
+      return 1
+    }
+    $$.val = FrameBounds{StartBound: startBound}
+  }
+| BETWEEN frame_bound AND frame_bound
+  {
+    startBound := $2.frameBound()
+    endBound := $4.frameBound()
+    if startBound.BoundType == UnboundedFollowing {
+      sqllex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+      return 1
+    }
+    if endBound.BoundType == UnboundedPreceding {
+      sqllex.Error("frame end cannot be UNBOUNDED PRECEDING")
+      return 1
+    }
+    $$.val = FrameBounds{StartBound: startBound, EndBound: endBound}
+  }
 
 // This is used for both frame start and frame end, with output set up on the
 // assumption it's frame start; the frame_extent productions must reject
 // invalid cases.
 frame_bound:
-  UNBOUNDED PRECEDING { return unimplemented(sqllex, "frame_bound") }
-| UNBOUNDED FOLLOWING { return unimplemented(sqllex, "frame_bound") }
-| CURRENT ROW { return unimplemented(sqllex, "frame_bound") }
-| a_expr PRECEDING { return unimplemented(sqllex, "frame_bound") }
-| a_expr FOLLOWING { return unimplemented(sqllex, "frame_bound") }
+  UNBOUNDED PRECEDING
+  {
+    $$.val = &FrameBound{BoundType: UnboundedPreceding}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$.val = &FrameBound{BoundType: UnboundedFollowing}
+  }
+| CURRENT ROW
+  {
+    $$.val = &FrameBound{BoundType: CurrentRow}
+  }
+| a_expr PRECEDING
+  {
+    $$.val = &FrameBound{BoundType: OffsetPreceding, OffsetExpr: $1.expr()}
+  }
+| a_expr FOLLOWING
+  {
+    $$.val = &FrameBound{BoundType: OffsetFollowing, OffsetExpr: $1.expr()}
+  }
 
 // Supporting nonterminals for expressions.
 
@@ -5419,7 +5564,10 @@ opt_name_list:
   {
     $$.val = $2.nameList()
   }
-| /* EMPTY */ {}
+| /* EMPTY */
+  {
+    $$.val = NameList(nil)
+  }
 
 // The production for a qualified func_name has to exactly match the production
 // for a qualified name, because we cannot tell which we are parsing until
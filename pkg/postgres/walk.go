@@ -204,6 +204,13 @@ func (expr *FuncExpr) CopyNode() *FuncExpr {
 		exprCopy.WindowDef = &windowDefCopy
 	}
 	exprCopy.Exprs = append(Exprs(nil), exprCopy.Exprs...)
+	if len(expr.OrderBy) > 0 {
+		newOrderBy := make(OrderBy, len(expr.OrderBy))
+		for i, o := range expr.OrderBy {
+			newOrderBy[i] = &Order{Expr: o.Expr, Direction: o.Direction}
+		}
+		exprCopy.OrderBy = newOrderBy
+	}
 	if windowDef := exprCopy.WindowDef; windowDef != nil {
 		windowDef.Partitions = append(Exprs(nil), windowDef.Partitions...)
 		if len(windowDef.OrderBy) > 0 {
@@ -229,6 +236,15 @@ func (expr *FuncExpr) Walk(v Visitor) Expr {
 			ret.Exprs[i] = e
 		}
 	}
+	for i := range expr.OrderBy {
+		e, changed := WalkExpr(v, expr.OrderBy[i].Expr)
+		if changed {
+			if ret == expr {
+				ret = expr.CopyNode()
+			}
+			ret.OrderBy[i].Expr = e
+		}
+	}
 	if expr.WindowDef != nil {
 		for i := range expr.WindowDef.Partitions {
 			e, changed := WalkExpr(v, expr.WindowDef.Partitions[i])
@@ -718,9 +734,18 @@ func (stmt *Select) CopyNode() *Select {
 // WalkStmt is part of the WalkableStmt interface.
 func (stmt *Select) WalkStmt(v Visitor) Statement {
 	ret := stmt
+	if stmt.With != nil {
+		with, changed := walkWith(v, stmt.With)
+		if changed {
+			ret = stmt.CopyNode()
+			ret.With = with
+		}
+	}
 	sel, changed := WalkStmt(v, stmt.Select)
 	if changed {
-		ret = stmt.CopyNode()
+		if ret == stmt {
+			ret = stmt.CopyNode()
+		}
 		ret.Select = sel.(SelectStatement)
 	}
 	order, changed := walkOrderBy(v, stmt.OrderBy)
@@ -753,9 +778,26 @@ func (stmt *Select) WalkStmt(v Visitor) Statement {
 	return ret
 }
 
+func walkWith(v Visitor, with *With) (*With, bool) {
+	copied := false
+	ret := with
+	for i, cte := range with.CTEList {
+		s, changed := WalkStmt(v, cte.Stmt)
+		if changed {
+			if !copied {
+				ret = &With{Recursive: with.Recursive, CTEList: append([]*CTE(nil), with.CTEList...)}
+				copied = true
+			}
+			ret.CTEList[i] = &CTE{Name: cte.Name, Stmt: s}
+		}
+	}
+	return ret, copied
+}
+
 // CopyNode makes a copy of this Statement without recursing in any child Statements.
 func (stmt *SelectClause) CopyNode() *SelectClause {
 	stmtCopy := *stmt
+	stmtCopy.DistinctOn = append(DistinctOn(nil), stmt.DistinctOn...)
 	stmtCopy.Exprs = append(SelectExprs(nil), stmt.Exprs...)
 	stmtCopy.From = &From{
 		Tables: append(TableExprs(nil), stmt.From.Tables...),
@@ -777,6 +819,16 @@ func (stmt *SelectClause) CopyNode() *SelectClause {
 func (stmt *SelectClause) WalkStmt(v Visitor) Statement {
 	ret := stmt
 
+	for i, expr := range stmt.DistinctOn {
+		e, changed := WalkExpr(v, expr)
+		if changed {
+			if ret == stmt {
+				ret = stmt.CopyNode()
+			}
+			ret.DistinctOn[i] = e
+		}
+	}
+
 	for i, expr := range stmt.Exprs {
 		e, changed := WalkExpr(v, expr.Expr)
 		if changed {